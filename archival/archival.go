@@ -0,0 +1,283 @@
+// Package archival joins TTL entries back to the contract data and code
+// entries they govern, and works out whether those entries are live, expired
+// or archived at a ledger and the rent of keeping them live.
+//
+// An entry is live while its TTL's live until ledger is not behind the
+// current ledger. Past it, a temporary entry has expired and is gone for
+// good, and a persistent entry (contract code is always persistent) is
+// archived until it is restored.
+package archival
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+type Status string
+
+const (
+	StatusLive     Status = "live"
+	StatusExpired  Status = "expired"
+	StatusArchived Status = "archived"
+	// StatusUnknown is the status of an entry whose TTL was not seen.
+	StatusUnknown Status = "unknown"
+)
+
+func ParseStatus(s string) (Status, error) {
+	switch Status(s) {
+	case StatusLive, StatusExpired, StatusArchived, StatusUnknown:
+		return Status(s), nil
+	}
+
+	return "", errors.Errorf("error invalid status %q, expected live, expired, archived or unknown", s)
+}
+
+// EntryStatus is the archival status of a contract data or code entry at a
+// ledger.
+//
+// ExtendToLedgerSeq and RentFee are what keeping the entry live costs: for a
+// live entry, extending its TTL to the requested ledger, and for an archived
+// one, restoring it, which makes it live for the minimum persistent TTL. An
+// expired entry cannot be kept, and neither are set without the config
+// settings rent depends on.
+type EntryStatus struct {
+	Key                converter.LedgerKey `json:"key"`
+	Persistent         bool                `json:"persistent"`
+	SizeBytes          uint32              `json:"size_bytes"`
+	LastModifiedLedger uint32              `json:"last_modified_ledger"`
+	LiveUntilLedgerSeq *uint32             `json:"live_until_ledger_seq,omitempty"`
+	Status             Status              `json:"status"`
+	ExtendToLedgerSeq  *uint32             `json:"extend_to_ledger_seq,omitempty"`
+	RentFee            *int64              `json:"rent_fee,omitempty"`
+}
+
+// Index holds the contract data, contract code and TTL entries it was given,
+// joined by TTL key hash, and the config settings rent depends on. It is not
+// safe for concurrent use.
+type Index struct {
+	entries  map[xdr.Hash]xdr.LedgerEntry
+	ttls     map[xdr.Hash]uint32
+	settings Settings
+}
+
+func NewIndex() *Index {
+	return &Index{
+		entries: make(map[xdr.Hash]xdr.LedgerEntry),
+		ttls:    make(map[xdr.Hash]uint32),
+	}
+}
+
+func (x *Index) Settings() *Settings {
+	return &x.settings
+}
+
+// Add records the current state of an entry. Entries other than contract
+// data, contract code, TTLs and config settings are ignored.
+func (x *Index) Add(e xdr.LedgerEntry) error {
+	switch e.Data.Type {
+	case xdr.LedgerEntryTypeContractData, xdr.LedgerEntryTypeContractCode:
+		key, err := e.LedgerKey()
+		if err != nil {
+			return err
+		}
+		hash, err := converter.TtlKeyHash(key)
+		if err != nil {
+			return err
+		}
+		x.entries[hash] = e
+	case xdr.LedgerEntryTypeTtl:
+		x.ttls[e.Data.Ttl.KeyHash] = uint32(e.Data.Ttl.LiveUntilLedgerSeq)
+	case xdr.LedgerEntryTypeConfigSetting:
+		x.settings.Apply(*e.Data.ConfigSetting)
+	}
+
+	return nil
+}
+
+// Remove forgets the entry with the given key.
+func (x *Index) Remove(key xdr.LedgerKey) error {
+	switch key.Type {
+	case xdr.LedgerEntryTypeContractData, xdr.LedgerEntryTypeContractCode:
+		hash, err := converter.TtlKeyHash(key)
+		if err != nil {
+			return err
+		}
+		delete(x.entries, hash)
+	case xdr.LedgerEntryTypeTtl:
+		delete(x.ttls, key.Ttl.KeyHash)
+	}
+
+	return nil
+}
+
+// ApplyChanges applies ledger entry changes in order.
+func (x *Index) ApplyChanges(changes xdr.LedgerEntryChanges) error {
+	for _, change := range changes {
+		var err error
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			err = x.Add(*change.Created)
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			err = x.Add(*change.Updated)
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			err = x.Add(*change.State)
+		case xdr.LedgerEntryChangeTypeLedgerEntryRestored:
+			err = x.Add(*change.Restored)
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			err = x.Remove(*change.Removed)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyLedgerCloseMeta applies every change of a ledger: fees, transactions,
+// fee refunds, upgrades and evictions.
+func (x *Index) ApplyLedgerCloseMeta(m xdr.LedgerCloseMeta) error {
+	if m.V > 2 {
+		return errors.Errorf("error invalid LedgerCloseMeta version %v", m.V)
+	}
+
+	for i := 0; i < m.CountTransactions(); i++ {
+		if err := x.ApplyChanges(m.FeeProcessing(i)); err != nil {
+			return err
+		}
+		changes, err := converter.TransactionMetaChanges(m.TxApplyProcessing(i))
+		if err != nil {
+			return err
+		}
+		if err := x.ApplyChanges(changes); err != nil {
+			return err
+		}
+	}
+
+	if m.V == 2 {
+		for _, processing := range m.V2.TxProcessing {
+			if err := x.ApplyChanges(processing.PostTxApplyFeeProcessing); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, upgrade := range m.UpgradesProcessing() {
+		if err := x.ApplyChanges(upgrade.Changes); err != nil {
+			return err
+		}
+	}
+
+	evicted, err := m.EvictedLedgerKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range evicted {
+		if err := x.Remove(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Status returns the status of every contract data and code entry at
+// ledger, ordered by TTL key hash. The rent is of extending live entries to
+// live until ledger+extendTo; an extendTo of 0 extends them as far as the
+// maximum entry TTL allows.
+func (x *Index) Status(ledger uint32, extendTo uint32) ([]EntryStatus, error) {
+	if archival := x.settings.StateArchival; archival != nil {
+		if archival.MaxEntryTtl == 0 {
+			return nil, errors.New("error state archival config setting has a max entry TTL of 0")
+		}
+		maxExtendTo := uint32(archival.MaxEntryTtl) - 1
+		if extendTo == 0 {
+			extendTo = maxExtendTo
+		}
+		if extendTo > maxExtendTo {
+			return nil, errors.Errorf("error extend to %d ledgers is over the maximum of %d", extendTo, maxExtendTo)
+		}
+	}
+
+	hashes := make([]xdr.Hash, 0, len(x.entries))
+	for hash := range x.entries {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+	})
+
+	result := make([]EntryStatus, 0, len(hashes))
+	for _, hash := range hashes {
+		status, err := x.status(hash, ledger, extendTo)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+func (x *Index) status(hash xdr.Hash, ledger uint32, extendTo uint32) (EntryStatus, error) {
+	var result EntryStatus
+
+	e := x.entries[hash]
+	key, err := e.LedgerKey()
+	if err != nil {
+		return result, err
+	}
+	result.Key, err = converter.ConvertLedgerKey(key)
+	if err != nil {
+		return result, err
+	}
+	bz, err := e.MarshalBinary()
+	if err != nil {
+		return result, err
+	}
+
+	result.Persistent = e.Data.Type == xdr.LedgerEntryTypeContractCode ||
+		e.Data.ContractData.Durability == xdr.ContractDataDurabilityPersistent
+	result.SizeBytes = uint32(len(bz))
+	result.LastModifiedLedger = uint32(e.LastModifiedLedgerSeq)
+
+	liveUntil, ok := x.ttls[hash]
+	if !ok {
+		result.Status = StatusUnknown
+		return result, nil
+	}
+	result.LiveUntilLedgerSeq = &liveUntil
+
+	// oldLiveUntil is 0 for a restore, see Settings.RentFee.
+	var oldLiveUntil, newLiveUntil uint32
+	switch {
+	case liveUntil >= ledger:
+		result.Status = StatusLive
+		oldLiveUntil, newLiveUntil = liveUntil, max(liveUntil, ledger+extendTo)
+	case result.Persistent:
+		result.Status = StatusArchived
+		if x.settings.StateArchival != nil {
+			newLiveUntil = ledger + uint32(x.settings.StateArchival.MinPersistentTtl) - 1
+		}
+	default:
+		result.Status = StatusExpired
+		return result, nil
+	}
+
+	if !x.settings.complete() {
+		return result, nil
+	}
+
+	fee, err := x.settings.RentFee(result.Persistent, result.SizeBytes, oldLiveUntil, newLiveUntil, ledger)
+	if err != nil {
+		return result, err
+	}
+	result.ExtendToLedgerSeq = &newLiveUntil
+	result.RentFee = &fee
+
+	return result, nil
+}
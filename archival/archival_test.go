@@ -0,0 +1,157 @@
+package archival
+
+import (
+	"testing"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+func contractData(key uint32, durability xdr.ContractDataDurability) xdr.LedgerEntry {
+	id := xdr.ContractId{1}
+	k := xdr.Uint32(key)
+	return xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+		Type: xdr.LedgerEntryTypeContractData,
+		ContractData: &xdr.ContractDataEntry{
+			Contract:   xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id},
+			Key:        xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &k},
+			Durability: durability,
+			Val:        xdr.ScVal{Type: xdr.ScValTypeScvVoid},
+		},
+	}}
+}
+
+func ttl(t *testing.T, e xdr.LedgerEntry, liveUntil uint32) xdr.LedgerEntry {
+	key, err := e.LedgerKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := converter.TtlKeyHash(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+		Type: xdr.LedgerEntryTypeTtl,
+		Ttl:  &xdr.TtlEntry{KeyHash: hash, LiveUntilLedgerSeq: xdr.Uint32(liveUntil)},
+	}}
+}
+
+func TestStatus(t *testing.T) {
+	live := contractData(1, xdr.ContractDataDurabilityPersistent)
+	archived := contractData(2, xdr.ContractDataDurabilityPersistent)
+	expired := contractData(3, xdr.ContractDataDurabilityTemporary)
+	unknown := contractData(4, xdr.ContractDataDurabilityPersistent)
+
+	x := NewIndex()
+	x.settings = *testSettings(500)
+	for _, e := range []xdr.LedgerEntry{
+		live, ttl(t, live, 200),
+		archived, ttl(t, archived, 100),
+		expired, ttl(t, expired, 100),
+		unknown,
+	} {
+		if err := x.Add(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	statuses, err := x.Status(150, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 4 {
+		t.Fatalf("got %d statuses, want 4", len(statuses))
+	}
+
+	byKey := map[uint32]EntryStatus{}
+	var previous string
+	for _, s := range statuses {
+		data := s.Key.ContractData
+		if data == nil || data.Key.U32 == nil {
+			t.Fatalf("got key %+v", s.Key)
+		}
+		byKey[*data.Key.U32] = s
+
+		if data.TtlKeyHash <= previous {
+			t.Errorf("got TTL key hash %s after %s", data.TtlKeyHash, previous)
+		}
+		previous = data.TtlKeyHash
+	}
+
+	for key, want := range map[uint32]Status{1: StatusLive, 2: StatusArchived, 3: StatusExpired, 4: StatusUnknown} {
+		if got := byKey[key].Status; got != want {
+			t.Errorf("entry %d: got status %s, want %s", key, got, want)
+		}
+	}
+
+	// An extendTo of 0 extends live entries to the maximum entry TTL, and
+	// archived ones are restored for the minimum persistent TTL.
+	if s := byKey[1]; s.ExtendToLedgerSeq == nil || *s.ExtendToLedgerSeq != 150+999 || s.RentFee == nil {
+		t.Errorf("live entry: got extend to %v, rent %v", s.ExtendToLedgerSeq, s.RentFee)
+	}
+	if s := byKey[2]; s.ExtendToLedgerSeq == nil || *s.ExtendToLedgerSeq != 150+99 || s.RentFee == nil {
+		t.Errorf("archived entry: got extend to %v, rent %v", s.ExtendToLedgerSeq, s.RentFee)
+	}
+	if s := byKey[3]; s.RentFee != nil {
+		t.Errorf("expired entry: got rent %d", *s.RentFee)
+	}
+
+	if _, err := x.Status(150, 1000); err == nil {
+		t.Error("got no error extending past the maximum entry TTL")
+	}
+
+	x.settings.StateArchival.MaxEntryTtl = 0
+	if _, err := x.Status(150, 0); err == nil {
+		t.Error("got no error for a max entry TTL of 0")
+	}
+}
+
+func TestApplyLedgerCloseMetaV2(t *testing.T) {
+	restored := contractData(1, xdr.ContractDataDurabilityPersistent)
+	restoredTtl := ttl(t, restored, 200)
+	evicted := contractData(2, xdr.ContractDataDurabilityTemporary)
+	evictedKey, err := evicted.LedgerKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := NewIndex()
+	x.settings = *testSettings(500)
+	if err := x.Add(evicted); err != nil {
+		t.Fatal(err)
+	}
+
+	meta := xdr.LedgerCloseMeta{V: 2, V2: &xdr.LedgerCloseMetaV2{
+		TxSet: xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{}},
+		TxProcessing: []xdr.TransactionResultMetaV1{{
+			TxApplyProcessing: xdr.TransactionMeta{V: 4, V4: &xdr.TransactionMetaV4{
+				Operations: []xdr.OperationMetaV2{{Changes: xdr.LedgerEntryChanges{
+					{Type: xdr.LedgerEntryChangeTypeLedgerEntryRestored, Restored: &restored},
+					{Type: xdr.LedgerEntryChangeTypeLedgerEntryRestored, Restored: &restoredTtl},
+				}}},
+			}},
+		}},
+		EvictedKeys: []xdr.LedgerKey{evictedKey},
+	}}
+	if err := x.ApplyLedgerCloseMeta(meta); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, err := x.Status(150, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 1 || statuses[0].Status != StatusLive || *statuses[0].Key.ContractData.Key.U32 != 1 {
+		t.Errorf("got %+v, want only the restored entry, live", statuses)
+	}
+
+	meta.V2.TxProcessing[0].TxApplyProcessing.V = 5
+	if err := x.ApplyLedgerCloseMeta(meta); err == nil {
+		t.Error("applied TransactionMeta V5")
+	}
+	meta.V = 3
+	if err := x.ApplyLedgerCloseMeta(meta); err == nil {
+		t.Error("applied LedgerCloseMeta V3")
+	}
+}
@@ -0,0 +1,133 @@
+package archival
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// The constants of the rent fee computation in soroban-env-host.
+const (
+	// ttlEntrySize is the size the host charges a TTL entry write at.
+	ttlEntrySize = 48
+	// minWriteFee1Kb is the floor of the write fee per 1KB.
+	minWriteFee1Kb = 1000
+)
+
+// Settings are the network config settings rent depends on. A field is nil
+// until the config setting entry holding it was seen.
+type Settings struct {
+	StateArchival        *xdr.StateArchivalSettings
+	LedgerCost           *xdr.ConfigSettingContractLedgerCostV0
+	BucketListSizeWindow []uint64
+	// LedgerCostExt holds the flat write fee of protocol 23 on. Before it,
+	// TTL entry writes pay the rent fee per 1KB.
+	LedgerCostExt *xdr.ConfigSettingContractLedgerCostExtV0
+}
+
+// Apply records the settings of a config setting entry. Entries of other
+// config settings are ignored.
+func (s *Settings) Apply(e xdr.ConfigSettingEntry) {
+	switch e.ConfigSettingId {
+	case xdr.ConfigSettingIdConfigSettingStateArchival:
+		settings := *e.StateArchivalSettings
+		s.StateArchival = &settings
+	case xdr.ConfigSettingIdConfigSettingContractLedgerCostV0:
+		cost := *e.ContractLedgerCost
+		s.LedgerCost = &cost
+	case xdr.ConfigSettingIdConfigSettingContractLedgerCostExtV0:
+		ext := *e.ContractLedgerCostExt
+		s.LedgerCostExt = &ext
+	case xdr.ConfigSettingIdConfigSettingLiveSorobanStateSizeWindow:
+		s.BucketListSizeWindow = s.BucketListSizeWindow[:0]
+		for _, size := range *e.LiveSorobanStateSizeWindow {
+			s.BucketListSizeWindow = append(s.BucketListSizeWindow, uint64(size))
+		}
+	}
+}
+
+// complete reports whether every setting rent depends on was seen.
+func (s *Settings) complete() bool {
+	return s.StateArchival != nil && s.LedgerCost != nil && len(s.BucketListSizeWindow) > 0
+}
+
+// BucketListSize is the average of the bucket list size window, which the
+// write fee grows with.
+func (s *Settings) BucketListSize() int64 {
+	if len(s.BucketListSizeWindow) == 0 {
+		return 0
+	}
+
+	var sum uint64
+	for _, size := range s.BucketListSizeWindow {
+		sum += size
+	}
+
+	return int64(sum / uint64(len(s.BucketListSizeWindow)))
+}
+
+// WriteFee1Kb is the fee per 1KB written at the current bucket list size.
+// It grows linearly from the low to the high fee until the bucket list
+// reaches its target size, and by the growth factor faster after that.
+func (s *Settings) WriteFee1Kb() (int64, error) {
+	if s.LedgerCost == nil {
+		return 0, errors.New("error missing contract ledger cost config setting")
+	}
+
+	cost := s.LedgerCost
+	size := s.BucketListSize()
+	target := max(int64(cost.SorobanStateTargetSizeBytes), 1)
+	low, high := int64(cost.RentFee1KbSorobanStateSizeLow), int64(cost.RentFee1KbSorobanStateSizeHigh)
+	multiplier := max(high-low, 0)
+
+	var fee int64
+	if size < target {
+		fee = low + ceilDiv(multiplier*size, target)
+	} else {
+		fee = high + ceilDiv(multiplier*(size-target)*int64(cost.SorobanStateRentFeeGrowthFactor), target)
+	}
+
+	return max(fee, minWriteFee1Kb), nil
+}
+
+// RentFee is the rent fee of changing the TTL of an entry of sizeBytes from
+// oldLiveUntil to newLiveUntil at ledger. An oldLiveUntil of 0 is an entry
+// being created or restored, which pays rent from ledger on.
+func (s *Settings) RentFee(persistent bool, sizeBytes uint32, oldLiveUntil, newLiveUntil, ledger uint32) (int64, error) {
+	if !s.complete() {
+		return 0, errors.New("error missing state archival, contract ledger cost or bucket list size window config setting")
+	}
+
+	before := oldLiveUntil
+	if oldLiveUntil == 0 {
+		before = ledger - 1
+	}
+	if newLiveUntil <= before {
+		return 0, nil
+	}
+
+	writeFee1Kb, err := s.WriteFee1Kb()
+	if err != nil {
+		return 0, err
+	}
+
+	denominator := int64(s.StateArchival.TempRentRateDenominator)
+	if persistent {
+		denominator = int64(s.StateArchival.PersistentRentRateDenominator)
+	}
+
+	rentLedgers := int64(newLiveUntil - before)
+	fee := ceilDiv(int64(sizeBytes)*writeFee1Kb*rentLedgers, 1024*max(denominator, 1))
+
+	// Extending a TTL also writes the TTL entry.
+	fee += int64(s.LedgerCost.FeeWriteLedgerEntry)
+	if s.LedgerCostExt != nil {
+		writeFee1Kb = int64(s.LedgerCostExt.FeeWrite1Kb)
+	}
+	fee += ceilDiv(ttlEntrySize*writeFee1Kb, 1024)
+
+	return fee, nil
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,98 @@
+package archival
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+)
+
+// testSettings has a target size of 1000 bytes and write fees of 1000 to
+// 10000 per 1KB below it, growing twice as fast above it.
+func testSettings(size uint64) *Settings {
+	return &Settings{
+		StateArchival: &xdr.StateArchivalSettings{
+			MaxEntryTtl:                   1000,
+			MinPersistentTtl:              100,
+			PersistentRentRateDenominator: 1000,
+			TempRentRateDenominator:       2000,
+		},
+		LedgerCost: &xdr.ConfigSettingContractLedgerCostV0{
+			FeeWriteLedgerEntry:             500,
+			SorobanStateTargetSizeBytes:     1000,
+			RentFee1KbSorobanStateSizeLow:   1000,
+			RentFee1KbSorobanStateSizeHigh:  10000,
+			SorobanStateRentFeeGrowthFactor: 2,
+		},
+		BucketListSizeWindow: []uint64{size},
+	}
+}
+
+func TestWriteFee1Kb(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		size uint64
+		low  xdr.Int64
+		want int64
+	}{
+		{"empty", 0, 1000, 1000},
+		{"below target", 500, 1000, 5500},
+		{"at target", 1000, 1000, 10000},
+		{"above target", 1500, 1000, 19000},
+		{"floor", 0, 10, minWriteFee1Kb},
+	} {
+		s := testSettings(c.size)
+		s.LedgerCost.RentFee1KbSorobanStateSizeLow = c.low
+		got, err := s.WriteFee1Kb()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %d, want %d", c.name, got, c.want)
+		}
+	}
+
+	if _, err := (&Settings{}).WriteFee1Kb(); err == nil {
+		t.Error("got no error without the ledger cost setting")
+	}
+}
+
+func TestRentFee(t *testing.T) {
+	for _, c := range []struct {
+		name                    string
+		size                    uint64
+		persistent              bool
+		oldLiveUntil, liveUntil uint32
+		feeWrite1Kb             *xdr.Int64
+		want                    int64
+	}{
+		// 1KB for 100 ledgers at 5500 per 1KB over a denominator of 1000 is
+		// 550, plus 500 for the TTL entry write and 48/1024 of 5500.
+		{"extend below target", 500, true, 200, 300, nil, 550 + 500 + 258},
+		{"extend temporary", 500, false, 200, 300, nil, 275 + 500 + 258},
+		{"extend above target", 1500, true, 200, 300, nil, 1900 + 500 + 891},
+		// A restore at ledger 150 pays for the 150 ledgers from 149 on.
+		{"restore", 500, true, 0, 299, nil, 825 + 500 + 258},
+		{"no extension", 500, true, 300, 300, nil, 0},
+		{"flat ttl write fee", 500, true, 200, 300, ptr(xdr.Int64(2048)), 550 + 500 + 96},
+	} {
+		s := testSettings(c.size)
+		if c.feeWrite1Kb != nil {
+			s.LedgerCostExt = &xdr.ConfigSettingContractLedgerCostExtV0{FeeWrite1Kb: *c.feeWrite1Kb}
+		}
+		got, err := s.RentFee(c.persistent, 1024, c.oldLiveUntil, c.liveUntil, 150)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %d, want %d", c.name, got, c.want)
+		}
+	}
+
+	if _, err := (&Settings{}).RentFee(true, 1024, 200, 300, 150); err == nil {
+		t.Error("got no error without the config settings")
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
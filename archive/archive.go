@@ -0,0 +1,187 @@
+// Package archive reads a Stellar history archive mirrored to a local
+// directory and converts its ledgers with the converter package.
+//
+// Every checkpoint of 64 ledgers has a ledger-*.xdr.gz file of
+// LedgerHeaderHistoryEntry records, a transactions-*.xdr.gz file of
+// TransactionHistoryEntry records and a results-*.xdr.gz file of
+// TransactionHistoryResultEntry records, each an RFC 5531 record-marked
+// stream. The last two only hold ledgers that have transactions.
+package archive
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+)
+
+const CheckpointFrequency = 64
+
+const (
+	CategoryLedger       = "ledger"
+	CategoryTransactions = "transactions"
+	CategoryResults      = "results"
+)
+
+// CheckpointLedger returns the last ledger of the checkpoint holding seq,
+// which names the checkpoint's files.
+func CheckpointLedger(seq uint32) uint32 {
+	return seq/CheckpointFrequency*CheckpointFrequency + CheckpointFrequency - 1
+}
+
+// CategoryPath returns the path of a checkpoint file relative to the archive
+// root, e.g. ledger/00/12/34/ledger-0012343f.xdr.gz.
+func CategoryPath(category string, checkpoint uint32) string {
+	hex := fmt.Sprintf("%08x", checkpoint)
+	return path.Join(category, hex[0:2], hex[2:4], hex[4:6], category+"-"+hex+".xdr.gz")
+}
+
+type Archive struct {
+	Root string
+	// NetworkPassphrase identifies the network of the archive, which
+	// transactions are matched to their results and contract IDs derived on.
+	NetworkPassphrase string
+	// EnumNames adds enum names to the converted ledgers, see
+	// converter.Options.EnumNames.
+	EnumNames bool
+}
+
+func NewArchive(root string) *Archive {
+	return &Archive{Root: root, NetworkPassphrase: converter.DefaultNetworkPassphrase}
+}
+
+// options returns the options the ledgers of the archive are converted with.
+func (a *Archive) options() converter.Options {
+	return converter.Options{NetworkPassphrase: a.NetworkPassphrase, EnumNames: a.EnumNames}
+}
+
+// Path returns the local path of a checkpoint file.
+func (a *Archive) Path(category string, checkpoint uint32) string {
+	return filepath.Join(a.Root, filepath.FromSlash(CategoryPath(category, checkpoint)))
+}
+
+// Checkpoints lists the checkpoints that have a ledger file, in order.
+func (a *Archive) Checkpoints() ([]uint32, error) {
+	var checkpoints []uint32
+	root := filepath.Join(a.Root, CategoryLedger)
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := d.Name()
+		if d.IsDir() || !strings.HasPrefix(name, "ledger-") || !strings.HasSuffix(name, ".xdr.gz") {
+			return nil
+		}
+
+		hex := strings.TrimSuffix(strings.TrimPrefix(name, "ledger-"), ".xdr.gz")
+		checkpoint, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return errors.Errorf("error invalid ledger file name %s", p)
+		}
+		checkpoints = append(checkpoints, uint32(checkpoint))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i] < checkpoints[j] })
+	return checkpoints, nil
+}
+
+// OpenCheckpoint opens the three files of a checkpoint for joined reading.
+func (a *Archive) OpenCheckpoint(checkpoint uint32) (*CheckpointReader, error) {
+	headers, err := OpenLedgerHeaders(a.Path(CategoryLedger, checkpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := OpenTransactions(a.Path(CategoryTransactions, checkpoint))
+	if err != nil {
+		headers.Close()
+		return nil, err
+	}
+
+	results, err := OpenResults(a.Path(CategoryResults, checkpoint))
+	if err != nil {
+		headers.Close()
+		txs.Close()
+		return nil, err
+	}
+
+	txs.NetworkPassphrase = a.NetworkPassphrase
+	headers.EnumNames = a.EnumNames
+	txs.EnumNames = a.EnumNames
+	results.EnumNames = a.EnumNames
+
+	return &CheckpointReader{headers: headers, txs: txs, results: results, opts: a.options()}, nil
+}
+
+// ReadLedgers calls fn with every ledger from from to to, inclusive, in order.
+// A to of zero reads up to the last checkpoint in the archive.
+func (a *Archive) ReadLedgers(from uint32, to uint32, fn func(Ledger) error) error {
+	return a.ReadLedgersXdr(from, to, func(l LedgerXdr) error {
+		ledger, err := ConvertLedger(l, a.options())
+		if err != nil {
+			return err
+		}
+
+		return fn(ledger)
+	})
+}
+
+// ReadLedgersXdr is ReadLedgers without the conversion.
+func (a *Archive) ReadLedgersXdr(from uint32, to uint32, fn func(LedgerXdr) error) error {
+	checkpoints, err := a.Checkpoints()
+	if err != nil {
+		return err
+	}
+
+	for _, checkpoint := range checkpoints {
+		if checkpoint < from || (to != 0 && checkpoint-CheckpointFrequency+1 > to) {
+			continue
+		}
+
+		if err := a.readCheckpoint(checkpoint, from, to, fn); err != nil {
+			return errors.Wrapf(err, "error reading checkpoint %d", checkpoint)
+		}
+	}
+
+	return nil
+}
+
+func (a *Archive) readCheckpoint(checkpoint uint32, from uint32, to uint32, fn func(LedgerXdr) error) error {
+	r, err := a.OpenCheckpoint(checkpoint)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		ledger, err := r.ReadXdr()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		seq := uint32(ledger.Header.Header.LedgerSeq)
+		if seq < from || (to != 0 && seq > to) {
+			continue
+		}
+
+		if err := fn(ledger); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package archive
+
+import (
+	"compress/gzip"
+	"encoding"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+)
+
+func testEnvelope(seq int64) xdr.TransactionEnvelope {
+	return xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1: &xdr.TransactionV1Envelope{Tx: xdr.Transaction{
+			SourceAccount: xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &xdr.Uint256{1}},
+			Fee:           100,
+			SeqNum:        xdr.SequenceNumber(seq),
+			Operations: []xdr.Operation{{Body: xdr.OperationBody{
+				Type:           xdr.OperationTypeBumpSequence,
+				BumpSequenceOp: &xdr.BumpSequenceOp{BumpTo: xdr.SequenceNumber(seq)},
+			}}},
+		}},
+	}
+}
+
+func testResult(t *testing.T, env xdr.TransactionEnvelope) xdr.TransactionResultPair {
+	t.Helper()
+
+	hash, err := network.HashTransactionInEnvelope(env, network.TestNetworkPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := []xdr.OperationResult{}
+
+	return xdr.TransactionResultPair{
+		TransactionHash: hash,
+		Result: xdr.TransactionResult{
+			FeeCharged: 100,
+			Result:     xdr.TransactionResultResult{Code: xdr.TransactionResultCodeTxSuccess, Results: &results},
+		},
+	}
+}
+
+func writeCategory[T encoding.BinaryMarshaler](t *testing.T, a *Archive, category string, checkpoint uint32, entries []T) {
+	t.Helper()
+
+	path := a.Path(category, checkpoint)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	for _, e := range entries {
+		bz, err := e.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := xdrstream.WriteRecord(gz, bz); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func headers(seqs ...uint32) []xdr.LedgerHeaderHistoryEntry {
+	var result []xdr.LedgerHeaderHistoryEntry
+	for _, seq := range seqs {
+		result = append(result, xdr.LedgerHeaderHistoryEntry{Header: xdr.LedgerHeader{LedgerVersion: 21, LedgerSeq: xdr.Uint32(seq)}})
+	}
+
+	return result
+}
+
+func txEntry(seq uint32, envelopes ...xdr.TransactionEnvelope) xdr.TransactionHistoryEntry {
+	return xdr.TransactionHistoryEntry{LedgerSeq: xdr.Uint32(seq), TxSet: xdr.TransactionSet{Txs: envelopes}}
+}
+
+func resultEntry(seq uint32, pairs ...xdr.TransactionResultPair) xdr.TransactionHistoryResultEntry {
+	return xdr.TransactionHistoryResultEntry{LedgerSeq: xdr.Uint32(seq), TxResultSet: xdr.TransactionResultSet{Results: pairs}}
+}
+
+// testArchive has checkpoint 63 with ledgers 61 to 63, of which 61 and 63
+// have transactions, and checkpoint 127 with ledger 64.
+func testArchive(t *testing.T) *Archive {
+	t.Helper()
+
+	a := NewArchive(t.TempDir())
+	a.NetworkPassphrase = network.TestNetworkPassphrase
+
+	first, second, third := testEnvelope(1), testEnvelope(2), testEnvelope(3)
+	writeCategory(t, a, CategoryLedger, 63, headers(61, 62, 63))
+	// The transaction set is in hash order; the results are in apply order.
+	writeCategory(t, a, CategoryTransactions, 63, []xdr.TransactionHistoryEntry{
+		txEntry(61, second, first),
+		txEntry(63, third),
+	})
+	writeCategory(t, a, CategoryResults, 63, []xdr.TransactionHistoryResultEntry{
+		resultEntry(61, testResult(t, first), testResult(t, second)),
+		resultEntry(63, testResult(t, third)),
+	})
+
+	writeCategory(t, a, CategoryLedger, 127, headers(64))
+	writeCategory(t, a, CategoryTransactions, 127, []xdr.TransactionHistoryEntry{})
+	writeCategory(t, a, CategoryResults, 127, []xdr.TransactionHistoryResultEntry{})
+
+	return a
+}
+
+func TestCheckpoints(t *testing.T) {
+	if got := CheckpointLedger(64); got != 127 {
+		t.Errorf("got checkpoint %d of ledger 64, want 127", got)
+	}
+	if got := CategoryPath(CategoryLedger, 0x0012343f); got != "ledger/00/12/34/ledger-0012343f.xdr.gz" {
+		t.Errorf("got path %s", got)
+	}
+
+	checkpoints, err := testArchive(t).Checkpoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoints) != 2 || checkpoints[0] != 63 || checkpoints[1] != 127 {
+		t.Errorf("got checkpoints %v, want [63 127]", checkpoints)
+	}
+}
+
+func TestReadLedgersJoin(t *testing.T) {
+	a := testArchive(t)
+
+	var ledgers []Ledger
+	err := a.ReadLedgers(0, 0, func(l Ledger) error {
+		ledgers = append(ledgers, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[uint32][]int64{61: {1, 2}, 62: nil, 63: {3}, 64: nil}
+	if len(ledgers) != len(want) {
+		t.Fatalf("got %d ledgers, want %d", len(ledgers), len(want))
+	}
+	for i, l := range ledgers {
+		if l.Sequence != uint32(61+i) || l.Header.Header.LedgerSeq != l.Sequence {
+			t.Errorf("ledger %d: got sequence %d", i, l.Sequence)
+		}
+
+		seqNums := want[l.Sequence]
+		if len(l.Transactions) != len(seqNums) {
+			t.Errorf("ledger %d: got %d transactions, want %d", l.Sequence, len(l.Transactions), len(seqNums))
+			continue
+		}
+		for j, tx := range l.Transactions {
+			if tx.Envelope.V1 == nil || tx.Envelope.V1.Tx.SeqNum != seqNums[j] {
+				t.Errorf("ledger %d transaction %d: got %+v, want seq num %d", l.Sequence, j, tx.Envelope.V1, seqNums[j])
+			}
+			if tx.Hash != tx.Result.TransactionHash {
+				t.Errorf("got hash %s for result %s", tx.Hash, tx.Result.TransactionHash)
+			}
+		}
+	}
+
+	var seqs []uint32
+	err = a.ReadLedgersXdr(62, 63, func(l LedgerXdr) error {
+		seqs = append(seqs, uint32(l.Header.Header.LedgerSeq))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seqs) != 2 || seqs[0] != 62 || seqs[1] != 63 {
+		t.Errorf("got ledgers %v from 62 to 63", seqs)
+	}
+}
+
+func TestReadLedgersJoinErrors(t *testing.T) {
+	read := func(a *Archive) error {
+		return a.ReadLedgersXdr(0, 0, func(LedgerXdr) error { return nil })
+	}
+
+	a := testArchive(t)
+	a.NetworkPassphrase = network.PublicNetworkPassphrase
+	if err := read(a); err == nil || !strings.Contains(err.Error(), "network passphrase") {
+		t.Errorf("got %v on the wrong network", err)
+	}
+
+	a = testArchive(t)
+	writeCategory(t, a, CategoryResults, 63, []xdr.TransactionHistoryResultEntry{
+		resultEntry(61, testResult(t, testEnvelope(1))),
+	})
+	if err := read(a); err == nil || !strings.Contains(err.Error(), "2 transactions but 1 results") {
+		t.Errorf("got %v for a missing result", err)
+	}
+
+	a = testArchive(t)
+	writeCategory(t, a, CategoryLedger, 63, headers(61, 62))
+	if err := read(a); err == nil || !strings.Contains(err.Error(), "ledger 63 have no header") {
+		t.Errorf("got %v for transactions past the last header", err)
+	}
+
+	a = testArchive(t)
+	writeCategory(t, a, CategoryLedger, 63, headers(62, 63))
+	if err := read(a); err == nil || !strings.Contains(err.Error(), "ledger 61 have no header") {
+		t.Errorf("got %v for transactions before the first header", err)
+	}
+}
@@ -0,0 +1,244 @@
+package archive
+
+import (
+	"io"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+)
+
+// Ledger is a ledger header joined with its transactions.
+type Ledger struct {
+	Sequence     uint32                             `json:"sequence"`
+	Header       converter.LedgerHeaderHistoryEntry `json:"header"`
+	Transactions []Transaction                      `json:"transactions,omitempty"`
+}
+
+// Transaction is an envelope with its result. Transactions of a ledger are
+// in apply order, the order of the results file.
+type Transaction struct {
+	Hash     string                          `json:"hash"`
+	Envelope converter.TransactionEnvelope   `json:"envelope"`
+	Result   converter.TransactionResultPair `json:"result"`
+}
+
+// CheckpointReader joins the three files of a checkpoint by ledger.
+type CheckpointReader struct {
+	headers *LedgerHeaderReader
+	txs     *TransactionReader
+	results *ResultReader
+	// opts holds the passphrase that matches transactions to results by
+	// hash, and the options Read converts with.
+	opts converter.Options
+
+	nextTx     *xdr.TransactionHistoryEntry
+	nextResult *xdr.TransactionHistoryResultEntry
+	txsDone    bool
+	resultDone bool
+}
+
+// LedgerXdr is the unconverted form of Ledger.
+type LedgerXdr struct {
+	Header       xdr.LedgerHeaderHistoryEntry
+	Transactions []TransactionXdr
+}
+
+type TransactionXdr struct {
+	Envelope xdr.TransactionEnvelope
+	Result   xdr.TransactionResultPair
+}
+
+// Read returns the next ledger of the checkpoint, or io.EOF after the last.
+func (c *CheckpointReader) Read() (Ledger, error) {
+	ledger, err := c.ReadXdr()
+	if err != nil {
+		return Ledger{}, err
+	}
+
+	return ConvertLedger(ledger, c.opts)
+}
+
+// ConvertLedger converts a ledger with opts, deriving contract IDs on
+// opts.Passphrase().
+func ConvertLedger(l LedgerXdr, opts converter.Options) (Ledger, error) {
+	var result Ledger
+
+	header, err := converter.ConvertLedgerHeaderHistoryEntry(l.Header, opts)
+	if err != nil {
+		return result, err
+	}
+
+	result.Sequence = uint32(l.Header.Header.LedgerSeq)
+	result.Header = header
+
+	for _, tx := range l.Transactions {
+		envelope, err := converter.ConvertTransactionEnvelopeWithOptions(tx.Envelope, opts)
+		if err != nil {
+			return result, err
+		}
+
+		rs, err := converter.ConvertTransactionResultPairWithOptions(tx.Result, opts)
+		if err != nil {
+			return result, err
+		}
+
+		result.Transactions = append(result.Transactions, Transaction{
+			Hash:     tx.Result.TransactionHash.HexString(),
+			Envelope: envelope,
+			Result:   rs,
+		})
+	}
+
+	return result, nil
+}
+
+// ReadXdr is Read without the conversion.
+func (c *CheckpointReader) ReadXdr() (LedgerXdr, error) {
+	var result LedgerXdr
+
+	entry, err := c.headers.ReadXdr()
+	if err == io.EOF {
+		return result, c.checkDrained()
+	}
+	if err != nil {
+		return result, err
+	}
+
+	seq := uint32(entry.Header.LedgerSeq)
+	result.Header = entry
+
+	var envelopes []xdr.TransactionEnvelope
+	if err := c.fillTx(); err != nil {
+		return result, err
+	}
+	if c.nextTx != nil && uint32(c.nextTx.LedgerSeq) <= seq {
+		if uint32(c.nextTx.LedgerSeq) < seq {
+			return result, errors.Errorf("error transactions for ledger %d have no header", c.nextTx.LedgerSeq)
+		}
+		if envelopes, err = TransactionSetEnvelopes(*c.nextTx); err != nil {
+			return result, err
+		}
+		c.nextTx = nil
+	}
+
+	var pairs []xdr.TransactionResultPair
+	if err := c.fillResult(); err != nil {
+		return result, err
+	}
+	if c.nextResult != nil && uint32(c.nextResult.LedgerSeq) <= seq {
+		if uint32(c.nextResult.LedgerSeq) < seq {
+			return result, errors.Errorf("error results for ledger %d have no header", c.nextResult.LedgerSeq)
+		}
+		pairs = c.nextResult.TxResultSet.Results
+		c.nextResult = nil
+	}
+
+	txs, err := joinTransactions(seq, envelopes, pairs, c.opts.Passphrase())
+	if err != nil {
+		return result, err
+	}
+	result.Transactions = txs
+
+	return result, nil
+}
+
+func (c *CheckpointReader) fillTx() error {
+	if c.nextTx != nil || c.txsDone {
+		return nil
+	}
+
+	entry, err := c.txs.ReadXdr()
+	if err == io.EOF {
+		c.txsDone = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c.nextTx = &entry
+	return nil
+}
+
+func (c *CheckpointReader) fillResult() error {
+	if c.nextResult != nil || c.resultDone {
+		return nil
+	}
+
+	entry, err := c.results.ReadXdr()
+	if err == io.EOF {
+		c.resultDone = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c.nextResult = &entry
+	return nil
+}
+
+// checkDrained reports transactions or results left over after the last
+// header, and io.EOF otherwise.
+func (c *CheckpointReader) checkDrained() error {
+	if err := c.fillTx(); err != nil {
+		return err
+	}
+	if c.nextTx != nil {
+		return errors.Errorf("error transactions for ledger %d have no header", c.nextTx.LedgerSeq)
+	}
+
+	if err := c.fillResult(); err != nil {
+		return err
+	}
+	if c.nextResult != nil {
+		return errors.Errorf("error results for ledger %d have no header", c.nextResult.LedgerSeq)
+	}
+
+	return io.EOF
+}
+
+func (c *CheckpointReader) Close() error {
+	errHeaders := c.headers.Close()
+	errTxs := c.txs.Close()
+	errResults := c.results.Close()
+
+	if errHeaders != nil {
+		return errHeaders
+	}
+	if errTxs != nil {
+		return errTxs
+	}
+	return errResults
+}
+
+// joinTransactions matches envelopes to results by their transaction hash on
+// the network identified by passphrase.
+func joinTransactions(seq uint32, envelopes []xdr.TransactionEnvelope, pairs []xdr.TransactionResultPair, passphrase string) ([]TransactionXdr, error) {
+	if len(envelopes) != len(pairs) {
+		return nil, errors.Errorf("error ledger %d has %d transactions but %d results", seq, len(envelopes), len(pairs))
+	}
+
+	byHash := make(map[xdr.Hash]xdr.TransactionEnvelope, len(envelopes))
+	for _, env := range envelopes {
+		hash, err := network.HashTransactionInEnvelope(env, converter.PassphraseOrDefault(passphrase))
+		if err != nil {
+			return nil, err
+		}
+		byHash[hash] = env
+	}
+
+	var result []TransactionXdr
+	for _, pair := range pairs {
+		env, ok := byHash[pair.TransactionHash]
+		if !ok {
+			return nil, errors.Errorf("error ledger %d has a result for unknown transaction %s, check the network passphrase", seq, pair.TransactionHash.HexString())
+		}
+
+		result = append(result, TransactionXdr{Envelope: env, Result: pair})
+	}
+
+	return result, nil
+}
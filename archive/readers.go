@@ -0,0 +1,179 @@
+package archive
+
+import (
+	"io"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/stellar/go/xdr"
+)
+
+type TransactionHistoryEntry struct {
+	LedgerSeq    uint32                          `json:"ledger_seq,omitempty"`
+	Transactions []converter.TransactionEnvelope `json:"transactions,omitempty"`
+}
+
+type TransactionHistoryResultEntry struct {
+	LedgerSeq uint32                            `json:"ledger_seq,omitempty"`
+	Results   []converter.TransactionResultPair `json:"results,omitempty"`
+}
+
+// LedgerHeaderReader reads a ledger-*.xdr.gz file.
+type LedgerHeaderReader struct {
+	s *xdrstream.Reader
+	// EnumNames makes Read add enum names, see converter.Options.EnumNames.
+	EnumNames bool
+}
+
+func NewLedgerHeaderReader(r io.Reader) *LedgerHeaderReader {
+	return &LedgerHeaderReader{s: xdrstream.NewReader(r)}
+}
+
+func OpenLedgerHeaders(path string) (*LedgerHeaderReader, error) {
+	s, err := xdrstream.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LedgerHeaderReader{s: s}, nil
+}
+
+// ReadXdr returns the next entry, or io.EOF at the end of the file.
+func (r *LedgerHeaderReader) ReadXdr() (xdr.LedgerHeaderHistoryEntry, error) {
+	var entry xdr.LedgerHeaderHistoryEntry
+	err := r.s.Read(&entry)
+	return entry, err
+}
+
+func (r *LedgerHeaderReader) Read() (converter.LedgerHeaderHistoryEntry, error) {
+	entry, err := r.ReadXdr()
+	if err != nil {
+		return converter.LedgerHeaderHistoryEntry{}, err
+	}
+
+	return converter.ConvertLedgerHeaderHistoryEntry(entry, converter.Options{EnumNames: r.EnumNames})
+}
+
+func (r *LedgerHeaderReader) Close() error {
+	return r.s.Close()
+}
+
+// TransactionReader reads a transactions-*.xdr.gz file.
+type TransactionReader struct {
+	s *xdrstream.Reader
+	// NetworkPassphrase is the network Read derives contract IDs on. The
+	// constructors set it to converter.DefaultNetworkPassphrase.
+	NetworkPassphrase string
+	// EnumNames makes Read add enum names, see converter.Options.EnumNames.
+	EnumNames bool
+}
+
+func NewTransactionReader(r io.Reader) *TransactionReader {
+	return &TransactionReader{s: xdrstream.NewReader(r), NetworkPassphrase: converter.DefaultNetworkPassphrase}
+}
+
+func OpenTransactions(path string) (*TransactionReader, error) {
+	s, err := xdrstream.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionReader{s: s, NetworkPassphrase: converter.DefaultNetworkPassphrase}, nil
+}
+
+// ReadXdr returns the next entry, or io.EOF at the end of the file.
+func (r *TransactionReader) ReadXdr() (xdr.TransactionHistoryEntry, error) {
+	var entry xdr.TransactionHistoryEntry
+	err := r.s.Read(&entry)
+	return entry, err
+}
+
+func (r *TransactionReader) Read() (TransactionHistoryEntry, error) {
+	var result TransactionHistoryEntry
+
+	entry, err := r.ReadXdr()
+	if err != nil {
+		return result, err
+	}
+
+	envelopes, err := TransactionSetEnvelopes(entry)
+	if err != nil {
+		return result, err
+	}
+
+	result.LedgerSeq = uint32(entry.LedgerSeq)
+	for _, env := range envelopes {
+		tx, err := converter.ConvertTransactionEnvelopeWithOptions(env, converter.Options{NetworkPassphrase: r.NetworkPassphrase, EnumNames: r.EnumNames})
+		if err != nil {
+			return result, err
+		}
+		result.Transactions = append(result.Transactions, tx)
+	}
+
+	return result, nil
+}
+
+func (r *TransactionReader) Close() error {
+	return r.s.Close()
+}
+
+// ResultReader reads a results-*.xdr.gz file.
+type ResultReader struct {
+	s *xdrstream.Reader
+	// EnumNames makes Read add enum names, see converter.Options.EnumNames.
+	EnumNames bool
+}
+
+func NewResultReader(r io.Reader) *ResultReader {
+	return &ResultReader{s: xdrstream.NewReader(r)}
+}
+
+func OpenResults(path string) (*ResultReader, error) {
+	s, err := xdrstream.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultReader{s: s}, nil
+}
+
+// ReadXdr returns the next entry, or io.EOF at the end of the file.
+func (r *ResultReader) ReadXdr() (xdr.TransactionHistoryResultEntry, error) {
+	var entry xdr.TransactionHistoryResultEntry
+	err := r.s.Read(&entry)
+	return entry, err
+}
+
+func (r *ResultReader) Read() (TransactionHistoryResultEntry, error) {
+	var result TransactionHistoryResultEntry
+
+	entry, err := r.ReadXdr()
+	if err != nil {
+		return result, err
+	}
+
+	result.LedgerSeq = uint32(entry.LedgerSeq)
+	for _, pair := range entry.TxResultSet.Results {
+		rs, err := converter.ConvertTransactionResultPairWithOptions(pair, converter.Options{EnumNames: r.EnumNames})
+		if err != nil {
+			return result, err
+		}
+		result.Results = append(result.Results, rs)
+	}
+
+	return result, nil
+}
+
+func (r *ResultReader) Close() error {
+	return r.s.Close()
+}
+
+// TransactionSetEnvelopes returns the envelopes of an entry's transaction
+// set, taken from the generalized set from protocol 20 on.
+func TransactionSetEnvelopes(e xdr.TransactionHistoryEntry) ([]xdr.TransactionEnvelope, error) {
+	if e.Ext.GeneralizedTxSet != nil {
+		return converter.GeneralizedTransactionSetEnvelopes(*e.Ext.GeneralizedTxSet)
+	}
+
+	return e.TxSet.Txs, nil
+}
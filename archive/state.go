@@ -0,0 +1,112 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RootStatePath is the path of the archive's latest HistoryArchiveState.
+const RootStatePath = ".well-known/stellar-history.json"
+
+const emptyBucketHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// HistoryArchiveState is the JSON document describing the bucket list as of
+// a checkpoint.
+type HistoryArchiveState struct {
+	Version           int                    `json:"version"`
+	Server            string                 `json:"server,omitempty"`
+	CurrentLedger     uint32                 `json:"currentLedger"`
+	NetworkPassphrase string                 `json:"networkPassphrase,omitempty"`
+	CurrentBuckets    []HistoryArchiveBucket `json:"currentBuckets"`
+}
+
+type HistoryArchiveBucket struct {
+	Curr string                     `json:"curr"`
+	Next HistoryArchiveFutureBucket `json:"next"`
+	Snap string                     `json:"snap"`
+}
+
+// HistoryArchiveFutureBucket is a merge in progress. Its output only takes
+// effect at a later ledger, so it is not part of the state at CurrentLedger.
+type HistoryArchiveFutureBucket struct {
+	State  int    `json:"state"`
+	Output string `json:"output,omitempty"`
+}
+
+// Buckets returns the hashes of the non-empty buckets, newest first: the curr
+// then snap bucket of level 0, then of level 1, and so on. An entry in a
+// bucket shadows entries with the same key in every bucket after it.
+func (h HistoryArchiveState) Buckets() []string {
+	var hashes []string
+	for _, level := range h.CurrentBuckets {
+		for _, hash := range []string{level.Curr, level.Snap} {
+			if hash == "" || hash == emptyBucketHash {
+				continue
+			}
+			hashes = append(hashes, hash)
+		}
+	}
+
+	return hashes
+}
+
+// StatePath returns the path of a checkpoint's HistoryArchiveState relative to
+// the archive root, e.g. history/00/12/34/history-0012343f.json.
+func StatePath(checkpoint uint32) string {
+	hex := fmt.Sprintf("%08x", checkpoint)
+	return path.Join("history", hex[0:2], hex[2:4], hex[4:6], "history-"+hex+".json")
+}
+
+// BucketPath returns the path of a bucket file relative to the archive root,
+// e.g. bucket/ab/cd/ef/bucket-abcdef....xdr.gz.
+func BucketPath(hash string) string {
+	return path.Join("bucket", hash[0:2], hash[2:4], hash[4:6], "bucket-"+hash+".xdr.gz")
+}
+
+// ReadState reads the HistoryArchiveState of a checkpoint, or the archive's
+// latest one when checkpoint is zero.
+func (a *Archive) ReadState(checkpoint uint32) (HistoryArchiveState, error) {
+	p := RootStatePath
+	if checkpoint != 0 {
+		p = StatePath(checkpoint)
+	}
+
+	return ReadStateFile(filepath.Join(a.Root, filepath.FromSlash(p)))
+}
+
+func ReadStateFile(path string) (HistoryArchiveState, error) {
+	var result HistoryArchiveState
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(bz, &result); err != nil {
+		return result, errors.Wrapf(err, "error decoding %s", path)
+	}
+
+	for _, hash := range result.Buckets() {
+		if len(hash) != 64 || strings.Trim(hash, "0123456789abcdef") != "" {
+			return result, errors.Errorf("error invalid bucket hash %q in %s", hash, path)
+		}
+	}
+
+	return result, nil
+}
+
+// BucketPaths returns the local paths of the buckets of a state, newest first.
+func (a *Archive) BucketPaths(state HistoryArchiveState) []string {
+	var paths []string
+	for _, hash := range state.Buckets() {
+		paths = append(paths, filepath.Join(a.Root, filepath.FromSlash(BucketPath(hash))))
+	}
+
+	return paths
+}
@@ -0,0 +1,283 @@
+// Package batch converts newline-delimited base64 XDR records to NDJSON on a
+// pool of workers.
+package batch
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultMaxLineBytes = 64 << 20
+	// inFlightPerWorker bounds how many records may wait for an earlier,
+	// slower record before the reader stops reading ahead.
+	inFlightPerWorker = 16
+)
+
+type Options struct {
+	// Type is the XDR type of every record, one of the keys of
+	// converter.MarshalJSONFuncs.
+	Type string
+	// Converter are the options every record is converted with.
+	Converter converter.Options
+	// Workers is the number of records converted in parallel. Zero means
+	// runtime.NumCPU().
+	Workers int
+	// MaxLineBytes limits the length of an input line. Zero means
+	// DefaultMaxLineBytes.
+	MaxLineBytes int
+	// Progress, if set, is called with the running stats about every
+	// ProgressInterval and once more when the batch ends.
+	Progress         func(Stats)
+	ProgressInterval time.Duration
+}
+
+// Stats are the throughput metrics of a batch.
+type Stats struct {
+	Records     int64         `json:"records"`
+	Failed      int64         `json:"failed"`
+	InputBytes  int64         `json:"input_bytes"`
+	OutputBytes int64         `json:"output_bytes"`
+	Elapsed     time.Duration `json:"elapsed"`
+}
+
+func (s Stats) RecordsPerSecond() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(s.Records) / s.Elapsed.Seconds()
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("%d records (%d failed) in %s, %.0f records/s, %d bytes in, %d bytes out",
+		s.Records, s.Failed, s.Elapsed.Round(time.Millisecond), s.RecordsPerSecond(), s.InputBytes, s.OutputBytes)
+}
+
+// ErrorRecord is written in place of a record that failed to convert, so the
+// output keeps one line per non-empty input line.
+type ErrorRecord struct {
+	Line  int64  `json:"line"`
+	Error string `json:"error"`
+}
+
+type job struct {
+	seq  int64
+	line int64
+	data string
+}
+
+type result struct {
+	seq    int64
+	bz     []byte
+	failed bool
+}
+
+// Convert reads base64 XDR records from r, one per line, and writes their
+// JSON to w in input order, one per line. Empty lines are skipped. A record
+// that fails to convert produces an ErrorRecord and does not stop the batch;
+// read and write errors and cancellation of ctx do.
+func Convert(ctx context.Context, r io.Reader, w io.Writer, opts Options) (Stats, error) {
+	marshal, ok := converter.MarshalJSONFuncs[opts.Type]
+	if !ok {
+		return Stats{}, errors.Errorf("error invalid batch type %q", opts.Type)
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	maxLine := opts.MaxLineBytes
+	if maxLine <= 0 {
+		maxLine = DefaultMaxLineBytes
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan job, workers)
+	results := make(chan result, workers)
+	window := make(chan struct{}, workers*inFlightPerWorker)
+
+	var inputBytes atomic.Int64
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(jobs)
+		readErr <- read(ctx, r, maxLine, jobs, window, &inputBytes)
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				select {
+				case results <- convert(marshal, opts.Converter, j):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	stats, err := write(ctx, w, results, window, &inputBytes, opts)
+	if err != nil {
+		cancel()
+		for range results {
+		}
+		return stats, err
+	}
+
+	stats.InputBytes = inputBytes.Load()
+	if opts.Progress != nil {
+		opts.Progress(stats)
+	}
+
+	// Workers stop early on cancellation, closing results while the reader
+	// may still be blocked reading r. Otherwise they drained jobs, so the
+	// reader has returned.
+	if err := ctx.Err(); err != nil {
+		return stats, err
+	}
+
+	return stats, <-readErr
+}
+
+func read(ctx context.Context, r io.Reader, maxLine int, jobs chan<- job, window chan<- struct{}, inputBytes *atomic.Int64) error {
+	scanner := bufio.NewScanner(r)
+	// The scanner allows lines as long as its initial buffer, so that must
+	// not exceed maxLine.
+	scanner.Buffer(make([]byte, 0, min(64*1024, maxLine)), maxLine)
+
+	var seq, line int64
+	for scanner.Scan() {
+		line++
+		inputBytes.Add(int64(len(scanner.Bytes())) + 1)
+
+		data := strings.TrimSpace(scanner.Text())
+		if data == "" {
+			continue
+		}
+
+		select {
+		case window <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		select {
+		case jobs <- job{seq: seq, line: line, data: data}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		seq++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return errors.Wrapf(err, "error reading line %d", line+1)
+	}
+
+	return nil
+}
+
+func convert(marshal func([]byte, converter.Options) ([]byte, error), opts converter.Options, j job) (res result) {
+	res.seq = j.seq
+
+	// A converter panic on one malformed record must not end a backfill.
+	defer func() {
+		if r := recover(); r != nil {
+			res.bz, res.failed = errorRecord(j.line, fmt.Errorf("panic: %v", r)), true
+		}
+	}()
+
+	inp, err := base64.StdEncoding.DecodeString(j.data)
+	if err == nil {
+		res.bz, err = marshal(inp, opts)
+	}
+	if err != nil {
+		res.bz, res.failed = errorRecord(j.line, err), true
+	}
+
+	return res
+}
+
+func errorRecord(line int64, err error) []byte {
+	bz, _ := json.Marshal(ErrorRecord{Line: line, Error: err.Error()})
+	return bz
+}
+
+// write puts results back in input order and writes them to w.
+func write(ctx context.Context, w io.Writer, results <-chan result, window <-chan struct{}, inputBytes *atomic.Int64, opts Options) (Stats, error) {
+	var stats Stats
+	start := time.Now()
+	lastProgress := start
+
+	bw := bufio.NewWriter(w)
+	pending := map[int64]result{}
+	var next int64
+
+	for res := range results {
+		pending[res.seq] = res
+
+		for {
+			res, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			<-window
+
+			n, err := bw.Write(append(res.bz, '\n'))
+			stats.OutputBytes += int64(n)
+			if err != nil {
+				stats.Elapsed = time.Since(start)
+				return stats, err
+			}
+
+			stats.Records++
+			if res.failed {
+				stats.Failed++
+			}
+		}
+
+		if opts.Progress != nil && opts.ProgressInterval > 0 && time.Since(lastProgress) >= opts.ProgressInterval {
+			lastProgress = time.Now()
+			stats.Elapsed = lastProgress.Sub(start)
+			stats.InputBytes = inputBytes.Load()
+			opts.Progress(stats)
+		}
+
+		if err := ctx.Err(); err != nil {
+			// Keep what was converted so far; stats count it.
+			stats.Elapsed = time.Since(start)
+			bw.Flush()
+			return stats, err
+		}
+	}
+
+	stats.Elapsed = time.Since(start)
+	if err := bw.Flush(); err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
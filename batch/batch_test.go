@@ -0,0 +1,143 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+)
+
+func u32Base64(t *testing.T, v uint32) string {
+	t.Helper()
+
+	u := xdr.Uint32(v)
+	s, err := xdr.MarshalBase64(xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &u})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestConvertOrder(t *testing.T) {
+	const n = 2000
+
+	// Every 100th record is invalid base64 and every 7th an empty line.
+	var input strings.Builder
+	var wantErrorLines []int64
+	line := int64(0)
+	for i := 0; i < n; i++ {
+		if i%7 == 0 {
+			input.WriteString("\n")
+			line++
+		}
+		line++
+		if i%100 == 50 {
+			input.WriteString("!!not base64\n")
+			wantErrorLines = append(wantErrorLines, line)
+			continue
+		}
+		fmt.Fprintf(&input, "%s\n", u32Base64(t, uint32(i)))
+	}
+
+	var out bytes.Buffer
+	stats, err := Convert(context.Background(), strings.NewReader(input.String()), &out, Options{Type: "scval", Workers: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Records != n || stats.Failed != int64(len(wantErrorLines)) {
+		t.Errorf("got %d records, %d failed, want %d and %d", stats.Records, stats.Failed, n, len(wantErrorLines))
+	}
+	if stats.InputBytes != int64(input.Len()) || stats.OutputBytes != int64(out.Len()) {
+		t.Errorf("got %d bytes in and %d out, want %d and %d", stats.InputBytes, stats.OutputBytes, input.Len(), out.Len())
+	}
+
+	scanner := bufio.NewScanner(&out)
+	var i int
+	var errorLines []int64
+	for ; scanner.Scan(); i++ {
+		var record struct {
+			U32   *uint32 `json:"u32"`
+			Line  int64   `json:"line"`
+			Error string  `json:"error"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("output line %d: %v", i+1, err)
+		}
+		if record.Error != "" {
+			errorLines = append(errorLines, record.Line)
+			continue
+		}
+		if record.U32 == nil || *record.U32 != uint32(i) {
+			t.Fatalf("output line %d: got %s, want u32 %d", i+1, scanner.Bytes(), i)
+		}
+	}
+	if i != n {
+		t.Errorf("got %d output lines, want %d", i, n)
+	}
+	if fmt.Sprint(errorLines) != fmt.Sprint(wantErrorLines) {
+		t.Errorf("got error records for lines %v, want %v", errorLines, wantErrorLines)
+	}
+}
+
+func TestConvertErrorRecords(t *testing.T) {
+	// A ledger key is not an ScVal, and the ScVal vec has no body.
+	input := strings.Join([]string{u32Base64(t, 1), "AAAAAw==", "AAAAEAAAAAA=", u32Base64(t, 2)}, "\n")
+
+	var out bytes.Buffer
+	stats, err := Convert(context.Background(), strings.NewReader(input), &out, Options{Type: "scval", Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Records != 4 || stats.Failed != 2 {
+		t.Errorf("got %d records, %d failed", stats.Records, stats.Failed)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 4 || lines[0] != `{"u32":1}` || lines[3] != `{"u32":2}` {
+		t.Fatalf("got %q", lines)
+	}
+	for i, want := range []int64{2, 3} {
+		var record ErrorRecord
+		if err := json.Unmarshal([]byte(lines[i+1]), &record); err != nil {
+			t.Fatal(err)
+		}
+		if record.Line != want || record.Error == "" {
+			t.Errorf("got %+v, want an error record of line %d", record, want)
+		}
+	}
+}
+
+func TestConvertFailures(t *testing.T) {
+	if _, err := Convert(context.Background(), strings.NewReader(""), &bytes.Buffer{}, Options{Type: "nope"}); err == nil {
+		t.Error("got no error for an unknown type")
+	}
+
+	input := u32Base64(t, 1) + "\n" + strings.Repeat("A", 100) + "\n"
+	_, err := Convert(context.Background(), strings.NewReader(input), &bytes.Buffer{}, Options{Type: "scval", MaxLineBytes: 64})
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("got %v, want an error reading line 2", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Convert(ctx, strings.NewReader(u32Base64(t, 1)), &bytes.Buffer{}, Options{Type: "scval"}); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestConvertProgress(t *testing.T) {
+	var calls []Stats
+	opts := Options{Type: "scval", Progress: func(s Stats) { calls = append(calls, s) }}
+	if _, err := Convert(context.Background(), strings.NewReader(u32Base64(t, 1)+"\n"), &bytes.Buffer{}, opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 1 || calls[0].Records != 1 {
+		t.Errorf("got progress %+v, want the final stats once", calls)
+	}
+}
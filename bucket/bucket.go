@@ -0,0 +1,196 @@
+// Package bucket reads bucket files, the ledger state snapshots of a history
+// archive, and resolves a HistoryArchiveState bucket list into the live
+// ledger entries it holds.
+//
+// A bucket file is an RFC 5531 record-marked stream of BucketEntry records:
+// an optional METAENTRY followed by LIVEENTRY, INITENTRY and DEADENTRY
+// records sorted by ledger key.
+package bucket
+
+import (
+	"crypto/sha256"
+	"io"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// Reader reads a bucket-*.xdr.gz file.
+type Reader struct {
+	s *xdrstream.Reader
+	// EnumNames makes Read add enum names, see converter.Options.EnumNames.
+	EnumNames bool
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{s: xdrstream.NewReader(r)}
+}
+
+func Open(path string) (*Reader, error) {
+	s, err := xdrstream.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{s: s}, nil
+}
+
+// ReadXdr returns the next entry, or io.EOF at the end of the file.
+func (r *Reader) ReadXdr() (xdr.BucketEntry, error) {
+	var entry xdr.BucketEntry
+	err := r.s.Read(&entry)
+	return entry, err
+}
+
+func (r *Reader) Read() (converter.BucketEntry, error) {
+	entry, err := r.ReadXdr()
+	if err != nil {
+		return converter.BucketEntry{}, err
+	}
+
+	return converter.ConvertBucketEntry(entry, converter.Options{EnumNames: r.EnumNames})
+}
+
+func (r *Reader) Close() error {
+	return r.s.Close()
+}
+
+// LiveEntry is a ledger entry that is live in the state of a bucket list.
+type LiveEntry struct {
+	Type  string                `json:"type"`
+	Entry converter.LedgerEntry `json:"entry"`
+}
+
+// TypeName returns the name LiveEntry.Type uses for an entry type, the XDR
+// name in lower case, e.g. account or contract_data.
+func TypeName(t xdr.LedgerEntryType) string {
+	return strings.ToLower(converter.XdrEnumName(t))
+}
+
+// ParseType is the inverse of TypeName.
+func ParseType(name string) (xdr.LedgerEntryType, error) {
+	for t := range xdr.LedgerEntryTypeMap {
+		if TypeName(xdr.LedgerEntryType(t)) == name {
+			return xdr.LedgerEntryType(t), nil
+		}
+	}
+
+	return 0, errors.Errorf("error unknown ledger entry type %q", name)
+}
+
+// ReadLiveEntries calls fn with every live entry of the buckets at paths,
+// which must be ordered newest first as returned by archive.BucketPaths. The
+// first entry found for a key shadows the entries for that key in later
+// buckets; when it is a DEADENTRY the key is not live at all.
+//
+// When types is not empty only entries of those types are read. The hashes
+// of the keys seen are kept in memory, 32 bytes per key of the selected
+// types. Entries are converted with opts.
+func ReadLiveEntries(paths []string, types []xdr.LedgerEntryType, opts converter.Options, fn func(LiveEntry) error) error {
+	return ReadLiveEntriesXdr(paths, types, func(e xdr.LedgerEntry) error {
+		converted, err := converter.ConvertLedgerEntryWithOptions(e, opts)
+		if err != nil {
+			return err
+		}
+
+		return fn(LiveEntry{Type: TypeName(e.Data.Type), Entry: converted})
+	})
+}
+
+// ReadLiveEntriesXdr is ReadLiveEntries without converting the entries.
+func ReadLiveEntriesXdr(paths []string, types []xdr.LedgerEntryType, fn func(xdr.LedgerEntry) error) error {
+	l := liveEntries{seen: make(map[[32]byte]struct{}), fn: fn}
+	if len(types) > 0 {
+		l.types = make(map[xdr.LedgerEntryType]bool, len(types))
+		for _, t := range types {
+			l.types[t] = true
+		}
+	}
+
+	for _, path := range paths {
+		if err := l.readBucket(path); err != nil {
+			return errors.Wrapf(err, "error reading bucket %s", path)
+		}
+	}
+
+	return nil
+}
+
+type liveEntries struct {
+	types map[xdr.LedgerEntryType]bool
+	seen  map[[32]byte]struct{}
+	buf   *xdr.EncodingBuffer
+	fn    func(xdr.LedgerEntry) error
+}
+
+func (l *liveEntries) readBucket(path string) error {
+	r, err := Open(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if l.buf == nil {
+		l.buf = xdr.NewEncodingBuffer()
+	}
+
+	for {
+		entry, err := r.ReadXdr()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var key xdr.LedgerKey
+		switch entry.Type {
+		case xdr.BucketEntryTypeLiveentry, xdr.BucketEntryTypeInitentry:
+			key, err = entry.LiveEntry.LedgerKey()
+			if err != nil {
+				return err
+			}
+		case xdr.BucketEntryTypeDeadentry:
+			key = *entry.DeadEntry
+		case xdr.BucketEntryTypeMetaentry:
+			continue
+		default:
+			return errors.Errorf("error invalid BucketEntry type %v", entry.Type)
+		}
+
+		if l.types != nil && !l.types[key.Type] {
+			continue
+		}
+
+		shadowed, err := l.see(key)
+		if err != nil {
+			return err
+		}
+		if shadowed || entry.Type == xdr.BucketEntryTypeDeadentry {
+			continue
+		}
+
+		if err := l.fn(*entry.LiveEntry); err != nil {
+			return err
+		}
+	}
+}
+
+// see records key and reports whether a newer bucket already had it.
+func (l *liveEntries) see(key xdr.LedgerKey) (bool, error) {
+	bz, err := l.buf.LedgerKeyUnsafeMarshalBinaryCompress(key)
+	if err != nil {
+		return false, err
+	}
+
+	hash := sha256.Sum256(bz)
+	if _, ok := l.seen[hash]; ok {
+		return true, nil
+	}
+	l.seen[hash] = struct{}{}
+
+	return false, nil
+}
@@ -0,0 +1,160 @@
+package bucket
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/stellar/go/xdr"
+)
+
+func account(b byte, balance int64) xdr.LedgerEntry {
+	return xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+		Type: xdr.LedgerEntryTypeAccount,
+		Account: &xdr.AccountEntry{
+			AccountId: xdr.AccountId{Type: xdr.PublicKeyTypePublicKeyTypeEd25519, Ed25519: &xdr.Uint256{b}},
+			Balance:   xdr.Int64(balance),
+		},
+	}}
+}
+
+func offer(id int64) xdr.LedgerEntry {
+	return xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+		Type: xdr.LedgerEntryTypeOffer,
+		Offer: &xdr.OfferEntry{
+			SellerId: xdr.AccountId{Type: xdr.PublicKeyTypePublicKeyTypeEd25519, Ed25519: &xdr.Uint256{9}},
+			OfferId:  xdr.Int64(id),
+			Selling:  xdr.MustNewNativeAsset(),
+			Buying:   xdr.MustNewNativeAsset(),
+			Price:    xdr.Price{N: 1, D: 1},
+		},
+	}}
+}
+
+func live(e xdr.LedgerEntry) xdr.BucketEntry {
+	return xdr.BucketEntry{Type: xdr.BucketEntryTypeLiveentry, LiveEntry: &e}
+}
+
+func initEntry(e xdr.LedgerEntry) xdr.BucketEntry {
+	return xdr.BucketEntry{Type: xdr.BucketEntryTypeInitentry, LiveEntry: &e}
+}
+
+func dead(t *testing.T, e xdr.LedgerEntry) xdr.BucketEntry {
+	t.Helper()
+
+	key, err := e.LedgerKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return xdr.BucketEntry{Type: xdr.BucketEntryTypeDeadentry, DeadEntry: &key}
+}
+
+func meta() xdr.BucketEntry {
+	return xdr.BucketEntry{Type: xdr.BucketEntryTypeMetaentry, MetaEntry: &xdr.BucketMetadata{LedgerVersion: 21}}
+}
+
+func writeBucket(t *testing.T, name string, entries ...xdr.BucketEntry) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	for _, e := range entries {
+		bz, err := e.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := xdrstream.WriteRecord(&buf, bz); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// testBuckets are newest first: account 1 is updated by the newest bucket,
+// account 2 deleted by it, and account 3 and offer 7 only in older ones.
+func testBuckets(t *testing.T) []string {
+	return []string{
+		writeBucket(t, "newest.xdr", meta(), live(account(1, 30)), dead(t, account(2, 0))),
+		writeBucket(t, "middle.xdr", meta(), live(account(1, 20)), live(account(2, 10)), initEntry(account(3, 5))),
+		writeBucket(t, "oldest.xdr", live(account(1, 10)), dead(t, account(3, 0)), live(offer(7))),
+	}
+}
+
+func TestReadLiveEntries(t *testing.T) {
+	balances := map[byte]int64{}
+	var offers int
+	err := ReadLiveEntriesXdr(testBuckets(t), nil, func(e xdr.LedgerEntry) error {
+		switch e.Data.Type {
+		case xdr.LedgerEntryTypeAccount:
+			b := e.Data.Account.AccountId.Ed25519[0]
+			if _, ok := balances[b]; ok {
+				t.Errorf("account %d read twice", b)
+			}
+			balances[b] = int64(e.Data.Account.Balance)
+		case xdr.LedgerEntryTypeOffer:
+			offers++
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The DEADENTRY of account 3 in the oldest bucket is shadowed by the
+	// INITENTRY of the middle one.
+	if len(balances) != 2 || balances[1] != 30 || balances[3] != 5 {
+		t.Errorf("got account balances %v, want 1: 30 and 3: 5", balances)
+	}
+	if offers != 1 {
+		t.Errorf("got %d offers, want 1", offers)
+	}
+}
+
+func TestReadLiveEntriesTypes(t *testing.T) {
+	var got []LiveEntry
+	err := ReadLiveEntries(testBuckets(t), []xdr.LedgerEntryType{xdr.LedgerEntryTypeOffer}, converter.Options{}, func(e LiveEntry) error {
+		got = append(got, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Type != "offer" || got[0].Entry.Data.Offer == nil {
+		t.Errorf("got %+v, want offer 7", got)
+	}
+
+	stop := errors.New("stop")
+	err = ReadLiveEntriesXdr(testBuckets(t), nil, func(xdr.LedgerEntry) error { return stop })
+	if !errors.Is(err, stop) {
+		t.Errorf("got %v, want the error of fn", err)
+	}
+
+	if err := ReadLiveEntriesXdr([]string{filepath.Join(t.TempDir(), "missing.xdr")}, nil, nil); err == nil {
+		t.Error("got no error for a missing bucket")
+	}
+}
+
+func TestParseType(t *testing.T) {
+	for _, typ := range []xdr.LedgerEntryType{xdr.LedgerEntryTypeAccount, xdr.LedgerEntryTypeContractData, xdr.LedgerEntryTypeTtl} {
+		got, err := ParseType(TypeName(typ))
+		if err != nil || got != typ {
+			t.Errorf("%s: got %v, %v", TypeName(typ), got, err)
+		}
+	}
+	if TypeName(xdr.LedgerEntryTypeContractData) != "contract_data" {
+		t.Errorf("got %s", TypeName(xdr.LedgerEntryTypeContractData))
+	}
+	if _, err := ParseType("nope"); err == nil {
+		t.Error("got no error for an unknown type")
+	}
+}
@@ -0,0 +1,326 @@
+// Package calltrace rebuilds the contract call tree of a Soroban transaction
+// from its diagnostic events.
+//
+// The host brackets every contract call with a fn_call event, raised by the
+// calling contract (or by no contract for the invoked one) with the called
+// contract and function as topics and the args as data, and a fn_return
+// event, raised by the called contract with the return value as data. A call
+// that fails has no fn_return: it ends where the next event comes from one of
+// its callers. log and error events, and the contract and system events the
+// contracts emit, carry the id of the contract they come from, which places
+// them in the innermost call of that contract.
+package calltrace
+
+import (
+	"strings"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+// Trace is the call tree of a transaction. Events, logs and errors raised
+// outside of any contract call are kept at the top level, and Metrics holds
+// the values of the core_metrics events stellar-core appends.
+type Trace struct {
+	Calls   []*Frame          `json:"calls,omitempty"`
+	Events  []Event           `json:"events,omitempty"`
+	Logs    []converter.ScVal `json:"logs,omitempty"`
+	Errors  []CallError       `json:"errors,omitempty"`
+	Metrics map[string]uint64 `json:"metrics,omitempty"`
+}
+
+// Frame is a contract call. ReturnValue is nil for calls that did not
+// return; Failed is set for those and for calls the host rolled back.
+type Frame struct {
+	ContractId  string            `json:"contract_id"`
+	Function    string            `json:"function"`
+	Args        []converter.ScVal `json:"args,omitempty"`
+	ReturnValue *converter.ScVal  `json:"return_value,omitempty"`
+	Failed      bool              `json:"failed"`
+	Events      []Event           `json:"events,omitempty"`
+	Logs        []converter.ScVal `json:"logs,omitempty"`
+	Errors      []CallError       `json:"errors,omitempty"`
+	Calls       []*Frame          `json:"calls,omitempty"`
+}
+
+// Event is a contract or system event emitted during a call, or a diagnostic
+// event the trace does not otherwise interpret.
+type Event struct {
+	Type   string            `json:"type"`
+	Topics []converter.ScVal `json:"topics,omitempty"`
+	Data   converter.ScVal   `json:"data"`
+}
+
+// CallError is an error event. Error is the error the host raised and Data
+// its message, or a vec of the message and its args.
+type CallError struct {
+	Error converter.ScVal `json:"error"`
+	Data  converter.ScVal `json:"data"`
+}
+
+// FromTransactionMeta builds the trace of the diagnostic events of a
+// transaction's meta. Meta before V3 has no diagnostic events.
+func FromTransactionMeta(m xdr.TransactionMeta) (Trace, error) {
+	switch m.V {
+	case 0, 1, 2:
+		return Trace{}, nil
+	case 3:
+		if m.V3.SorobanMeta == nil {
+			return Trace{}, nil
+		}
+		return Build(m.V3.SorobanMeta.DiagnosticEvents)
+	case 4:
+		return Build(m.V4.DiagnosticEvents)
+	}
+
+	return Trace{}, errors.Errorf("error invalid TransactionMeta type %v", m.V)
+}
+
+// Build builds the trace of diagnostic events in the order the host raised
+// them.
+func Build(events []xdr.DiagnosticEvent) (Trace, error) {
+	b := builder{}
+	for i, e := range events {
+		if err := b.add(e); err != nil {
+			return b.trace, errors.Wrapf(err, "error in diagnostic event %d", i)
+		}
+	}
+
+	// Calls still open when the events end never returned.
+	b.unwind(0)
+
+	return b.trace, nil
+}
+
+type builder struct {
+	trace Trace
+	stack []*Frame
+}
+
+func (b *builder) add(e xdr.DiagnosticEvent) error {
+	event := e.Event
+	if event.Body.V0 == nil {
+		return errors.Errorf("error invalid contract event body version %d", event.Body.V)
+	}
+	topics, data := event.Body.V0.Topics, event.Body.V0.Data
+
+	contractId, err := eventContractId(event)
+	if err != nil {
+		return err
+	}
+
+	var name xdr.ScSymbol
+	if event.Type == xdr.ContractEventTypeDiagnostic && len(topics) > 0 {
+		name, _ = topics[0].GetSym()
+	}
+
+	switch name {
+	case "fn_call":
+		return b.call(contractId, topics, data, e.InSuccessfulContractCall)
+	case "fn_return":
+		return b.ret(contractId, topics, data)
+	case "log":
+		converted, err := converter.ConvertScVal(data)
+		if err != nil {
+			return err
+		}
+
+		if frame := b.frame(contractId); frame != nil {
+			frame.Logs = append(frame.Logs, converted)
+		} else {
+			b.trace.Logs = append(b.trace.Logs, converted)
+		}
+		return nil
+	case "error":
+		if len(topics) < 2 {
+			return errors.New("error error event without an error topic")
+		}
+		callErr, err := convertCallError(topics[1], data)
+		if err != nil {
+			return err
+		}
+
+		if frame := b.frame(contractId); frame != nil {
+			frame.Errors = append(frame.Errors, callErr)
+		} else {
+			b.trace.Errors = append(b.trace.Errors, callErr)
+		}
+		return nil
+	case "core_metrics":
+		if len(topics) < 2 {
+			return errors.New("error core_metrics event without a metric topic")
+		}
+		metric, _ := topics[1].GetSym()
+		value, _ := data.GetU64()
+
+		if b.trace.Metrics == nil {
+			b.trace.Metrics = make(map[string]uint64)
+		}
+		b.trace.Metrics[string(metric)] = uint64(value)
+		return nil
+	}
+
+	converted, err := convertEvent(event)
+	if err != nil {
+		return err
+	}
+
+	if frame := b.frame(contractId); frame != nil {
+		frame.Events = append(frame.Events, converted)
+	} else {
+		b.trace.Events = append(b.trace.Events, converted)
+	}
+
+	return nil
+}
+
+// call opens the call a fn_call event raised by caller describes.
+func (b *builder) call(caller string, topics xdr.ScVec, data xdr.ScVal, successful bool) error {
+	if len(topics) < 3 {
+		return errors.New("error fn_call event without contract and function topics")
+	}
+
+	called, ok := topics[1].GetBytes()
+	if !ok || len(called) != 32 {
+		return errors.New("error fn_call event contract topic is not a contract id")
+	}
+	contractId, err := strkey.Encode(strkey.VersionByteContract, called)
+	if err != nil {
+		return err
+	}
+	function, _ := topics[2].GetSym()
+
+	// The args are a vec, except in events of old hosts that passed a
+	// single arg as is.
+	args := []xdr.ScVal{data}
+	if vec, ok := data.GetVec(); ok && vec != nil {
+		args = *vec
+	}
+
+	frame := &Frame{ContractId: contractId, Function: string(function), Failed: !successful}
+	for _, arg := range args {
+		converted, err := converter.ConvertScVal(arg)
+		if err != nil {
+			return err
+		}
+		frame.Args = append(frame.Args, converted)
+	}
+
+	// Calls of contracts deeper than the caller have failed.
+	if caller == "" {
+		b.unwind(0)
+	} else if i := b.find(caller, ""); i >= 0 {
+		b.unwind(i + 1)
+	}
+
+	if n := len(b.stack); n > 0 {
+		b.stack[n-1].Calls = append(b.stack[n-1].Calls, frame)
+	} else {
+		b.trace.Calls = append(b.trace.Calls, frame)
+	}
+	b.stack = append(b.stack, frame)
+
+	return nil
+}
+
+// ret closes the call a fn_return event describes.
+func (b *builder) ret(contractId string, topics xdr.ScVec, data xdr.ScVal) error {
+	if len(topics) < 2 {
+		return errors.New("error fn_return event without a function topic")
+	}
+	function, _ := topics[1].GetSym()
+
+	// A return without its call, as when the events were cut short at the
+	// start, has no frame to close.
+	i := b.find(contractId, string(function))
+	if i < 0 {
+		return nil
+	}
+
+	value, err := converter.ConvertScVal(data)
+	if err != nil {
+		return err
+	}
+
+	frame := b.stack[i]
+	frame.ReturnValue = &value
+	b.unwind(i + 1)
+	b.stack = b.stack[:i]
+
+	return nil
+}
+
+// frame returns the innermost open call of the contract an event came from,
+// ending the calls it made that are still open. Events of no contract, and of
+// contracts without an open call, go to the innermost call, if any.
+func (b *builder) frame(contractId string) *Frame {
+	if contractId != "" {
+		if i := b.find(contractId, ""); i >= 0 {
+			b.unwind(i + 1)
+		}
+	}
+
+	if n := len(b.stack); n > 0 {
+		return b.stack[n-1]
+	}
+
+	return nil
+}
+
+// find returns the index in the stack of the innermost open call of the
+// contract, and of the function unless it is empty, or -1.
+func (b *builder) find(contractId, function string) int {
+	for i := len(b.stack) - 1; i >= 0; i-- {
+		frame := b.stack[i]
+		if frame.ContractId == contractId && (function == "" || frame.Function == function) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// unwind ends the open calls from depth on as failed, since they ended
+// without returning.
+func (b *builder) unwind(depth int) {
+	for _, frame := range b.stack[depth:] {
+		frame.Failed = true
+	}
+	b.stack = b.stack[:depth]
+}
+
+func eventContractId(e xdr.ContractEvent) (string, error) {
+	if e.ContractId == nil {
+		return "", nil
+	}
+
+	return strkey.Encode(strkey.VersionByteContract, e.ContractId[:])
+}
+
+func convertEvent(e xdr.ContractEvent) (Event, error) {
+	result := Event{Type: strings.ToLower(converter.XdrEnumName(e.Type))}
+
+	body, err := converter.ConvertContractEventV0(*e.Body.V0)
+	if err != nil {
+		return result, err
+	}
+	result.Topics = body.Topics
+	result.Data = body.Data
+
+	return result, nil
+}
+
+func convertCallError(errTopic xdr.ScVal, data xdr.ScVal) (CallError, error) {
+	var result CallError
+
+	converted, err := converter.ConvertScVal(errTopic)
+	if err != nil {
+		return result, err
+	}
+	result.Error = converted
+
+	result.Data, err = converter.ConvertScVal(data)
+	return result, err
+}
@@ -0,0 +1,269 @@
+package calltrace
+
+import (
+	"testing"
+
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+var (
+	contractA = xdr.ContractId{0xaa}
+	contractB = xdr.ContractId{0xbb}
+)
+
+func contractId(id xdr.ContractId) string {
+	return strkey.MustEncode(strkey.VersionByteContract, id[:])
+}
+
+func symbol(s string) xdr.ScVal {
+	sym := xdr.ScSymbol(s)
+	return xdr.ScVal{Type: xdr.ScValTypeScvSymbol, Sym: &sym}
+}
+
+func u32(v uint32) xdr.ScVal {
+	u := xdr.Uint32(v)
+	return xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &u}
+}
+
+func vec(vals ...xdr.ScVal) xdr.ScVal {
+	v := xdr.ScVec(vals)
+	p := &v
+	return xdr.ScVal{Type: xdr.ScValTypeScvVec, Vec: &p}
+}
+
+func event(typ xdr.ContractEventType, from *xdr.ContractId, data xdr.ScVal, topics ...xdr.ScVal) xdr.DiagnosticEvent {
+	var id *xdr.ContractId
+	if from != nil {
+		c := *from
+		id = &c
+	}
+
+	return xdr.DiagnosticEvent{
+		InSuccessfulContractCall: true,
+		Event: xdr.ContractEvent{
+			Type:       typ,
+			ContractId: id,
+			Body:       xdr.ContractEventBody{V0: &xdr.ContractEventV0{Topics: topics, Data: data}},
+		},
+	}
+}
+
+// fnCall is the fn_call event of caller, nil for the invoked contract,
+// calling function of called.
+func fnCall(caller *xdr.ContractId, called xdr.ContractId, function string, args ...xdr.ScVal) xdr.DiagnosticEvent {
+	id := xdr.ScBytes(called[:])
+	return event(xdr.ContractEventTypeDiagnostic, caller, vec(args...),
+		symbol("fn_call"), xdr.ScVal{Type: xdr.ScValTypeScvBytes, Bytes: &id}, symbol(function))
+}
+
+func fnReturn(called xdr.ContractId, function string, value xdr.ScVal) xdr.DiagnosticEvent {
+	return event(xdr.ContractEventTypeDiagnostic, &called, value, symbol("fn_return"), symbol(function))
+}
+
+func logEvent(from *xdr.ContractId, message string) xdr.DiagnosticEvent {
+	return event(xdr.ContractEventTypeDiagnostic, from, symbol(message), symbol("log"))
+}
+
+func errorEvent(from *xdr.ContractId, code uint32) xdr.DiagnosticEvent {
+	contractCode := xdr.Uint32(code)
+	scErr := xdr.ScVal{Type: xdr.ScValTypeScvError, Error: &xdr.ScError{Type: xdr.ScErrorTypeSceContract, ContractCode: &contractCode}}
+	return event(xdr.ContractEventTypeDiagnostic, from, symbol("escalating error"), symbol("error"), scErr)
+}
+
+func build(t *testing.T, events ...xdr.DiagnosticEvent) Trace {
+	t.Helper()
+
+	trace, err := Build(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return trace
+}
+
+func expectFrame(t *testing.T, frame *Frame, contract xdr.ContractId, function string, failed bool) {
+	t.Helper()
+
+	if frame.ContractId != contractId(contract) || frame.Function != function || frame.Failed != failed {
+		t.Errorf("got %s.%s failed %v, want %s.%s failed %v",
+			frame.ContractId, frame.Function, frame.Failed, contractId(contract), function, failed)
+	}
+}
+
+func TestNestedCalls(t *testing.T) {
+	trace := build(t,
+		fnCall(nil, contractA, "swap", u32(1), u32(2)),
+		fnCall(&contractA, contractB, "transfer", u32(3)),
+		event(xdr.ContractEventTypeContract, &contractB, u32(3), symbol("transfer")),
+		logEvent(&contractB, "sent"),
+		fnReturn(contractB, "transfer", u32(0)),
+		logEvent(&contractA, "swapped"),
+		fnReturn(contractA, "swap", u32(7)),
+	)
+
+	if len(trace.Calls) != 1 {
+		t.Fatalf("got %d top-level calls, want 1", len(trace.Calls))
+	}
+	a := trace.Calls[0]
+	expectFrame(t, a, contractA, "swap", false)
+	if len(a.Args) != 2 || a.ReturnValue == nil || a.ReturnValue.U32 == nil || *a.ReturnValue.U32 != 7 {
+		t.Errorf("got args %+v, return %+v", a.Args, a.ReturnValue)
+	}
+	if len(a.Logs) != 1 || *a.Logs[0].Sym != "swapped" || len(a.Events) != 0 {
+		t.Errorf("got logs %+v, events %+v of swap", a.Logs, a.Events)
+	}
+
+	if len(a.Calls) != 1 {
+		t.Fatalf("got %d calls of swap, want 1", len(a.Calls))
+	}
+	b := a.Calls[0]
+	expectFrame(t, b, contractB, "transfer", false)
+	if len(b.Events) != 1 || b.Events[0].Type != "contract" {
+		t.Errorf("got events %+v of transfer", b.Events)
+	}
+	if len(b.Logs) != 1 || *b.Logs[0].Sym != "sent" {
+		t.Errorf("got logs %+v of transfer", b.Logs)
+	}
+	if len(trace.Events) != 0 || len(trace.Logs) != 0 {
+		t.Errorf("got top-level events %+v, logs %+v", trace.Events, trace.Logs)
+	}
+}
+
+// A call that fails has no fn_return, and ends at the next event of its
+// caller.
+func TestFailedInnerCall(t *testing.T) {
+	failing := fnCall(&contractA, contractB, "transfer")
+	failing.InSuccessfulContractCall = false
+	trace := build(t,
+		fnCall(nil, contractA, "swap"),
+		failing,
+		errorEvent(&contractB, 7),
+		errorEvent(&contractA, 9),
+		logEvent(&contractA, "recovered"),
+		fnReturn(contractA, "swap", u32(0)),
+	)
+
+	a := trace.Calls[0]
+	expectFrame(t, a, contractA, "swap", false)
+	if len(a.Calls) != 1 {
+		t.Fatalf("got %d calls of swap, want 1", len(a.Calls))
+	}
+	b := a.Calls[0]
+	expectFrame(t, b, contractB, "transfer", true)
+	if b.ReturnValue != nil {
+		t.Errorf("got return value %+v of a failed call", b.ReturnValue)
+	}
+
+	if len(b.Errors) != 1 || *b.Errors[0].Error.Error.ContractCode != 7 {
+		t.Errorf("got errors %+v of transfer", b.Errors)
+	}
+	if len(a.Errors) != 1 || *a.Errors[0].Error.Error.ContractCode != 9 {
+		t.Errorf("got errors %+v of swap", a.Errors)
+	}
+	if len(a.Logs) != 1 || len(b.Logs) != 0 {
+		t.Errorf("got logs %+v of swap and %+v of transfer", a.Logs, b.Logs)
+	}
+}
+
+func TestTopLevelCalls(t *testing.T) {
+	trace := build(t,
+		logEvent(nil, "before"),
+		fnCall(nil, contractA, "first"),
+		// The host invokes the next top-level call without a return of the
+		// first.
+		fnCall(nil, contractB, "second"),
+		fnReturn(contractB, "second", u32(1)),
+		event(xdr.ContractEventTypeSystem, nil, u32(0), symbol("upgrade")),
+	)
+
+	if len(trace.Calls) != 2 {
+		t.Fatalf("got %d top-level calls, want 2", len(trace.Calls))
+	}
+	expectFrame(t, trace.Calls[0], contractA, "first", true)
+	expectFrame(t, trace.Calls[1], contractB, "second", false)
+	if len(trace.Calls[0].Calls) != 0 {
+		t.Errorf("got calls %+v of the first call", trace.Calls[0].Calls)
+	}
+
+	if len(trace.Logs) != 1 || *trace.Logs[0].Sym != "before" {
+		t.Errorf("got top-level logs %+v", trace.Logs)
+	}
+	if len(trace.Events) != 1 || trace.Events[0].Type != "system" {
+		t.Errorf("got top-level events %+v", trace.Events)
+	}
+}
+
+func TestCoreMetrics(t *testing.T) {
+	u64 := func(v uint64) xdr.ScVal {
+		u := xdr.Uint64(v)
+		return xdr.ScVal{Type: xdr.ScValTypeScvU64, U64: &u}
+	}
+
+	trace := build(t,
+		fnCall(nil, contractA, "run"),
+		fnReturn(contractA, "run", u32(0)),
+		event(xdr.ContractEventTypeDiagnostic, nil, u64(1200), symbol("core_metrics"), symbol("cpu_insn")),
+		event(xdr.ContractEventTypeDiagnostic, nil, u64(64), symbol("core_metrics"), symbol("read_entry")),
+	)
+
+	if len(trace.Metrics) != 2 || trace.Metrics["cpu_insn"] != 1200 || trace.Metrics["read_entry"] != 64 {
+		t.Errorf("got metrics %v", trace.Metrics)
+	}
+	if len(trace.Events) != 0 || len(trace.Calls[0].Events) != 0 {
+		t.Errorf("core_metrics kept as events")
+	}
+}
+
+func TestMalformedEvents(t *testing.T) {
+	for name, e := range map[string]xdr.DiagnosticEvent{
+		"fn_call without topics":   event(xdr.ContractEventTypeDiagnostic, nil, u32(0), symbol("fn_call")),
+		"fn_call without bytes":    event(xdr.ContractEventTypeDiagnostic, nil, u32(0), symbol("fn_call"), u32(1), symbol("f")),
+		"fn_return without topics": event(xdr.ContractEventTypeDiagnostic, &contractA, u32(0), symbol("fn_return")),
+		"error without topics":     event(xdr.ContractEventTypeDiagnostic, &contractA, u32(0), symbol("error")),
+	} {
+		if _, err := Build([]xdr.DiagnosticEvent{e}); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+
+	// A return without its call is ignored.
+	trace := build(t, fnReturn(contractA, "swap", u32(0)))
+	if len(trace.Calls) != 0 {
+		t.Errorf("got calls %+v", trace.Calls)
+	}
+}
+
+func TestFromTransactionMeta(t *testing.T) {
+	trace, err := FromTransactionMeta(xdr.TransactionMeta{V: 2, V2: &xdr.TransactionMetaV2{}})
+	if err != nil || len(trace.Calls) != 0 {
+		t.Errorf("got %+v, %v for meta V2", trace, err)
+	}
+
+	meta := xdr.TransactionMeta{V: 3, V3: &xdr.TransactionMetaV3{SorobanMeta: &xdr.SorobanTransactionMeta{
+		DiagnosticEvents: []xdr.DiagnosticEvent{fnCall(nil, contractA, "run"), fnReturn(contractA, "run", u32(0))},
+	}}}
+	trace, err = FromTransactionMeta(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trace.Calls) != 1 {
+		t.Errorf("got %d calls, want 1", len(trace.Calls))
+	}
+
+	// V4 moves the diagnostic events out of the Soroban meta.
+	meta = xdr.TransactionMeta{V: 4, V4: &xdr.TransactionMetaV4{
+		DiagnosticEvents: []xdr.DiagnosticEvent{fnCall(nil, contractA, "run"), fnReturn(contractA, "run", u32(0))},
+	}}
+	trace, err = FromTransactionMeta(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trace.Calls) != 1 {
+		t.Errorf("got %d calls for meta V4, want 1", len(trace.Calls))
+	}
+
+	if _, err := FromTransactionMeta(xdr.TransactionMeta{V: 5}); err == nil {
+		t.Error("traced TransactionMeta V5")
+	}
+}
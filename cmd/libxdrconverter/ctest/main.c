@@ -0,0 +1,69 @@
+// Smoke test for libxdrconverter, built and run by run.sh.
+#include <stdio.h>
+#include <string.h>
+
+#include "libxdrconverter.h"
+
+static int failures = 0;
+
+static void expect(int ok, const char *what) {
+	if (!ok) {
+		fprintf(stderr, "FAIL %s\n", what);
+		failures++;
+	} else {
+		printf("ok   %s\n", what);
+	}
+}
+
+int main(void) {
+	char *out = NULL;
+	xc_status status;
+
+	// ScVal u32 42.
+	uint8_t scval[] = {0, 0, 0, 3, 0, 0, 0, 42};
+	status = xc_marshal_json_contract_value(scval, sizeof(scval), &out);
+	expect(status == XC_OK && strcmp(out, "{\"u32\":42}") == 0, "decode scval");
+	xc_free(out);
+
+	status = xc_marshal_json_contract_value_info(scval, sizeof(scval), &out);
+	expect(status == XC_OK && strcmp(out, "{\"type\":\"u32\",\"value\":42}") == 0, "decode scval info");
+	xc_free(out);
+
+	status = xc_marshal_json("scval", scval, sizeof(scval), "Test SDF Network ; September 2015", 0, &out);
+	expect(status == XC_OK && strcmp(out, "{\"u32\":42}") == 0, "decode by type name");
+	xc_free(out);
+
+	// TransactionResultPair of a successful transaction without operations.
+	uint8_t result[52] = {0};
+	status = xc_marshal_json("result", result, sizeof(result), NULL, 1, &out);
+	expect(status == XC_OK && strstr(out, "\"txSUCCESS\"") != NULL, "enum names");
+	xc_free(out);
+
+	status = xc_marshal_json("result", result, sizeof(result), NULL, 0, &out);
+	expect(status == XC_OK && strstr(out, "txSUCCESS") == NULL, "no enum names");
+	xc_free(out);
+
+	status = xc_marshal_json("nope", scval, sizeof(scval), NULL, 0, &out);
+	expect(status == XC_ERR_INVALID_ARGUMENT, "unknown type name");
+	xc_free(out);
+
+	status = xc_encode_scval("u32", "42", &out);
+	expect(status == XC_OK && strcmp(out, "AAAAAwAAACo=") == 0, "encode scval");
+	xc_free(out);
+
+	status = xc_marshal_json_envelope(scval, 3, &out);
+	expect(status == XC_ERR_DECODE && out != NULL && strlen(out) > 0, "truncated envelope");
+	xc_free(out);
+
+	status = xc_marshal_json_ledger_entry(NULL, 0, &out);
+	expect(status == XC_ERR_INVALID_ARGUMENT, "NULL input");
+	xc_free(out);
+
+	status = xc_encode_scval("u32", "nope", &out);
+	expect(status == XC_ERR_ENCODE, "invalid value");
+	xc_free(out);
+
+	expect(xc_encode_scval("u32", "1", NULL) == XC_ERR_INVALID_ARGUMENT, "NULL out");
+
+	return failures == 0 ? 0 : 1;
+}
@@ -0,0 +1,12 @@
+#!/bin/sh
+# Builds libxdrconverter and the C smoke test against it, then runs the test.
+# go test -tags cgo_smoke ./cmd/libxdrconverter does the same.
+set -e
+
+dir=$(cd "$(dirname "$0")" && pwd)
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+go build -buildmode=c-shared -o "$tmp/libxdrconverter.so" "$dir/.."
+${CC:-cc} -Wall -o "$tmp/ctest" "$dir/main.c" -I"$tmp" -L"$tmp" -lxdrconverter
+LD_LIBRARY_PATH="$tmp" "$tmp/ctest"
@@ -0,0 +1,122 @@
+/* Code generated by cmd/cgo; DO NOT EDIT. */
+
+/* package github.com/decentrio/xdr-converter/cmd/libxdrconverter */
+
+
+#line 1 "cgo-builtin-export-prolog"
+
+#include <stddef.h>
+
+#ifndef GO_CGO_EXPORT_PROLOGUE_H
+#define GO_CGO_EXPORT_PROLOGUE_H
+
+#ifndef GO_CGO_GOSTRING_TYPEDEF
+typedef struct { const char *p; ptrdiff_t n; } _GoString_;
+extern size_t _GoStringLen(_GoString_ s);
+extern const char *_GoStringPtr(_GoString_ s);
+#endif
+
+#endif
+
+/* Start of preamble from import "C" comments.  */
+
+
+#line 21 "main.go"
+
+#include <stddef.h>
+#include <stdint.h>
+#include <stdlib.h>
+
+typedef enum {
+	XC_OK = 0,
+	// A required pointer was NULL or a length was out of range.
+	XC_ERR_INVALID_ARGUMENT = 1,
+	// The input is not valid XDR of the requested type or failed to convert.
+	XC_ERR_DECODE = 2,
+	// The value could not be encoded as XDR.
+	XC_ERR_ENCODE = 3,
+	// The converter panicked; the message describes the panic.
+	XC_ERR_INTERNAL = 4,
+} xc_status;
+
+#line 1 "cgo-generated-wrapper"
+
+
+/* End of preamble from import "C" comments.  */
+
+
+/* Start of boilerplate cgo prologue.  */
+#line 1 "cgo-gcc-export-header-prolog"
+
+#ifndef GO_CGO_PROLOGUE_H
+#define GO_CGO_PROLOGUE_H
+
+typedef signed char GoInt8;
+typedef unsigned char GoUint8;
+typedef short GoInt16;
+typedef unsigned short GoUint16;
+typedef int GoInt32;
+typedef unsigned int GoUint32;
+typedef long long GoInt64;
+typedef unsigned long long GoUint64;
+typedef GoInt64 GoInt;
+typedef GoUint64 GoUint;
+typedef size_t GoUintptr;
+typedef float GoFloat32;
+typedef double GoFloat64;
+#ifdef _MSC_VER
+#if !defined(__cplusplus) || _MSVC_LANG <= 201402L
+#include <complex.h>
+typedef _Fcomplex GoComplex64;
+typedef _Dcomplex GoComplex128;
+#else
+#include <complex>
+typedef std::complex<float> GoComplex64;
+typedef std::complex<double> GoComplex128;
+#endif
+#else
+typedef float _Complex GoComplex64;
+typedef double _Complex GoComplex128;
+#endif
+
+/*
+  static assertion to make sure the file is being used on architecture
+  at least with matching size of GoInt.
+*/
+typedef char _check_for_64_bit_pointer_matching_GoInt[sizeof(void*)==64/8 ? 1:-1];
+
+#ifndef GO_CGO_GOSTRING_TYPEDEF
+typedef _GoString_ GoString;
+#endif
+typedef void *GoMap;
+typedef void *GoChan;
+typedef struct { void *t; void *v; } GoInterface;
+typedef struct { void *data; GoInt len; GoInt cap; } GoSlice;
+
+#endif
+
+/* End of boilerplate cgo prologue.  */
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+extern void xc_free(char* p);
+extern xc_status xc_marshal_json_envelope(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_result(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_result_meta(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_contract_event(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_contract_event_body(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_contract_key(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_contract_key_info(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_contract_value(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_contract_value_info(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_invoke_contract_args(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_ledger_key(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json_ledger_entry(uint8_t* inp, size_t inpLen, char** out);
+extern xc_status xc_marshal_json(char* xdrType, uint8_t* inp, size_t inpLen, char* passphrase, int enumNames, char** out);
+extern xc_status xc_encode_scval(char* scValType, char* value, char** out);
+
+#ifdef __cplusplus
+}
+#endif
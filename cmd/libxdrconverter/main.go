@@ -0,0 +1,209 @@
+// Command libxdrconverter builds the converter as a C shared library:
+//
+//	go build -buildmode=c-shared -o libxdrconverter.so ./cmd/libxdrconverter
+//
+// which also writes libxdrconverter.h. The header committed next to this file
+// is that generated header.
+//
+// Every exported function returns an xc_status and reports its result through
+// an out parameter:
+//
+//   - On XC_OK, *out is a NUL-terminated JSON (decode) or base64 (encode)
+//     string.
+//   - On any other status, *out is a NUL-terminated error message.
+//
+// In both cases *out is allocated by the library and owned by the caller, who
+// must release it with xc_free. Input buffers are only read during the call
+// and stay owned by the caller. The functions are safe to call from multiple
+// threads.
+package main
+
+/*
+#include <stddef.h>
+#include <stdint.h>
+#include <stdlib.h>
+
+typedef enum {
+	XC_OK = 0,
+	// A required pointer was NULL or a length was out of range.
+	XC_ERR_INVALID_ARGUMENT = 1,
+	// The input is not valid XDR of the requested type or failed to convert.
+	XC_ERR_DECODE = 2,
+	// The value could not be encoded as XDR.
+	XC_ERR_ENCODE = 3,
+	// The converter panicked; the message describes the panic.
+	XC_ERR_INTERNAL = 4,
+} xc_status;
+*/
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+//go:generate go build -buildmode=c-shared -o libxdrconverter.so .
+//go:generate rm libxdrconverter.so
+
+// xc_free releases a string returned by any xc_ function. NULL is ignored.
+//
+//export xc_free
+func xc_free(p *C.char) {
+	C.free(unsafe.Pointer(p))
+}
+
+//export xc_marshal_json_envelope
+func xc_marshal_json_envelope(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONEnvelopeXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_result
+func xc_marshal_json_result(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONResultXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_result_meta
+func xc_marshal_json_result_meta(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONResultMetaXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_contract_event
+func xc_marshal_json_contract_event(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONContractEventXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_contract_event_body
+func xc_marshal_json_contract_event_body(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONContractEventBodyXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_contract_key
+func xc_marshal_json_contract_key(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONContractKeyXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_contract_key_info
+func xc_marshal_json_contract_key_info(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONContractKeyInfoXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_contract_value
+func xc_marshal_json_contract_value(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONContractValueXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_contract_value_info
+func xc_marshal_json_contract_value_info(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONContractValueInfoXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_invoke_contract_args
+func xc_marshal_json_invoke_contract_args(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONInvokeContractArgsXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_ledger_key
+func xc_marshal_json_ledger_key(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONLedgerKeyXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+//export xc_marshal_json_ledger_entry
+func xc_marshal_json_ledger_entry(inp *C.uint8_t, inpLen C.size_t, out **C.char) C.xc_status {
+	return marshalJSON(converter.MarshalJSONLedgerEntryXdrWithOptions, converter.Options{}, inp, inpLen, out)
+}
+
+// xc_marshal_json converts XDR of a type named as in converter.MarshalJSONFuncs,
+// e.g. "envelope", deriving contract IDs on the network identified by
+// passphrase. A NULL passphrase means the public network, which the
+// xc_marshal_json_* functions always use. A non-zero enumNames adds the XDR
+// names of enums and flags, which the xc_marshal_json_* functions leave out.
+//
+//export xc_marshal_json
+func xc_marshal_json(xdrType *C.char, inp *C.uint8_t, inpLen C.size_t, passphrase *C.char, enumNames C.int, out **C.char) (status C.xc_status) {
+	if out == nil {
+		return C.XC_ERR_INVALID_ARGUMENT
+	}
+	defer recoverStatus(out, &status)
+
+	if xdrType == nil {
+		return setError(out, C.XC_ERR_INVALID_ARGUMENT, fmt.Errorf("type must not be NULL"))
+	}
+
+	marshal, ok := converter.MarshalJSONFuncs[C.GoString(xdrType)]
+	if !ok {
+		return setError(out, C.XC_ERR_INVALID_ARGUMENT, fmt.Errorf("unknown type %q", C.GoString(xdrType)))
+	}
+
+	opts := converter.Options{EnumNames: enumNames != 0}
+	if passphrase != nil {
+		opts.NetworkPassphrase = C.GoString(passphrase)
+	}
+
+	return marshalJSON(marshal, opts, inp, inpLen, out)
+}
+
+// xc_encode_scval builds ScVal XDR from a typed value, see
+// converter.ConvertToData, and returns it base64 encoded.
+//
+//export xc_encode_scval
+func xc_encode_scval(scValType *C.char, value *C.char, out **C.char) (status C.xc_status) {
+	if out == nil {
+		return C.XC_ERR_INVALID_ARGUMENT
+	}
+	defer recoverStatus(out, &status)
+
+	if scValType == nil || value == nil {
+		return setError(out, C.XC_ERR_INVALID_ARGUMENT, fmt.Errorf("type and value must not be NULL"))
+	}
+
+	scVal, err := converter.ConvertToData(C.GoString(scValType), C.GoString(value))
+	if err != nil {
+		return setError(out, C.XC_ERR_ENCODE, err)
+	}
+
+	encoded, err := xdr.MarshalBase64(scVal)
+	if err != nil {
+		return setError(out, C.XC_ERR_ENCODE, err)
+	}
+
+	*out = C.CString(encoded)
+	return C.XC_OK
+}
+
+func marshalJSON(marshal func([]byte, converter.Options) ([]byte, error), opts converter.Options, inp *C.uint8_t, inpLen C.size_t, out **C.char) (status C.xc_status) {
+	if out == nil {
+		return C.XC_ERR_INVALID_ARGUMENT
+	}
+	defer recoverStatus(out, &status)
+
+	if inp == nil || inpLen > math.MaxInt32 {
+		return setError(out, C.XC_ERR_INVALID_ARGUMENT, fmt.Errorf("input must be non-NULL and at most %d bytes", math.MaxInt32))
+	}
+
+	bz, err := marshal(C.GoBytes(unsafe.Pointer(inp), C.int(inpLen)), opts)
+	if err != nil {
+		return setError(out, C.XC_ERR_DECODE, err)
+	}
+
+	*out = C.CString(string(bz))
+	return C.XC_OK
+}
+
+func setError(out **C.char, status C.xc_status, err error) C.xc_status {
+	*out = C.CString(err.Error())
+	return status
+}
+
+// recoverStatus turns a panic into XC_ERR_INTERNAL, since a Go panic
+// unwinding into C would abort the host process.
+func recoverStatus(out **C.char, status *C.xc_status) {
+	if r := recover(); r != nil {
+		*status = setError(out, C.XC_ERR_INTERNAL, fmt.Errorf("panic: %v", r))
+	}
+}
+
+func main() {}
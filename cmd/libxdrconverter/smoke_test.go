@@ -0,0 +1,42 @@
+//go:build cgo_smoke
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestCSmoke builds the shared library and runs the C smoke test of ctest
+// against it:
+//
+//	go test -tags cgo_smoke ./cmd/libxdrconverter
+func TestCSmoke(t *testing.T) {
+	cc := os.Getenv("CC")
+	if cc == "" {
+		cc = "cc"
+	}
+	if _, err := exec.LookPath(cc); err != nil {
+		t.Skipf("no C compiler: %v", err)
+	}
+
+	tmp := t.TempDir()
+	run := func(name string, args ...string) {
+		t.Helper()
+
+		cmd := exec.Command(name, args...)
+		cmd.Env = append(os.Environ(), "LD_LIBRARY_PATH="+tmp)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", name, err, out)
+		}
+		t.Logf("%s", out)
+	}
+
+	run("go", "build", "-buildmode=c-shared", "-o", filepath.Join(tmp, "libxdrconverter.so"), ".")
+	run(cc, "-Wall", "-o", filepath.Join(tmp, "ctest"), filepath.Join("ctest", "main.c"),
+		"-I"+tmp, "-L"+tmp, "-lxdrconverter")
+	run(filepath.Join(tmp, "ctest"))
+}
@@ -0,0 +1,344 @@
+// Command protogen writes the protobuf messages mirroring the output types of
+// the converter package, so that the .proto cannot drift from types.go.
+//
+// Usage:
+//
+//	go run ./cmd/protogen -o proto/xdrconverter/v1/types.proto
+//
+// Every message field is named after the json tag of its Go field and carries
+// it as json_name, so protojson reads the JSON produced by the converter
+// directly. Field numbers already present in the output file are kept; new
+// fields get the next free number and numbers of removed fields are reserved.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decentrio/xdr-converter/converter"
+)
+
+// roots are the converter types that get a message, together with every type
+// reachable from them.
+var roots = []reflect.Type{
+	reflect.TypeOf(converter.TransactionEnvelope{}),
+	reflect.TypeOf(converter.TransactionResultMeta{}),
+	reflect.TypeOf(converter.ContractEvent{}),
+	reflect.TypeOf(converter.LedgerEntry{}),
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+var scalars = map[reflect.Kind]string{
+	reflect.Bool:   "bool",
+	reflect.String: "string",
+	reflect.Int32:  "int32",
+	reflect.Int64:  "int64",
+	reflect.Uint32: "uint32",
+	reflect.Uint64: "uint64",
+}
+
+type field struct {
+	label    string
+	protoTyp string
+	name     string
+	number   int
+}
+
+type message struct {
+	name     string
+	fields   []field
+	reserved []int
+}
+
+// numbering holds the field numbers read from a previously generated file.
+type numbering struct {
+	fields   map[string]map[string]int
+	reserved map[string][]int
+}
+
+func main() {
+	out := flag.String("o", "proto/xdrconverter/v1/types.proto", "output `path`")
+	flag.Parse()
+
+	bz, err := generate(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*out, bz, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// generate renders the .proto for the roots, keeping the field numbers of
+// the file at path.
+func generate(path string) ([]byte, error) {
+	prev, err := readNumbering(path)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{prev: prev, seen: map[reflect.Type]bool{}}
+	for _, t := range roots {
+		if err := g.addMessage(t); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.render(), nil
+}
+
+type generator struct {
+	prev     numbering
+	seen     map[reflect.Type]bool
+	messages []message
+	imports  map[string]bool
+}
+
+func (g *generator) addMessage(t reflect.Type) error {
+	if g.seen[t] {
+		return nil
+	}
+	g.seen[t] = true
+
+	msg := message{name: t.Name()}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			name = strings.Split(tag, ",")[0]
+		}
+		if name == "-" {
+			continue
+		}
+
+		label, protoTyp, err := g.fieldType(f.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+
+		msg.fields = append(msg.fields, field{label: label, protoTyp: protoTyp, name: name})
+	}
+
+	g.number(&msg)
+	g.messages = append(g.messages, msg)
+
+	return nil
+}
+
+// fieldType maps a Go field type to a proto label and type, adding messages
+// for the struct types it refers to.
+func (g *generator) fieldType(t reflect.Type) (string, string, error) {
+	label := ""
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		label = "optional"
+	}
+
+	if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+		return label, "bytes", nil
+	}
+
+	if t.Kind() == reflect.Slice {
+		elemLabel, elem, err := g.fieldType(t.Elem())
+		if err != nil {
+			return "", "", err
+		}
+		if elemLabel == "repeated" {
+			return "", "", fmt.Errorf("nested slice %s is not supported", t)
+		}
+		return "repeated", elem, nil
+	}
+
+	switch {
+	case t == timeType:
+		g.addImport("google/protobuf/timestamp.proto")
+		return "", "google.protobuf.Timestamp", nil
+	case t == interfaceType:
+		g.addImport("google/protobuf/struct.proto")
+		return "", "google.protobuf.Value", nil
+	case t.Kind() == reflect.Struct:
+		if err := g.addMessage(t); err != nil {
+			return "", "", err
+		}
+		// Message fields always track presence.
+		return "", t.Name(), nil
+	}
+
+	if scalar, ok := scalars[t.Kind()]; ok {
+		return label, scalar, nil
+	}
+
+	return "", "", fmt.Errorf("type %s is not supported", t)
+}
+
+func (g *generator) addImport(path string) {
+	if g.imports == nil {
+		g.imports = map[string]bool{}
+	}
+	g.imports[path] = true
+}
+
+// number assigns field numbers, keeping the ones from the previous file.
+func (g *generator) number(msg *message) {
+	prev := g.prev.fields[msg.name]
+	last := 0
+	for _, n := range g.prev.reserved[msg.name] {
+		if n > last {
+			last = n
+		}
+	}
+	for _, n := range prev {
+		if n > last {
+			last = n
+		}
+	}
+
+	for i := range msg.fields {
+		if n, ok := prev[msg.fields[i].name]; ok {
+			msg.fields[i].number = n
+		}
+	}
+
+	for i := range msg.fields {
+		if msg.fields[i].number == 0 {
+			last++
+			msg.fields[i].number = last
+		}
+	}
+
+	reserved := append([]int(nil), g.prev.reserved[msg.name]...)
+	for _, n := range prev {
+		if !containsNumber(msg.fields, n) {
+			reserved = append(reserved, n)
+		}
+	}
+	sort.Ints(reserved)
+	msg.reserved = reserved
+}
+
+func containsNumber(fields []field, n int) bool {
+	for _, f := range fields {
+		if f.number == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *generator) render() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by cmd/protogen from converter/types.go. DO NOT EDIT.\n\n")
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	buf.WriteString("package xdrconverter.v1;\n\n")
+
+	var imports []string
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	for _, path := range imports {
+		fmt.Fprintf(&buf, "import %q;\n", path)
+	}
+	if len(imports) > 0 {
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("option go_package = \"github.com/decentrio/xdr-converter/pb;pb\";\n")
+
+	msgs := append([]message(nil), g.messages...)
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].name < msgs[j].name })
+	for _, msg := range msgs {
+		fmt.Fprintf(&buf, "\nmessage %s {\n", msg.name)
+		if len(msg.reserved) > 0 {
+			numbers := make([]string, len(msg.reserved))
+			for i, n := range msg.reserved {
+				numbers[i] = strconv.Itoa(n)
+			}
+			fmt.Fprintf(&buf, "  reserved %s;\n", strings.Join(numbers, ", "))
+		}
+		for _, f := range msg.fields {
+			buf.WriteString("  ")
+			if f.label != "" {
+				buf.WriteString(f.label + " ")
+			}
+			fmt.Fprintf(&buf, "%s %s = %d [json_name = %q];\n", f.protoTyp, f.name, f.number, f.name)
+		}
+		buf.WriteString("}\n")
+	}
+
+	return buf.Bytes()
+}
+
+var (
+	messageLine  = regexp.MustCompile(`^message (\w+) \{`)
+	fieldLine    = regexp.MustCompile(`^\s+(?:optional |repeated )?[\w.]+ (\w+) = (\d+)`)
+	reservedLine = regexp.MustCompile(`^\s+reserved ([\d, ]+);`)
+)
+
+// readNumbering reads the field numbers of a file previously written by
+// protogen. A missing file yields empty numbering.
+func readNumbering(path string) (numbering, error) {
+	n := numbering{fields: map[string]map[string]int{}, reserved: map[string][]int{}}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return n, nil
+	}
+	if err != nil {
+		return n, err
+	}
+	defer f.Close()
+
+	current := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := messageLine.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			n.fields[current] = map[string]int{}
+			continue
+		}
+		if current == "" {
+			continue
+		}
+
+		if m := fieldLine.FindStringSubmatch(line); m != nil {
+			number, _ := strconv.Atoi(m[2])
+			n.fields[current][m[1]] = number
+			continue
+		}
+
+		if m := reservedLine.FindStringSubmatch(line); m != nil {
+			for _, s := range strings.Split(m[1], ",") {
+				number, err := strconv.Atoi(strings.TrimSpace(s))
+				if err != nil {
+					return n, fmt.Errorf("%s: bad reserved line %q", path, line)
+				}
+				n.reserved[current] = append(n.reserved[current], number)
+			}
+		}
+	}
+
+	return n, scanner.Err()
+}
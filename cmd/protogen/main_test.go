@@ -0,0 +1,27 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+const typesProto = "../../proto/xdrconverter/v1/types.proto"
+
+// TestProtoUpToDate fails when types.proto lacks a field of types.go, which
+// the gRPC service would otherwise silently drop.
+func TestProtoUpToDate(t *testing.T) {
+	committed, err := os.ReadFile(typesProto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := generate(typesProto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(committed, generated) {
+		t.Error("proto/xdrconverter/v1/types.proto is stale, run go generate ./pb")
+	}
+}
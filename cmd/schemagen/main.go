@@ -0,0 +1,501 @@
+// Command schemagen writes the JSON Schema (draft 2020-12) of the output types
+// of the converter package and an OpenAPI 3.1 document for the HTTP server,
+// so that downstream validators and generated clients cannot drift from
+// types.go.
+//
+// Usage:
+//
+//	go run ./cmd/schemagen -dir schema
+//	go run ./cmd/schemagen -dir schema -check
+//
+// With -check nothing is written; the command exits with status 1 when the
+// files in dir differ from what would be generated, to catch a types.go change
+// committed without regenerating.
+//
+// Properties are named after the json tags. A property is required when
+// encoding/json always emits it: fields without omitempty and struct
+// values. Fields without omitempty that can be nil are nullable. Types
+// mirroring an XDR union get a oneOf allowing at most one of their arms.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/httpserver"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	schemaFile  = "xdr-converter.schema.json"
+	openAPIFile = "openapi.json"
+	schemaId    = "https://github.com/decentrio/xdr-converter/schema/" + schemaFile
+)
+
+// roots are the top-level output types, keyed by the decode type names of
+// converter.MarshalJSONFuncs, with the XDR type each one converts.
+var roots = []struct {
+	name      string
+	converted reflect.Type
+	xdr       reflect.Type
+}{
+	{"envelope", reflect.TypeOf(converter.TransactionEnvelope{}), reflect.TypeOf(xdr.TransactionEnvelope{})},
+	{"result", reflect.TypeOf(converter.TransactionResultPair{}), reflect.TypeOf(xdr.TransactionResultPair{})},
+	{"meta", reflect.TypeOf(converter.TransactionResultMeta{}), reflect.TypeOf(xdr.TransactionResultMeta{})},
+	{"event", reflect.TypeOf(converter.ContractEvent{}), reflect.TypeOf(xdr.ContractEvent{})},
+	{"event-body", reflect.TypeOf(converter.ContractEventBody{}), reflect.TypeOf(xdr.ContractEventBody{})},
+	{"scval", reflect.TypeOf(converter.ScVal{}), reflect.TypeOf(xdr.ScVal{})},
+	{"scval-info", reflect.TypeOf(converter.ScValInfo{}), reflect.TypeOf(xdr.ScVal{})},
+	{"invoke-args", reflect.TypeOf(converter.InvokeContractArgsArg{}), reflect.TypeOf(xdr.InvokeContractArgs{})},
+	{"ledger-key", reflect.TypeOf(converter.LedgerKey{}), reflect.TypeOf(xdr.LedgerKey{})},
+	{"ledger-entry", reflect.TypeOf(converter.LedgerEntry{}), reflect.TypeOf(xdr.LedgerEntry{})},
+	{"ledger-header", reflect.TypeOf(converter.LedgerHeader{}), reflect.TypeOf(xdr.LedgerHeader{})},
+	{"ledger-meta", reflect.TypeOf(converter.LedgerCloseMeta{}), reflect.TypeOf(xdr.LedgerCloseMeta{})},
+	{"bucket-entry", reflect.TypeOf(converter.BucketEntry{}), reflect.TypeOf(xdr.BucketEntry{})},
+}
+
+// encodeTypes are the ScVal types accepted by converter.ConvertToData.
+var encodeTypes = []string{
+	converter.XDR_BOOL, converter.XDR_U32, converter.XDR_I32, converter.XDR_U64, converter.XDR_I64,
+	converter.XDR_TIME_POINT, converter.XDR_DURATION, converter.XDR_U128, converter.XDR_I128,
+	converter.XDR_U256, converter.XDR_I256, converter.XDR_BYTES, converter.XDR_STRING,
+	converter.XDR_SYM, converter.XDR_NONCE, converter.XDR_ADDRESS, converter.XDR_VEC,
+}
+
+// everyArm lists the union types whose converter sets every arm, so their
+// schema has no oneOf. ConvertOperationBody fills the unused arm of
+// RevokeSponsorshipOp with a zero value.
+var everyArm = map[string]bool{
+	"RevokeSponsorshipOp": true,
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+	rawType       = reflect.TypeOf(json.RawMessage{})
+)
+
+func main() {
+	dir := flag.String("dir", "schema", "output `directory`")
+	check := flag.Bool("check", false, "report stale files instead of writing them")
+	flag.Parse()
+
+	if err := run(*dir, *check); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, check bool) error {
+	for name := range converter.MarshalJSONFuncs {
+		if !hasRoot(name) {
+			return fmt.Errorf("decode type %q has no root, add it to roots", name)
+		}
+	}
+
+	schema, err := jsonSchema()
+	if err != nil {
+		return err
+	}
+
+	openAPI, err := openAPIDocument()
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{schemaFile: schema, openAPIFile: openAPI}
+	var stale []string
+	for _, name := range []string{schemaFile, openAPIFile} {
+		path := filepath.Join(dir, name)
+		if !check {
+			if err := os.WriteFile(path, files[name], 0o644); err != nil {
+				return err
+			}
+			continue
+		}
+
+		current, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !bytes.Equal(current, files[name]) {
+			stale = append(stale, path)
+		}
+	}
+
+	if len(stale) > 0 {
+		return fmt.Errorf("%s out of date, run go generate ./schema", strings.Join(stale, ", "))
+	}
+
+	return nil
+}
+
+func hasRoot(name string) bool {
+	for _, root := range roots {
+		if root.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func jsonSchema() ([]byte, error) {
+	g := newGenerator("#/$defs/")
+	var rootRefs []interface{}
+	for _, root := range roots {
+		ref, err := g.schema(root.converted)
+		if err != nil {
+			return nil, err
+		}
+		rootRefs = append(rootRefs, ref)
+	}
+
+	doc := object{
+		{"$schema", "https://json-schema.org/draft/2020-12/schema"},
+		{"$id", schemaId},
+		{"title", "xdr-converter output"},
+		{"description", "Any value produced by the decode command or the /decode endpoints. Each decode type has its own definition in $defs."},
+		{"anyOf", rootRefs},
+		{"$defs", g.definitions()},
+	}
+
+	return marshal(doc)
+}
+
+func openAPIDocument() ([]byte, error) {
+	g := newGenerator("#/components/schemas/")
+
+	ref := func(t reflect.Type) interface{} {
+		s, err := g.schema(t)
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}
+	jsonBody := func(schema interface{}) object {
+		return object{{"content", object{{"application/json", object{{"schema", schema}}}}}}
+	}
+	response := func(description string, schema interface{}) object {
+		return append(object{{"description", description}}, jsonBody(schema)...)
+	}
+
+	errorRef := ref(reflect.TypeOf(httpserver.ErrorResponse{}))
+	errorResponses := func(statuses ...string) object {
+		var result object
+		for _, status := range statuses {
+			result = append(result, member{status, response("error", errorRef)})
+		}
+		return result
+	}
+
+	var decodeTypes []string
+	paths := object{
+		{"/health", object{{"get", object{
+			{"operationId", "health"},
+			{"responses", object{{"200", response("the server is up", ref(reflect.TypeOf(httpserver.HealthResponse{})))}}},
+		}}}},
+	}
+
+	for _, root := range roots {
+		decodeTypes = append(decodeTypes, root.name)
+		paths = append(paths, member{"/decode/" + root.name, object{{"post", object{
+			{"operationId", "decode_" + strings.ReplaceAll(root.name, "-", "_")},
+			{"summary", "Convert a " + root.xdr.Name() + " to JSON"},
+			{"requestBody", append(object{{"required", true}}, jsonBody(ref(reflect.TypeOf(httpserver.DecodeRequest{})))...)},
+			{"responses", append(object{{"200", response("the converted value", ref(root.converted))}}, errorResponses("400", "413", "422")...)},
+		}}}})
+	}
+
+	paths = append(paths,
+		member{"/decode/auto", object{{"post", object{
+			{"operationId", "decode_auto"},
+			{"summary", "Convert a blob with every type it decodes as, most likely first"},
+			{"requestBody", append(object{{"required", true}}, jsonBody(ref(reflect.TypeOf(httpserver.DecodeRequest{})))...)},
+			{"responses", append(object{{"200", response("the readings of the blob", object{
+				{"type", "array"},
+				{"items", ref(reflect.TypeOf(converter.DetectedXdr{}))},
+			})}}, errorResponses("400", "413", "422")...)},
+		}}}},
+		member{"/batch/decode/{type}", object{{"post", object{
+			{"operationId", "batch_decode"},
+			{"summary", "Convert many blobs of one type; failures are reported in place"},
+			{"parameters", []interface{}{pathParameter("type", append(decodeTypes, "auto"))}},
+			{"requestBody", append(object{{"required", true}}, jsonBody(ref(reflect.TypeOf(httpserver.BatchDecodeRequest{})))...)},
+			{"responses", append(object{{"200", response("one result per blob", ref(reflect.TypeOf(httpserver.BatchDecodeResponse{})))}}, errorResponses("400", "404", "413")...)},
+		}}}},
+		member{"/encode/{type}", object{{"post", object{
+			{"operationId", "encode"},
+			{"summary", "Build ScVal XDR from a typed value"},
+			{"parameters", []interface{}{pathParameter("type", encodeTypes)}},
+			{"requestBody", append(object{{"required", true}}, jsonBody(ref(reflect.TypeOf(httpserver.EncodeRequest{})))...)},
+			{"responses", append(object{{"200", response("the base64 XDR", ref(reflect.TypeOf(httpserver.EncodeResponse{})))}}, errorResponses("400", "413")...)},
+		}}}},
+	)
+
+	doc := object{
+		{"openapi", "3.1.0"},
+		{"info", object{
+			{"title", "xdr-converter"},
+			{"description", "Decode Stellar XDR into JSON. The schemas are generated from converter/types.go."},
+			{"version", "1"},
+		}},
+		{"jsonSchemaDialect", "https://json-schema.org/draft/2020-12/schema"},
+		{"paths", paths},
+		{"components", object{{"schemas", g.definitions()}}},
+	}
+
+	return marshal(doc)
+}
+
+func pathParameter(name string, values []string) object {
+	return object{
+		{"name", name},
+		{"in", "path"},
+		{"required", true},
+		{"schema", object{{"type", "string"}, {"enum", values}}},
+	}
+}
+
+func marshal(doc object) ([]byte, error) {
+	bz, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz, '\n'), nil
+}
+
+type generator struct {
+	refPrefix string
+	defs      map[string]object
+	types     map[string]reflect.Type
+	unions    map[string]map[string]bool
+}
+
+func newGenerator(refPrefix string) *generator {
+	g := &generator{refPrefix: refPrefix, defs: map[string]object{}, types: map[string]reflect.Type{}, unions: map[string]map[string]bool{}}
+	for _, root := range roots {
+		collectUnions(root.xdr, g.unions, map[reflect.Type]bool{})
+	}
+
+	return g
+}
+
+// collectUnions records the arm fields of every XDR union reachable from t,
+// keyed by type name. The converter types mirroring them share their names.
+func collectUnions(t reflect.Type, unions map[string]map[string]bool, seen map[reflect.Type]bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return
+	}
+	seen[t] = true
+
+	if switcher, ok := reflect.Zero(t).Interface().(interface{ SwitchFieldName() string }); ok {
+		arms := map[string]bool{}
+		for i := 0; i < t.NumField(); i++ {
+			if name := t.Field(i).Name; name != switcher.SwitchFieldName() {
+				arms[name] = true
+			}
+		}
+		unions[t.Name()] = arms
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		collectUnions(t.Field(i).Type, unions, seen)
+	}
+}
+
+func (g *generator) definitions() object {
+	names := make([]string, 0, len(g.defs))
+	for name := range g.defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make(object, 0, len(names))
+	for _, name := range names {
+		result = append(result, member{name, g.defs[name]})
+	}
+
+	return result
+}
+
+// schema returns the schema of a value of type t, adding a definition for
+// every struct type it refers to.
+func (g *generator) schema(t reflect.Type) (object, error) {
+	switch {
+	case t == timeType:
+		return object{{"type", "string"}, {"format", "date-time"}}, nil
+	case t == interfaceType || t == rawType:
+		return object{}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.Bool:
+		return object{{"type", "boolean"}}, nil
+	case reflect.String:
+		return object{{"type", "string"}}, nil
+	case reflect.Int32:
+		return object{{"type", "integer"}, {"minimum", math.MinInt32}, {"maximum", math.MaxInt32}}, nil
+	case reflect.Uint32:
+		return object{{"type", "integer"}, {"minimum", 0}, {"maximum", math.MaxUint32}}, nil
+	case reflect.Int, reflect.Int64:
+		return object{{"type", "integer"}}, nil
+	case reflect.Uint64:
+		return object{{"type", "integer"}, {"minimum", 0}}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return object{{"type", "string"}, {"contentEncoding", "base64"}}, nil
+		}
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return object{{"type", "array"}, {"items", items}}, nil
+	case reflect.Struct:
+		if err := g.define(t); err != nil {
+			return nil, err
+		}
+		return object{{"$ref", g.refPrefix + t.Name()}}, nil
+	}
+
+	return nil, fmt.Errorf("type %s is not supported", t)
+}
+
+func (g *generator) define(t reflect.Type) error {
+	if defined, ok := g.types[t.Name()]; ok {
+		if defined != t {
+			return fmt.Errorf("types %s and %s are both named %s", defined, t, t.Name())
+		}
+		return nil
+	}
+	// Reserve the name first so recursive types terminate.
+	g.types[t.Name()] = t
+
+	var properties object
+	var required []string
+	var arms []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		omitEmpty := strings.Contains(opts, "omitempty")
+
+		s, err := g.schema(f.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+		// omitempty drops nil pointers but not pointers to nil slices.
+		if !omitEmpty && nilable(f.Type) || f.Type.Kind() == reflect.Ptr && nilable(f.Type.Elem()) {
+			s = nullable(s)
+		}
+		properties = append(properties, member{name, s})
+
+		if !omitEmpty || f.Type.Kind() == reflect.Struct {
+			required = append(required, name)
+		}
+		if g.unions[t.Name()][f.Name] && nilable(f.Type) {
+			arms = append(arms, name)
+		}
+	}
+
+	def := object{{"type", "object"}, {"properties", properties}}
+	if len(required) > 0 {
+		def = append(def, member{"required", required})
+	}
+	def = append(def, member{"additionalProperties", false})
+	if len(arms) > 1 && !everyArm[t.Name()] {
+		def = append(def, member{"oneOf", atMostOne(arms)})
+	}
+	g.defs[t.Name()] = def
+
+	return nil
+}
+
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+
+	return false
+}
+
+func nullable(s object) object {
+	if len(s) == 0 {
+		return s
+	}
+	if typ, ok := s[0].value.(string); ok && s[0].key == "type" {
+		return append(object{{"type", []string{typ, "null"}}}, s[1:]...)
+	}
+
+	return object{{"anyOf", []interface{}{s, object{{"type", "null"}}}}}
+}
+
+// atMostOne allows either exactly one of arms or none of them.
+func atMostOne(arms []string) []interface{} {
+	var each []interface{}
+	for _, arm := range arms {
+		each = append(each, object{{"required", []string{arm}}})
+	}
+
+	return append(each[:len(each):len(each)], object{{"not", object{{"anyOf", each}}}})
+}
+
+// object is a JSON object that keeps its keys in insertion order.
+type object []member
+
+type member struct {
+	key   string
+	value interface{}
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
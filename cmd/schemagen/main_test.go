@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/schema"
+)
+
+// TestSchemaUpToDate fails when the files committed in schema differ from
+// what schemagen generates, e.g. after a types.go change without go
+// generate ./schema.
+func TestSchemaUpToDate(t *testing.T) {
+	for name := range converter.MarshalJSONFuncs {
+		if !hasRoot(name) {
+			t.Errorf("decode type %q has no root", name)
+		}
+	}
+
+	generated, err := jsonSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, schemaFile, schema.JSONSchema, generated)
+
+	generated, err = openAPIDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, openAPIFile, schema.OpenAPI, generated)
+}
+
+func expectSame(t *testing.T, name string, committed, generated []byte) {
+	t.Helper()
+
+	if bytes.Equal(committed, generated) {
+		return
+	}
+
+	// Point at the first differing line.
+	a, b := bytes.Split(committed, []byte("\n")), bytes.Split(generated, []byte("\n"))
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var got, want []byte
+		if i < len(a) {
+			got = a[i]
+		}
+		if i < len(b) {
+			want = b[i]
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("schema/%s is stale, run go generate ./schema\nline %d: got\n\t%s\nwant\n\t%s", name, i+1, got, want)
+			return
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Node test harness for the WebAssembly build. Run it with
+//
+//   GOOS=js GOARCH=wasm go build -o xdr-converter.wasm ./cmd/xdr-converter-wasm
+//   node cmd/xdr-converter-wasm/harness.js xdr-converter.wasm
+//
+// wasm_exec.js is loaded from the Go toolchain found by `go env GOROOT`.
+"use strict";
+
+const assert = require("assert");
+const { execSync } = require("child_process");
+const fs = require("fs");
+const path = require("path");
+
+const envelope =
+  "AAAAAgAAAABi/B0L0JGythwN1lY0aypo19NHxvLCyO5tBEcCVvwF9wAAAGQAAAAAAAAABQAAAAAAAAAAAAAAAQAAAAAAAAABAAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAAAAAAAAAAATSAAAAAAAAAAA=";
+const resultPair =
+  "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAAAAAAAAAABAAAAAAAAAAEAAAAAAAAAAA==";
+const resultMeta =
+  "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAZAAAAAAAAAABAAAAAAAAAAEAAAAAAAAAAAAAAAAAAAADAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAA";
+
+function loadWasmExec() {
+  const goroot = execSync("go env GOROOT").toString().trim();
+  // The file moved from misc/wasm to lib/wasm in Go 1.24.
+  for (const dir of ["lib/wasm", "misc/wasm"]) {
+    const file = path.join(goroot, dir, "wasm_exec.js");
+    if (fs.existsSync(file)) {
+      require(file);
+      return;
+    }
+  }
+  throw new Error("wasm_exec.js not found under " + goroot);
+}
+
+function call(fn, ...args) {
+  const out = fn(...args);
+  if (out instanceof Error) {
+    throw out;
+  }
+  return out;
+}
+
+const tests = {
+  decodeEnvelope(api) {
+    const env = JSON.parse(call(api.decodeEnvelope, envelope));
+    assert.strictEqual(env.v1.tx.fee, 100);
+    assert.strictEqual(env.v1.tx.seq_num, 5);
+    assert.strictEqual(env.v1.tx.operations.length, 1);
+  },
+
+  decodeWithOptions(api) {
+    const options = { networkPassphrase: "Test SDF Network ; September 2015" };
+    const env = JSON.parse(call(api.decodeEnvelope, envelope, options));
+    assert.strictEqual(env.v1.tx.fee, 100);
+    assert.ok(api.decodeEnvelope(envelope, "testnet") instanceof Error);
+  },
+
+  decodeResult(api) {
+    const pair = JSON.parse(call(api.decodeResult, resultPair));
+    assert.strictEqual(pair.result.fee_charged, 100);
+    assert.strictEqual(pair.result.result.code_name, undefined);
+  },
+
+  enumNames(api) {
+    const pair = JSON.parse(call(api.decodeResult, resultPair, { enumNames: true }));
+    assert.strictEqual(pair.result.result.code_name, "txSUCCESS");
+  },
+
+  decodeResultMeta(api) {
+    const meta = JSON.parse(call(api.decodeResultMeta, resultMeta));
+    assert.strictEqual(meta.result.result.fee_charged, 100);
+  },
+
+  scValRoundTrip(api) {
+    const encoded = call(api.encodeScVal, "u32", "42");
+    assert.strictEqual(encoded, "AAAAAwAAACo=");
+    assert.deepStrictEqual(JSON.parse(call(api.decodeScVal, encoded)), { u32: 42 });
+    assert.deepStrictEqual(JSON.parse(call(api.decodeScValInfo, encoded)), { type: "u32", value: 42 });
+  },
+
+  errors(api) {
+    assert.ok(api.decodeEnvelope("not base64!") instanceof Error);
+    assert.ok(api.decodeEnvelope("AAAA") instanceof Error);
+    assert.ok(api.decodeScVal() instanceof Error);
+    assert.ok(api.encodeScVal("u32", "nope") instanceof Error);
+  },
+
+  // A map without a body used to panic and stop the Go runtime.
+  absentMap(api) {
+    assert.ok(api.decodeScVal("AAAAEQAAAAA=") instanceof Error);
+    assert.ok(api.decodeScValInfo("AAAAEQAAAAA=") instanceof Error);
+    assert.deepStrictEqual(JSON.parse(call(api.decodeScVal, "AAAAAwAAACo=")), { u32: 42 });
+  },
+};
+
+async function main() {
+  const wasmFile = process.argv[2];
+  if (!wasmFile) {
+    console.error("usage: node harness.js <xdr-converter.wasm>");
+    process.exit(2);
+  }
+
+  loadWasmExec();
+  const go = new globalThis.Go();
+  const { instance } = await WebAssembly.instantiate(fs.readFileSync(wasmFile), go.importObject);
+  go.run(instance);
+
+  const api = globalThis.xdrConverter;
+  let failed = 0;
+  for (const [name, test] of Object.entries(tests)) {
+    try {
+      test(api);
+      console.log("ok   " + name);
+    } catch (err) {
+      console.log("FAIL " + name + ": " + err.message);
+      failed++;
+    }
+  }
+
+  process.exit(failed > 0 ? 1 : 0);
+}
+
+main().catch((err) => {
+  console.error(err);
+  process.exit(1);
+});
@@ -0,0 +1,117 @@
+//go:build js && wasm
+
+// Command xdr-converter-wasm exposes the converter to JavaScript. Build it
+// with
+//
+//	GOOS=js GOARCH=wasm go build -o xdr-converter.wasm ./cmd/xdr-converter-wasm
+//
+// and run it with the wasm_exec.js shipped in the Go toolchain. It registers
+// a global xdrConverter object whose functions take a base64 XDR string and
+// return a JSON string:
+//
+//	decodeEnvelope, decodeResult, decodeResultMeta, decodeContractEvent,
+//	decodeScVal, decodeScValInfo, decodeScKey, decodeScKeyInfo
+//
+// An optional second argument {networkPassphrase: "...", enumNames: true}
+// names the network contract IDs are derived on, the public network by
+// default, and adds the XDR names of enums and flags.
+//
+// plus encodeScVal(type, value), which returns base64 ScVal XDR built by
+// converter.ConvertToData. On failure a function returns an Error instead.
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+func main() {
+	api := js.Global().Get("Object").New()
+	api.Set("decodeEnvelope", decodeFunc(converter.MarshalJSONEnvelopeXdrWithOptions))
+	api.Set("decodeResult", decodeFunc(converter.MarshalJSONResultXdrWithOptions))
+	api.Set("decodeResultMeta", decodeFunc(converter.MarshalJSONResultMetaXdrWithOptions))
+	api.Set("decodeContractEvent", decodeFunc(converter.MarshalJSONContractEventXdrWithOptions))
+	api.Set("decodeScVal", decodeFunc(converter.MarshalJSONContractValueXdrWithOptions))
+	api.Set("decodeScValInfo", decodeFunc(converter.MarshalJSONContractValueInfoXdrWithOptions))
+	api.Set("decodeScKey", decodeFunc(converter.MarshalJSONContractKeyXdrWithOptions))
+	api.Set("decodeScKeyInfo", decodeFunc(converter.MarshalJSONContractKeyInfoXdrWithOptions))
+	api.Set("encodeScVal", jsFunc(encodeScVal))
+	js.Global().Set("xdrConverter", api)
+
+	// Keep the functions alive for the lifetime of the page.
+	select {}
+}
+
+// jsFunc wraps fn for JavaScript. A panic while converting would stop the Go
+// runtime and every later call, so it is returned as an Error instead.
+func jsFunc(fn func(this js.Value, args []js.Value) interface{}) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) (result interface{}) {
+		defer func() {
+			if r := recover(); r != nil {
+				result = jsError(fmt.Errorf("internal error: %v", r))
+			}
+		}()
+
+		return fn(this, args)
+	})
+}
+
+func decodeFunc(marshal func([]byte, converter.Options) ([]byte, error)) js.Func {
+	return jsFunc(func(this js.Value, args []js.Value) interface{} {
+		if len(args) < 1 || len(args) > 2 || args[0].Type() != js.TypeString {
+			return jsError(errors.New("expected a base64 XDR string"))
+		}
+
+		var opts converter.Options
+		if len(args) == 2 {
+			if args[1].Type() != js.TypeObject {
+				return jsError(errors.New("expected an options object"))
+			}
+			if passphrase := args[1].Get("networkPassphrase"); passphrase.Type() == js.TypeString {
+				opts.NetworkPassphrase = passphrase.String()
+			}
+			if enumNames := args[1].Get("enumNames"); enumNames.Type() == js.TypeBoolean {
+				opts.EnumNames = enumNames.Bool()
+			}
+		}
+
+		inp, err := base64.StdEncoding.DecodeString(args[0].String())
+		if err != nil {
+			return jsError(err)
+		}
+
+		bz, err := marshal(inp, opts)
+		if err != nil {
+			return jsError(err)
+		}
+
+		return string(bz)
+	})
+}
+
+func encodeScVal(this js.Value, args []js.Value) interface{} {
+	if len(args) != 2 || args[0].Type() != js.TypeString || args[1].Type() != js.TypeString {
+		return jsError(errors.New("expected an ScVal type and a value string"))
+	}
+
+	scVal, err := converter.ConvertToData(args[0].String(), args[1].String())
+	if err != nil {
+		return jsError(err)
+	}
+
+	encoded, err := xdr.MarshalBase64(scVal)
+	if err != nil {
+		return jsError(err)
+	}
+
+	return encoded
+}
+
+func jsError(err error) js.Value {
+	return js.Global().Get("Error").New(err.Error())
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/archival"
+	"github.com/decentrio/xdr-converter/archive"
+	"github.com/decentrio/xdr-converter/bucket"
+	"github.com/decentrio/xdr-converter/metastream"
+	"github.com/stellar/go/xdr"
+)
+
+// runArchival prints the archival status and rent of contract data and code
+// entries as NDJSON. The entries are read from the live state of a history
+// archive checkpoint, a metadata output stream, or the stream on top of the
+// checkpoint.
+func runArchival(args []string) error {
+	fs := flag.NewFlagSet("archival", flag.ContinueOnError)
+	root := fs.String("root", "", "archive root directory to read the state of a checkpoint from")
+	checkpoint := fs.Uint("checkpoint", 0, "checkpoint ledger (0 for the archive's latest state)")
+	in := fs.String("in", "", "meta stream `path` (file or named pipe) to apply, - for stdin")
+	ledger := fs.Uint("ledger", 0, "current ledger (0 for the last ledger read)")
+	extendTo := fs.Uint("extend-to", 0, "ledgers to extend live entries by for their rent (0 for the maximum TTL)")
+	statuses := fs.String("status", "", "comma separated statuses to keep: live, expired, archived, unknown (default all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *root == "" && *in == "" {
+		return fmt.Errorf("--root or --in is required")
+	}
+
+	keep := make(map[archival.Status]bool)
+	if *statuses != "" {
+		for _, name := range strings.Split(*statuses, ",") {
+			s, err := archival.ParseStatus(strings.TrimSpace(name))
+			if err != nil {
+				return err
+			}
+			keep[s] = true
+		}
+	}
+
+	index := archival.NewIndex()
+	var last uint32
+
+	if *root != "" {
+		a := archive.NewArchive(*root)
+		state, err := a.ReadState(uint32(*checkpoint))
+		if err != nil {
+			return err
+		}
+
+		types := []xdr.LedgerEntryType{
+			xdr.LedgerEntryTypeContractData,
+			xdr.LedgerEntryTypeContractCode,
+			xdr.LedgerEntryTypeTtl,
+			xdr.LedgerEntryTypeConfigSetting,
+		}
+		if err := bucket.ReadLiveEntriesXdr(a.BucketPaths(state), types, index.Add); err != nil {
+			return err
+		}
+		last = state.CurrentLedger
+	}
+
+	if *in != "" {
+		f := os.Stdin
+		if *in != "-" {
+			var err error
+			f, err = os.Open(*in)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+		}
+
+		r := metastream.NewReader(f)
+		if last != 0 {
+			r.ResumeFrom(last + 1)
+		}
+		for {
+			meta, err := r.ReadXdr()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := index.ApplyLedgerCloseMeta(meta); err != nil {
+				return err
+			}
+			last = meta.LedgerSequence()
+		}
+	}
+
+	at := uint32(*ledger)
+	if at == 0 {
+		at = last
+	}
+
+	entries, err := index.Status(at, uint32(*extendTo))
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+
+	for _, e := range entries {
+		if len(keep) > 0 && !keep[e.Status] {
+			continue
+		}
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
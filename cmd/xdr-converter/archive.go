@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/decentrio/xdr-converter/archive"
+	"github.com/decentrio/xdr-converter/converter"
+)
+
+// runArchive writes the ledgers of a locally mirrored history archive as
+// NDJSON, each joined with its transactions and results.
+func runArchive(args []string) error {
+	fs := flag.NewFlagSet("archive", flag.ContinueOnError)
+	root := fs.String("root", "", "archive root directory")
+	from := fs.Uint("from", 0, "first ledger")
+	to := fs.Uint("to", 0, "last ledger (0 for the end of the archive)")
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to match transactions to results")
+	enumNames := fs.Bool("enum-names", false, "add the XDR names of enums and flags next to their values")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+
+	a := archive.NewArchive(*root)
+	a.NetworkPassphrase = *passphrase
+	a.EnumNames = *enumNames
+
+	return a.ReadLedgers(uint32(*from), uint32(*to), func(l archive.Ledger) error {
+		return enc.Encode(l)
+	})
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/sorobanauth"
+	"github.com/stellar/go/xdr"
+)
+
+// runAuthAudit prints the flat audit view of the auth entries of each
+// transaction envelope, one JSON array of operations per input.
+func runAuthAudit(args []string) error {
+	fs := flag.NewFlagSet("auth-audit", flag.ContinueOnError)
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to derive the ids of created contracts")
+	var in inputFlags
+	in.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	inputs, err := in.read(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, input := range inputs {
+		bz, err := in.decodeBlob(input)
+		if err == nil {
+			bz, err = auditEnvelope(bz, *passphrase)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "input %d: %v\n", i, err)
+			failed++
+			continue
+		}
+
+		if err := in.write(os.Stdout, bz); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed to audit", failed, len(inputs))
+	}
+
+	return nil
+}
+
+func auditEnvelope(bz []byte, passphrase string) ([]byte, error) {
+	var envelope xdr.TransactionEnvelope
+	if err := envelope.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+
+	audits, err := sorobanauth.AnalyzeTransaction(envelope, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if audits == nil {
+		audits = []sorobanauth.Audit{}
+	}
+
+	return json.Marshal(audits)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/sorobanauth"
+	"github.com/stellar/go/xdr"
+)
+
+// runAuthVerify verifies the address credential signatures of the auth
+// entries of each transaction envelope, one JSON array of operations per
+// input.
+func runAuthVerify(args []string) error {
+	fs := flag.NewFlagSet("auth-verify", flag.ContinueOnError)
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase the entries were signed for")
+	var accountEntries fileList
+	fs.Var(&accountEntries, "account", "ledger entry XDR of a signing account, to check signer weights against its thresholds (repeatable)")
+	var in inputFlags
+	in.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	accounts := make(map[string]xdr.AccountEntry)
+	for _, blob := range accountEntries {
+		bz, err := in.decodeBlob(blob)
+		if err != nil {
+			return fmt.Errorf("invalid --account: %w", err)
+		}
+
+		var entry xdr.LedgerEntry
+		if err := entry.UnmarshalBinary(bz); err != nil {
+			return fmt.Errorf("invalid --account: %w", err)
+		}
+		if entry.Data.Type != xdr.LedgerEntryTypeAccount {
+			return fmt.Errorf("invalid --account: ledger entry is %v, expected an account", entry.Data.Type)
+		}
+
+		account := *entry.Data.Account
+		accounts[account.AccountId.Address()] = account
+	}
+
+	inputs, err := in.read(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, input := range inputs {
+		bz, err := in.decodeBlob(input)
+		if err == nil {
+			bz, err = verifyEnvelope(bz, *passphrase, accounts)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "input %d: %v\n", i, err)
+			failed++
+			continue
+		}
+
+		if err := in.write(os.Stdout, bz); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed to verify", failed, len(inputs))
+	}
+
+	return nil
+}
+
+func verifyEnvelope(bz []byte, passphrase string, accounts map[string]xdr.AccountEntry) ([]byte, error) {
+	var envelope xdr.TransactionEnvelope
+	if err := envelope.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+
+	verifications, err := sorobanauth.VerifyTransaction(envelope, passphrase, accounts)
+	if err != nil {
+		return nil, err
+	}
+	if verifications == nil {
+		verifications = []sorobanauth.OperationVerification{}
+	}
+
+	return json.Marshal(verifications)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/batch"
+	"github.com/decentrio/xdr-converter/converter"
+)
+
+// runBatch converts base64 XDR records from stdin (or --in) to NDJSON on
+// stdout using a worker pool. Stats go to stderr.
+func runBatch(args []string) error {
+	fs := flag.NewFlagSet("batch", flag.ContinueOnError)
+	typ := fs.String("type", "", "XDR type: "+strings.Join(decodeTypes, ", "))
+	workers := fs.Int("workers", 0, "number of worker goroutines (0 for one per CPU)")
+	in := fs.String("in", "-", "input `path`, - for stdin")
+	out := fs.String("out", "-", "output `path`, - for stdout")
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to derive the ids of created contracts")
+	enumNames := fs.Bool("enum-names", false, "add the XDR names of enums and flags next to their values")
+	progress := fs.Duration("progress", 0, "print stats to stderr at this interval (0 to disable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	r := os.Stdin
+	if *in != "-" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	w := os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := batch.Options{
+		Type:             *typ,
+		Workers:          *workers,
+		ProgressInterval: *progress,
+		Converter:        converter.Options{NetworkPassphrase: *passphrase, EnumNames: *enumNames},
+	}
+	if *progress > 0 {
+		opts.Progress = func(s batch.Stats) {
+			fmt.Fprintln(os.Stderr, s)
+		}
+	}
+
+	// With --progress the final stats are printed by the callback.
+	stats, err := batch.Convert(ctx, r, w, opts)
+	if *progress == 0 {
+		fmt.Fprintln(os.Stderr, stats)
+	}
+
+	return err
+}
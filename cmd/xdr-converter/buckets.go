@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/archive"
+	"github.com/decentrio/xdr-converter/bucket"
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+// runBuckets writes the live ledger entries of a checkpoint's bucket list as
+// NDJSON, either to stdout or to one <type>.ndjson file per entry type.
+func runBuckets(args []string) error {
+	fs := flag.NewFlagSet("buckets", flag.ContinueOnError)
+	root := fs.String("root", "", "archive root directory")
+	checkpoint := fs.Uint("checkpoint", 0, "checkpoint ledger (0 for the archive's latest state)")
+	statePath := fs.String("state", "", "HistoryArchiveState `path` to use instead of the archive's")
+	types := fs.String("types", "", "comma separated entry types to keep, e.g. account,contract_data (default all)")
+	outDir := fs.String("out-dir", "", "write one NDJSON file per entry type to this directory instead of stdout")
+	enumNames := fs.Bool("enum-names", false, "add the XDR names of enums and flags next to their values")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	a := archive.NewArchive(*root)
+	opts := converter.Options{EnumNames: *enumNames}
+
+	var state archive.HistoryArchiveState
+	var err error
+	if *statePath != "" {
+		state, err = archive.ReadStateFile(*statePath)
+	} else {
+		state, err = a.ReadState(uint32(*checkpoint))
+	}
+	if err != nil {
+		return err
+	}
+
+	var entryTypes []xdr.LedgerEntryType
+	if *types != "" {
+		for _, name := range strings.Split(*types, ",") {
+			t, err := bucket.ParseType(strings.TrimSpace(name))
+			if err != nil {
+				return err
+			}
+			entryTypes = append(entryTypes, t)
+		}
+	}
+
+	if *outDir == "" {
+		w := bufio.NewWriter(os.Stdout)
+		defer w.Flush()
+		enc := json.NewEncoder(w)
+
+		return bucket.ReadLiveEntries(a.BucketPaths(state), entryTypes, opts, func(e bucket.LiveEntry) error {
+			return enc.Encode(e)
+		})
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		return err
+	}
+
+	files := make(map[string]*typeFile)
+	defer func() {
+		for _, f := range files {
+			f.close()
+		}
+	}()
+
+	err = bucket.ReadLiveEntries(a.BucketPaths(state), entryTypes, opts, func(e bucket.LiveEntry) error {
+		f, ok := files[e.Type]
+		if !ok {
+			var err error
+			f, err = createTypeFile(filepath.Join(*outDir, e.Type+".ndjson"))
+			if err != nil {
+				return err
+			}
+			files[e.Type] = f
+		}
+
+		return f.enc.Encode(e.Entry)
+	})
+	if err != nil {
+		return err
+	}
+
+	for typ, f := range files {
+		delete(files, typ)
+		if err := f.close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type typeFile struct {
+	f   *os.File
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func createTypeFile(path string) (*typeFile, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	w := bufio.NewWriter(f)
+	return &typeFile{f: f, w: w, enc: json.NewEncoder(w)}, nil
+}
+
+func (t *typeFile) close() error {
+	errFlush := t.w.Flush()
+	errClose := t.f.Close()
+	if errFlush != nil {
+		return errFlush
+	}
+
+	return errClose
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/decentrio/xdr-converter/calltrace"
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+// runCallTrace prints the contract call tree rebuilt from the diagnostic
+// events of each transaction meta, one JSON trace per input.
+func runCallTrace(args []string) error {
+	fs := flag.NewFlagSet("call-trace", flag.ContinueOnError)
+	typ := fs.String("type", "tx-meta", "XDR type: tx-meta (TransactionMeta) or meta (TransactionResultMeta)")
+	spec := fs.String("contract-spec", "", "name contract errors after the error enums of the contract Wasm or spec XDR at `path`")
+	var in inputFlags
+	in.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	errorSpec, err := loadContractSpec(*spec)
+	if err != nil {
+		return err
+	}
+
+	if *typ != "tx-meta" && *typ != "meta" {
+		return fmt.Errorf("unknown --type %q, expected tx-meta or meta", *typ)
+	}
+
+	inputs, err := in.read(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, input := range inputs {
+		bz, err := in.decodeBlob(input)
+		if err == nil {
+			bz, err = traceMeta(*typ, bz, errorSpec)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "input %d: %v\n", i, err)
+			failed++
+			continue
+		}
+
+		if err := in.write(os.Stdout, bz); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed to trace", failed, len(inputs))
+	}
+
+	return nil
+}
+
+func traceMeta(typ string, bz []byte, errorSpec converter.ContractSpec) ([]byte, error) {
+	var meta xdr.TransactionMeta
+	if typ == "meta" {
+		var resultMeta xdr.TransactionResultMeta
+		if err := resultMeta.UnmarshalBinary(bz); err != nil {
+			return nil, err
+		}
+		meta = resultMeta.TxApplyProcessing
+	} else if err := meta.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+
+	trace, err := calltrace.FromTransactionMeta(meta)
+	if err != nil {
+		return nil, err
+	}
+	errorSpec.NameContractErrors(&trace)
+
+	return json.Marshal(trace)
+}
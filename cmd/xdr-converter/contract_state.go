@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/contractstate"
+	"github.com/decentrio/xdr-converter/metastream"
+	"github.com/stellar/go/xdr"
+)
+
+// runContractState replays the contract data changes of a metadata output
+// stream and prints the storage of contracts, or the value of one key, as of
+// a ledger.
+func runContractState(args []string) error {
+	fs := flag.NewFlagSet("contract-state", flag.ContinueOnError)
+	in := fs.String("in", "-", "meta stream `path` (file or named pipe), - for stdin")
+	to := fs.Uint("to", 0, "stop reading after this ledger (0 for the end of the stream)")
+	ledger := fs.Uint("ledger", 0, "ledger to read the state at (0 for the last ledger read)")
+	contracts := fs.String("contract", "", "comma separated contract ids (default all, required with --key)")
+	key := fs.String("key", "", "base64 XDR of an ScVal key to print the value of instead of a snapshot")
+	storage := fs.String("storage", string(contractstate.StoragePersistent), "storage of --key: persistent, temporary or instance")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var contractIds []string
+	if *contracts != "" {
+		for _, id := range strings.Split(*contracts, ",") {
+			contractIds = append(contractIds, strings.TrimSpace(id))
+		}
+	}
+
+	var scKey xdr.ScVal
+	if *key != "" {
+		if len(contractIds) != 1 {
+			return fmt.Errorf("--key needs exactly one --contract")
+		}
+		if err := xdr.SafeUnmarshalBase64(*key, &scKey); err != nil {
+			return fmt.Errorf("invalid --key: %w", err)
+		}
+	}
+	keyStorage, err := contractstate.ParseStorage(*storage)
+	if err != nil {
+		return err
+	}
+
+	f := os.Stdin
+	if *in != "-" {
+		f, err = os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+
+	tracker := contractstate.NewTracker()
+	r := metastream.NewReader(f)
+	for {
+		meta, err := r.ReadXdr()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if *to != 0 && meta.LedgerSequence() > uint32(*to) {
+			break
+		}
+		if err := tracker.ApplyLedgerCloseMeta(meta); err != nil {
+			return err
+		}
+	}
+
+	at := uint32(*ledger)
+	if at == 0 {
+		at = tracker.Ledger()
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	if *key == "" {
+		snapshot, err := tracker.Snapshot(at, contractIds...)
+		if err != nil {
+			return err
+		}
+		return enc.Encode(snapshot)
+	}
+
+	entry, ok, err := tracker.Get(contractIds[0], keyStorage, scKey, at)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("key has no %s value in %s at ledger %d", keyStorage, contractIds[0], at)
+	}
+
+	return enc.Encode(entry)
+}
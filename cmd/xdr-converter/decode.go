@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/converter"
+)
+
+// decodeTypes lists the types accepted by --type.
+var decodeTypes = []string{"envelope", "result", "meta", "event", "event-body", "scval", "scval-info", "invoke-args", "ledger-key", "ledger-entry", "ledger-header", "ledger-meta", "bucket-entry"}
+
+func runDecode(args []string) error {
+	fs := flag.NewFlagSet("decode", flag.ContinueOnError)
+	typ := fs.String("type", "", "XDR type: "+strings.Join(decodeTypes, ", "))
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to derive the ids of created contracts")
+	enumNames := fs.Bool("enum-names", false, "add the XDR names of enums and flags next to their values")
+	spec := fs.String("contract-spec", "", "name contract errors after the error enums of the contract Wasm or spec XDR at `path`")
+	var in inputFlags
+	in.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	errorSpec, err := loadContractSpec(*spec)
+	if err != nil {
+		return err
+	}
+	opts := converter.Options{NetworkPassphrase: *passphrase, ErrorSpec: errorSpec, EnumNames: *enumNames}
+
+	decode, ok := converter.MarshalJSONFuncs[*typ]
+	if !ok {
+		return fmt.Errorf("unknown --type %q, expected one of %s", *typ, strings.Join(decodeTypes, ", "))
+	}
+
+	inputs, err := in.read(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, input := range inputs {
+		bz, err := in.decodeBlob(input)
+		if err == nil {
+			bz, err = decode(bz, opts)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "input %d: %v\n", i, err)
+			failed++
+			continue
+		}
+
+		if err := in.write(os.Stdout, bz); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed to decode", failed, len(inputs))
+	}
+
+	return nil
+}
+
+// loadContractSpec reads the spec contract errors are named after from a
+// contract Wasm or its raw spec entries. An empty path gives an empty spec.
+func loadContractSpec(path string) (converter.ContractSpec, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := converter.ParseContractSpec(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --contract-spec: %w", err)
+	}
+
+	return spec, nil
+}
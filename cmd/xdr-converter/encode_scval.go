@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+// runEncodeScVal builds ScVal XDR from typed values. The JSON produced by
+// decode drops XDR details, so it cannot be encoded back; ScVals built from
+// typed values are what callers need to query contract storage.
+func runEncodeScVal(args []string) error {
+	fs := flag.NewFlagSet("encode-scval", flag.ContinueOnError)
+	scValType := fs.String("scval-type", "", "ScVal type: bool, u32, i32, u64, i64, time, duration, u128, i128, u256, i256, bytes, string, sym, nonce, address or vec (type@value,...)")
+	output := fs.String("output", "base64", "output encoding: base64 or hex")
+	var in inputFlags
+	in.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	values, err := in.read(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	for i, value := range values {
+		scVal, err := converter.ConvertToData(*scValType, value)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+
+		var encoded string
+		switch *output {
+		case "base64":
+			encoded, err = xdr.MarshalBase64(scVal)
+		case "hex":
+			var bz []byte
+			bz, err = scVal.MarshalBinary()
+			encoded = hex.EncodeToString(bz)
+		default:
+			return fmt.Errorf("unknown --output %q", *output)
+		}
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+
+		fmt.Fprintln(os.Stdout, encoded)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/decentrio/xdr-converter/archive"
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/flatten"
+	"github.com/decentrio/xdr-converter/metastream"
+	"github.com/decentrio/xdr-converter/sink/postgres"
+)
+
+// flattenBatchSize is the number of transactions per Write, and so per
+// Parquet row group and Postgres transaction.
+const flattenBatchSize = 10000
+
+// runFlatten writes the transactions of a history archive or of a metadata
+// output stream as flat tables, to files or into Postgres. Archives carry no
+// meta, so they only fill the transactions, operations and operation_results
+// tables.
+func runFlatten(args []string) error {
+	fs := flag.NewFlagSet("flatten", flag.ContinueOnError)
+	format := fs.String("format", "csv", "output format: csv, parquet or postgres")
+	outDir := fs.String("out-dir", "", "directory for the table files")
+	dsn := fs.String("dsn", "", "Postgres connection string for --format postgres")
+	root := fs.String("root", "", "read from the history archive at this root instead of a meta stream")
+	in := fs.String("in", "-", "meta stream `path` (file or named pipe), - for stdin")
+	from := fs.Uint("from", 0, "first ledger")
+	to := fs.Uint("to", 0, "last ledger (0 for the end of the input)")
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to match transactions to results")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *outDir == "" && *format != "postgres" {
+		return fmt.Errorf("--out-dir is required")
+	}
+	if *dsn == "" && *format == "postgres" {
+		return fmt.Errorf("--dsn is required")
+	}
+
+	var w flatten.Writer
+	var err error
+	switch *format {
+	case "csv":
+		w, err = flatten.NewCSVWriter(*outDir)
+	case "parquet":
+		w, err = flatten.NewParquetWriter(*outDir)
+	case "postgres":
+		w, err = postgres.Open(context.Background(), *dsn)
+	default:
+		return fmt.Errorf("unknown --format %q, expected csv, parquet or postgres", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = flattenInto(w, *root, *in, uint32(*from), uint32(*to), *passphrase)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// flattenInto writes the transactions of the history archive at root, or of
+// the meta stream at in if root is empty, of the network identified by
+// passphrase to w in batches of flattenBatchSize transactions.
+func flattenInto(w flatten.Writer, root string, in string, from uint32, to uint32, passphrase string) error {
+	var rows flatten.Rows
+	add := func(txs []flatten.Transaction) error {
+		for _, tx := range txs {
+			if err := rows.Add(tx); err != nil {
+				return err
+			}
+		}
+
+		if len(rows.Transactions) < flattenBatchSize {
+			return nil
+		}
+		defer rows.Reset()
+		return w.Write(&rows)
+	}
+
+	var err error
+	if root != "" {
+		a := archive.NewArchive(root)
+		a.NetworkPassphrase = passphrase
+		err = a.ReadLedgersXdr(from, to, func(l archive.LedgerXdr) error {
+			return add(flatten.ArchiveTransactions(l, passphrase))
+		})
+	} else {
+		err = flattenMetaStream(in, from, to, passphrase, add)
+	}
+	if err != nil {
+		return err
+	}
+
+	return w.Write(&rows)
+}
+
+func flattenMetaStream(path string, from uint32, to uint32, passphrase string, add func([]flatten.Transaction) error) error {
+	f := os.Stdin
+	if path != "-" {
+		var err error
+		f, err = os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+
+	r := metastream.NewReader(f)
+	r.ResumeFrom(from)
+
+	for {
+		meta, err := r.ReadXdr()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if to != 0 && uint32(meta.LedgerSequence()) > to {
+			return nil
+		}
+
+		txs, err := flatten.LedgerCloseMetaTransactions(meta, passphrase)
+		if err != nil {
+			return err
+		}
+
+		if err := add(txs); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/decentrio/xdr-converter/converter"
+)
+
+type guessResult struct {
+	Input   int                     `json:"input"`
+	Matches []converter.DetectedXdr `json:"matches"`
+}
+
+func runGuess(args []string) error {
+	fs := flag.NewFlagSet("guess", flag.ContinueOnError)
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to derive the ids of created contracts")
+	enumNames := fs.Bool("enum-names", false, "add the XDR names of enums and flags next to their values")
+	var in inputFlags
+	in.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	inputs, err := in.read(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, input := range inputs {
+		bz, err := in.decodeBlob(input)
+		var matches []converter.DetectedXdr
+		if err == nil {
+			matches, err = converter.DetectAndConvert(bz, converter.Options{NetworkPassphrase: *passphrase, EnumNames: *enumNames})
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "input %d: %v\n", i, err)
+			failed++
+			continue
+		}
+
+		out, err := json.Marshal(guessResult{Input: i, Matches: matches})
+		if err != nil {
+			return err
+		}
+
+		if err := in.write(os.Stdout, out); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inputs failed to decode", failed, len(inputs))
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/converter"
+)
+
+// fileList collects repeated --file flags.
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+// inputFlags are the flags shared by every command that reads inputs.
+type inputFlags struct {
+	files  fileList
+	format string
+	pretty bool
+}
+
+func (in *inputFlags) register(fs *flag.FlagSet) {
+	fs.Var(&in.files, "file", "read inputs from `path`, one per line (repeatable, - for stdin)")
+	fs.StringVar(&in.format, "format", "auto", "input encoding: auto, base64 or hex")
+	fs.BoolVar(&in.pretty, "pretty", false, "indent JSON output")
+}
+
+// read returns the inputs given as arguments, in files, or on stdin.
+func (in *inputFlags) read(args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	if len(in.files) == 0 {
+		return readLines(os.Stdin)
+	}
+
+	var inputs []string
+	for _, path := range in.files {
+		lines, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, lines...)
+	}
+
+	return inputs, nil
+}
+
+func readFile(path string) ([]string, error) {
+	if path == "-" {
+		return readLines(os.Stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readLines(f)
+}
+
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+// decodeBlob turns a base64 or hex string into raw XDR bytes. Auto mode
+// guesses the encoding with converter.DecodeXdrString.
+func (in *inputFlags) decodeBlob(s string) ([]byte, error) {
+	switch in.format {
+	case "base64":
+		return base64.StdEncoding.DecodeString(s)
+	case "hex":
+		return hex.DecodeString(s)
+	case "auto":
+		bz, err := converter.DecodeXdrString(s)
+		if errors.Is(err, converter.ErrAmbiguousEncoding) {
+			return nil, fmt.Errorf("%w with --format", err)
+		}
+		return bz, err
+	}
+
+	return nil, fmt.Errorf("unknown input format %q", in.format)
+}
+
+func (in *inputFlags) write(w io.Writer, bz []byte) error {
+	if in.pretty {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, bz, "", "  "); err != nil {
+			return err
+		}
+		bz = buf.Bytes()
+	}
+
+	_, err := fmt.Fprintf(w, "%s\n", bz)
+	return err
+}
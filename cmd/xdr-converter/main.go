@@ -0,0 +1,117 @@
+// Command xdr-converter decodes Stellar XDR into the JSON produced by the
+// converter package.
+//
+// Usage:
+//
+//	xdr-converter decode --type envelope|result|meta|event|scval|ledger-key|ledger-entry|... [--network-passphrase P] [--contract-spec wasm] [--enum-names] [blob ...]
+//	xdr-converter encode-scval --scval-type u32|i128|sym|address|vec|... [value ...]
+//	xdr-converter guess [--network-passphrase P] [--enum-names] [blob ...]
+//	xdr-converter auth-audit [--network-passphrase P] [blob ...]
+//	xdr-converter auth-verify [--network-passphrase P] [--account XDR ...] [blob ...]
+//	xdr-converter call-trace [--type tx-meta|meta] [--contract-spec wasm] [blob ...]
+//	xdr-converter batch --type meta [--workers N] [--network-passphrase P] [--enum-names] [--in path] [--out path]
+//	xdr-converter archive --root DIR [--from N] [--to N] [--enum-names]
+//	xdr-converter buckets --root DIR [--checkpoint N] [--types account,...] [--out-dir DIR] [--enum-names]
+//	xdr-converter meta-stream [--in PATH] [--from N] [--enum-names]
+//	xdr-converter contract-state [--in PATH] [--to N] [--ledger N] [--contract C,...] [--key XDR --storage persistent|temporary|instance]
+//	xdr-converter archival [--root DIR [--checkpoint N]] [--in PATH] [--ledger N] [--extend-to N] [--status live,...]
+//	xdr-converter flatten --out-dir DIR [--format csv|parquet] [--root DIR | --in PATH] [--from N] [--to N]
+//	xdr-converter flatten --format postgres --dsn DSN [--root DIR | --in PATH] [--from N] [--to N]
+//	xdr-converter store ingest --db FILE [--root DIR | --in PATH] [--from N] [--to N]
+//	xdr-converter store query --db FILE [--limit N] tx|ops|events|data ARGS...
+//	xdr-converter serve [--addr :8080] [--enum-names]
+//	xdr-converter serve-grpc [--addr :9090]
+//
+// Inputs are taken from the arguments, from the files given with --file, or
+// otherwise from stdin, one per line. XDR blobs may be base64 or hex; pass
+// --format for the rare blob that reads both ways. decode and guess still
+// convert the remaining inputs when one fails, then exit with status 1.
+//
+// There is no general encode command: the JSON written by decode is meant for
+// reading and drops XDR details, so it cannot be turned back into the same
+// bytes. encode-scval covers what callers need in the other direction,
+// building the ScVal keys and arguments of contract calls from typed values.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `usage: xdr-converter <command> [flags] [input ...]
+
+commands:
+  decode       convert XDR blobs of a given type to JSON
+  encode-scval build ScVal XDR from typed values
+  guess        try every supported type on each XDR blob
+  auth-audit   list the signers, calls and mismatches of Soroban auth entries
+  auth-verify  verify the signatures of Soroban address credentials
+  call-trace   rebuild the contract call tree from diagnostic events
+  batch        convert newline-delimited base64 XDR to NDJSON in parallel
+  archive      read ledgers from a local history archive mirror
+  buckets      write the live ledger entries of a history archive state
+  meta-stream  convert a stellar-core metadata output stream
+  contract-state
+               print contract storage as of a ledger, replayed from a metadata stream
+  archival     report the TTL status and rent of contract data and code entries
+  flatten      export transactions as CSV or Parquet tables, or load them into Postgres
+  store        load ledgers into a SQLite file and query it
+  serve        serve decode and encode over HTTP
+  serve-grpc   serve the XdrConverter gRPC service
+
+run "xdr-converter <command> -h" for the flags of a command
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "decode":
+		err = runDecode(os.Args[2:])
+	case "encode-scval":
+		err = runEncodeScVal(os.Args[2:])
+	case "guess":
+		err = runGuess(os.Args[2:])
+	case "auth-audit":
+		err = runAuthAudit(os.Args[2:])
+	case "auth-verify":
+		err = runAuthVerify(os.Args[2:])
+	case "call-trace":
+		err = runCallTrace(os.Args[2:])
+	case "batch":
+		err = runBatch(os.Args[2:])
+	case "archive":
+		err = runArchive(os.Args[2:])
+	case "buckets":
+		err = runBuckets(os.Args[2:])
+	case "meta-stream":
+		err = runMetaStream(os.Args[2:])
+	case "contract-state":
+		err = runContractState(os.Args[2:])
+	case "archival":
+		err = runArchival(os.Args[2:])
+	case "flatten":
+		err = runFlatten(os.Args[2:])
+	case "store":
+		err = runStore(os.Args[2:])
+	case "serve":
+		err = runServe(os.Args[2:])
+	case "serve-grpc":
+		err = runServeGrpc(os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMain runs main instead of the tests when the test binary is started by
+// run, so commands are tested end to end over stdin and stdout.
+func TestMain(m *testing.M) {
+	if os.Getenv("XDR_CONVERTER_RUN_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// u32Hex is SCV_U32 42 in hex, and u32Base64 the same in base64.
+const (
+	u32Hex    = "000000030000002a"
+	u32Base64 = "AAAAAwAAACo="
+)
+
+func run(t *testing.T, stdin string, args ...string) (stdout string, stderr string, code int) {
+	t.Helper()
+
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "XDR_CONVERTER_RUN_MAIN=1")
+	cmd.Stdin = strings.NewReader(stdin)
+	var out, errOut bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &errOut
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	return out.String(), errOut.String(), code
+}
+
+func TestDecode(t *testing.T) {
+	want := "{\"u32\":42}\n{\"u32\":42}\n"
+
+	stdout, stderr, code := run(t, "", "decode", "--type", "scval", u32Hex, u32Base64)
+	if code != 0 || stdout != want {
+		t.Errorf("arguments: got %d %q %s", code, stdout, stderr)
+	}
+
+	stdout, stderr, code = run(t, u32Hex+"\n\n"+u32Base64+"\n", "decode", "--type", "scval")
+	if code != 0 || stdout != want {
+		t.Errorf("stdin: got %d %q %s", code, stdout, stderr)
+	}
+
+	path := filepath.Join(t.TempDir(), "blobs")
+	if err := os.WriteFile(path, []byte(u32Base64+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr, code = run(t, u32Hex+"\n", "decode", "--type", "scval", "--file", path, "--file", "-")
+	if code != 0 || stdout != want {
+		t.Errorf("files: got %d %q %s", code, stdout, stderr)
+	}
+
+	// The other inputs are still decoded.
+	stdout, stderr, code = run(t, u32Hex+"\nnot xdr\n"+u32Base64+"\n", "decode", "--type", "scval")
+	if code != 1 || stdout != want || !strings.Contains(stderr, "input 1:") {
+		t.Errorf("bad input: got %d %q %s", code, stdout, stderr)
+	}
+
+	_, stderr, code = run(t, "", "decode", "--type", "nope", u32Hex)
+	if code != 1 || !strings.Contains(stderr, "unknown --type") {
+		t.Errorf("unknown type: got %d %s", code, stderr)
+	}
+}
+
+func TestGuess(t *testing.T) {
+	stdout, stderr, code := run(t, u32Base64+"\nnot xdr\n", "guess")
+	if code != 1 || !strings.Contains(stderr, "input 1:") {
+		t.Errorf("got %d %s, want a failure for input 1", code, stderr)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("got output %q, want one line", stdout)
+	}
+	var result struct {
+		Input   int `json:"input"`
+		Matches []struct {
+			Type  string          `json:"type"`
+			Value json.RawMessage `json:"value"`
+		} `json:"matches"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &result); err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, m := range result.Matches {
+		found = found || m.Type == "ScVal" && string(m.Value) == `{"u32":42}`
+	}
+	if result.Input != 0 || !found {
+		t.Errorf("got %s, want input 0 read as ScVal 42", lines[0])
+	}
+
+	if _, _, code := run(t, u32Hex+"\n", "guess"); code != 0 {
+		t.Errorf("got status %d for a valid input", code)
+	}
+}
+
+func TestEncodeScVal(t *testing.T) {
+	stdout, stderr, code := run(t, "42\n", "encode-scval", "--scval-type", "u32")
+	if code != 0 || stdout != u32Base64+"\n" {
+		t.Errorf("got %d %q %s", code, stdout, stderr)
+	}
+
+	stdout, stderr, code = run(t, "", "encode-scval", "--scval-type", "u32", "--output", "hex", "42")
+	if code != 0 || stdout != u32Hex+"\n" {
+		t.Errorf("hex: got %d %q %s", code, stdout, stderr)
+	}
+
+	// Encoded values decode back to themselves.
+	encoded, _, _ := run(t, "", "encode-scval", "--scval-type", "sym", "transfer")
+	stdout, stderr, code = run(t, encoded, "decode", "--type", "scval")
+	if code != 0 || stdout != "{\"sym\":\"transfer\"}\n" {
+		t.Errorf("round trip: got %d %q %s", code, stdout, stderr)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	if _, stderr, code := run(t, ""); code != 2 || !strings.Contains(stderr, "usage:") {
+		t.Errorf("no command: got %d %s", code, stderr)
+	}
+	if _, stderr, code := run(t, "", "encode", "--type", "envelope"); code != 2 || !strings.Contains(stderr, "unknown command") {
+		t.Errorf("encode: got %d %s", code, stderr)
+	}
+}
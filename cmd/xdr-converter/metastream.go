@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+
+	"github.com/decentrio/xdr-converter/metastream"
+)
+
+// runMetaStream converts a stellar-core metadata output stream to NDJSON, one
+// ledger per line.
+func runMetaStream(args []string) error {
+	fs := flag.NewFlagSet("meta-stream", flag.ContinueOnError)
+	in := fs.String("in", "-", "stream `path` (file or named pipe), - for stdin")
+	from := fs.Uint("from", 0, "skip ledgers before this sequence")
+	enumNames := fs.Bool("enum-names", false, "add the XDR names of enums and flags next to their values")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	f := os.Stdin
+	if *in != "-" {
+		var err error
+		f, err = os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+
+	r := metastream.NewReader(f)
+	r.ResumeFrom(uint32(*from))
+	r.SetEnumNames(*enumNames)
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+
+	for {
+		ledger, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := enc.Encode(ledger); err != nil {
+			return err
+		}
+		// Ledgers arrive every few seconds; do not hold them back.
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+}
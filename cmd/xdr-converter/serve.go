@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/httpserver"
+)
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", ":8080", "listen address")
+	maxBody := fs.Int64("max-body-bytes", httpserver.DefaultMaxBodyBytes, "maximum request body size")
+	maxBatch := fs.Int("max-batch", httpserver.DefaultMaxBatchSize, "maximum number of blobs in a batch request")
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase of requests that do not name one")
+	enumNames := fs.Bool("enum-names", false, "add enum names to the output of requests that do not set enum_names")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr: *addr,
+		Handler: httpserver.NewServer(httpserver.Config{
+			MaxBodyBytes:      *maxBody,
+			MaxBatchSize:      *maxBatch,
+			NetworkPassphrase: *passphrase,
+			EnumNames:         *enumNames,
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      60 * time.Second,
+	}
+
+	fmt.Fprintf(os.Stderr, "listening on %s\n", *addr)
+	return srv.ListenAndServe()
+}
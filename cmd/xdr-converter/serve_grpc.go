@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/decentrio/xdr-converter/grpcserver"
+	"google.golang.org/grpc"
+)
+
+func runServeGrpc(args []string) error {
+	fs := flag.NewFlagSet("serve-grpc", flag.ContinueOnError)
+	addr := fs.String("addr", ":9090", "listen address")
+	maxMsg := fs.Int("max-msg-bytes", 4<<20, "maximum request message size")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer(grpc.MaxRecvMsgSize(*maxMsg))
+	grpcserver.Register(srv)
+
+	fmt.Fprintf(os.Stderr, "listening on %s\n", lis.Addr())
+	return srv.Serve(lis)
+}
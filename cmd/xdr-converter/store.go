@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/store/sqlite"
+	"github.com/stellar/go/xdr"
+)
+
+const storeUsage = `usage:
+  xdr-converter store ingest --db FILE [--root DIR | --in PATH] [--from N] [--to N]
+  xdr-converter store query --db FILE [--limit N] tx HASH
+  xdr-converter store query --db FILE [--limit N] ops ACCOUNT
+  xdr-converter store query --db FILE [--limit N] events CONTRACT
+  xdr-converter store query --db FILE [--limit N] data CONTRACT KEY
+
+KEY is the base64 XDR of the ScVal key of a contract data entry.
+`
+
+// runStore ingests ledgers into a SQLite file and queries it.
+func runStore(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing store subcommand\n\n%s", storeUsage)
+	}
+
+	switch args[0] {
+	case "ingest":
+		return runStoreIngest(args[1:])
+	case "query":
+		return runStoreQuery(args[1:])
+	}
+
+	return fmt.Errorf("unknown store subcommand %q\n\n%s", args[0], storeUsage)
+}
+
+func runStoreIngest(args []string) error {
+	fs := flag.NewFlagSet("store ingest", flag.ContinueOnError)
+	dbPath := fs.String("db", "", "SQLite database `file`, created if missing")
+	root := fs.String("root", "", "read from the history archive at this root instead of a meta stream")
+	in := fs.String("in", "-", "meta stream `path` (file or named pipe), - for stdin")
+	from := fs.Uint("from", 0, "first ledger")
+	to := fs.Uint("to", 0, "last ledger (0 for the end of the input)")
+	passphrase := fs.String("network-passphrase", converter.DefaultNetworkPassphrase, "network passphrase, used to match transactions to results")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *dbPath == "" {
+		return fmt.Errorf("--db is required")
+	}
+
+	store, err := sqlite.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+
+	err = flattenInto(store, *root, *in, uint32(*from), uint32(*to), *passphrase)
+	if closeErr := store.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// runStoreQuery prints the rows a query finds as NDJSON.
+func runStoreQuery(args []string) error {
+	fs := flag.NewFlagSet("store query", flag.ContinueOnError)
+	dbPath := fs.String("db", "", "SQLite database `file`")
+	limit := fs.Int("limit", 100, "maximum number of rows (0 for all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *dbPath == "" {
+		return fmt.Errorf("--db is required")
+	}
+	if _, err := os.Stat(*dbPath); err != nil {
+		return err
+	}
+
+	query := fs.Args()
+	want := map[string]int{"tx": 2, "ops": 2, "events": 2, "data": 3}
+	if len(query) == 0 || want[query[0]] != len(query) {
+		return fmt.Errorf("invalid query %q\n\n%s", query, storeUsage)
+	}
+
+	store, err := sqlite.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+
+	ctx := context.Background()
+	switch query[0] {
+	case "tx":
+		tx, err := store.TransactionByHash(ctx, query[1])
+		if errors.Is(err, sqlite.ErrNotFound) {
+			return fmt.Errorf("transaction %s not found", query[1])
+		}
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(tx); err != nil {
+			return err
+		}
+
+		ops, err := store.OperationsByTransaction(ctx, tx.LedgerSeq, tx.Hash)
+		if err != nil {
+			return err
+		}
+		return encodeRows(enc, ops)
+	case "ops":
+		ops, err := store.OperationsByAccount(ctx, query[1], *limit)
+		if err != nil {
+			return err
+		}
+		return encodeRows(enc, ops)
+	case "events":
+		events, err := store.EventsByContract(ctx, query[1], *limit)
+		if err != nil {
+			return err
+		}
+		return encodeRows(enc, events)
+	default:
+		var key xdr.ScVal
+		if err := xdr.SafeUnmarshalBase64(query[2], &key); err != nil {
+			return fmt.Errorf("invalid KEY: %w", err)
+		}
+
+		changes, err := store.ContractDataHistory(ctx, query[1], key, *limit)
+		if err != nil {
+			return err
+		}
+		return encodeRows(enc, changes)
+	}
+}
+
+func encodeRows[T any](enc *json.Encoder, rows []T) error {
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
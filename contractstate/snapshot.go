@@ -0,0 +1,266 @@
+package contractstate
+
+import (
+	"sort"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+// Snapshot is the readable storage of contracts as of the end of a ledger.
+type Snapshot struct {
+	Ledger    uint32            `json:"ledger"`
+	Contracts []ContractStorage `json:"contracts"`
+}
+
+type ContractStorage struct {
+	ContractId string `json:"contract_id"`
+	// InstanceLiveUntilLedger is the TTL of the contract instance, which
+	// instance storage shares.
+	InstanceLiveUntilLedger *uint32        `json:"instance_live_until_ledger,omitempty"`
+	Instance                []StorageEntry `json:"instance,omitempty"`
+	Persistent              []StorageEntry `json:"persistent,omitempty"`
+	Temporary               []StorageEntry `json:"temporary,omitempty"`
+}
+
+// StorageEntry is a key and its value. KeyXdr is the base64 XDR of the key,
+// for looking it up again. LastModifiedLedger and LiveUntilLedger are unset
+// for instance storage, see ContractStorage.
+type StorageEntry struct {
+	Key                converter.ScVal `json:"key"`
+	KeyXdr             string          `json:"key_xdr"`
+	Value              converter.ScVal `json:"value"`
+	LastModifiedLedger uint32          `json:"last_modified_ledger,omitempty"`
+	LiveUntilLedger    *uint32         `json:"live_until_ledger,omitempty"`
+}
+
+// Get returns the value of key in a contract's storage as of the end of
+// ledger. ok is false when the key has no readable value then.
+func (t *Tracker) Get(contractId string, storage Storage, key xdr.ScVal, ledger uint32) (result StorageEntry, ok bool, err error) {
+	contract, err := contractAddress(contractId)
+	if err != nil {
+		return result, false, err
+	}
+
+	if storage == StorageInstance {
+		instance, found, err := t.instanceStorage(contract, ledger)
+		if err != nil || !found {
+			return result, false, err
+		}
+
+		keyXdr, err := xdr.MarshalBase64(key)
+		if err != nil {
+			return result, false, err
+		}
+		for _, e := range instance {
+			if e.KeyXdr == keyXdr {
+				return e, true, nil
+			}
+		}
+
+		return result, false, nil
+	}
+
+	durability := xdr.ContractDataDurabilityPersistent
+	if storage == StorageTemporary {
+		durability = xdr.ContractDataDurabilityTemporary
+	}
+
+	hash, err := converter.TtlKeyHash(contractDataKey(contract, key, durability))
+	if err != nil {
+		return result, false, err
+	}
+
+	e, found := t.entries[hash]
+	if !found {
+		return result, false, nil
+	}
+
+	return t.readable(e, hash, ledger)
+}
+
+// Snapshot returns the storage of the given contracts, or of every contract
+// seen, as of the end of ledger. Contracts and keys are sorted.
+func (t *Tracker) Snapshot(ledger uint32, contractIds ...string) (Snapshot, error) {
+	result := Snapshot{Ledger: ledger}
+
+	wanted := make(map[string]bool, len(contractIds))
+	for _, id := range contractIds {
+		if _, err := contractAddress(id); err != nil {
+			return result, err
+		}
+		wanted[id] = true
+	}
+
+	hashes := make([]xdr.Hash, 0, len(t.entries))
+	for hash, e := range t.entries {
+		if len(wanted) == 0 || wanted[e.contractId] {
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		a, b := t.entries[hashes[i]], t.entries[hashes[j]]
+		if a.contractId != b.contractId {
+			return a.contractId < b.contractId
+		}
+		return a.keyXdr < b.keyXdr
+	})
+
+	// storageOf returns the storage of the entry's contract, starting it when
+	// the entry is the first readable one of the contract.
+	storageOf := func(e *entry) *ContractStorage {
+		if n := len(result.Contracts); n == 0 || result.Contracts[n-1].ContractId != e.contractId {
+			result.Contracts = append(result.Contracts, ContractStorage{ContractId: e.contractId})
+		}
+		return &result.Contracts[len(result.Contracts)-1]
+	}
+
+	for _, hash := range hashes {
+		e := t.entries[hash]
+
+		if e.key.Type == xdr.ScValTypeScvLedgerKeyContractInstance {
+			v, ok := e.at(ledger)
+			if !ok || !t.live(hash, ledger) {
+				continue
+			}
+
+			instance, err := instanceEntries(*v.value)
+			if err != nil {
+				return result, err
+			}
+			contract := storageOf(e)
+			contract.Instance = instance
+			if liveUntil, ok := t.liveUntil(hash, ledger); ok {
+				contract.InstanceLiveUntilLedger = &liveUntil
+			}
+			continue
+		}
+
+		entry, ok, err := t.readable(e, hash, ledger)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			continue
+		}
+
+		contract := storageOf(e)
+		if e.durability == xdr.ContractDataDurabilityTemporary {
+			contract.Temporary = append(contract.Temporary, entry)
+		} else {
+			contract.Persistent = append(contract.Persistent, entry)
+		}
+	}
+
+	return result, nil
+}
+
+func (t *Tracker) readable(e *entry, hash xdr.Hash, ledger uint32) (StorageEntry, bool, error) {
+	var result StorageEntry
+
+	v, ok := e.at(ledger)
+	if !ok || !t.live(hash, ledger) {
+		return result, false, nil
+	}
+
+	key, err := converter.ConvertScVal(e.key)
+	if err != nil {
+		return result, false, err
+	}
+	value, err := converter.ConvertScVal(*v.value)
+	if err != nil {
+		return result, false, err
+	}
+
+	result.Key = key
+	result.KeyXdr = e.keyXdr
+	result.Value = value
+	result.LastModifiedLedger = v.lastModified
+	if liveUntil, ok := t.liveUntil(hash, ledger); ok {
+		result.LiveUntilLedger = &liveUntil
+	}
+
+	return result, true, nil
+}
+
+// live reports whether the TTL of an entry, if any was seen, covers ledger.
+func (t *Tracker) live(hash xdr.Hash, ledger uint32) bool {
+	liveUntil, ok := t.liveUntil(hash, ledger)
+	return !ok || liveUntil >= ledger
+}
+
+func (t *Tracker) instanceStorage(contract xdr.ScAddress, ledger uint32) ([]StorageEntry, bool, error) {
+	hash, err := converter.TtlKeyHash(contractDataKey(contract, xdr.ScVal{Type: xdr.ScValTypeScvLedgerKeyContractInstance}, xdr.ContractDataDurabilityPersistent))
+	if err != nil {
+		return nil, false, err
+	}
+
+	e, ok := t.entries[hash]
+	if !ok {
+		return nil, false, nil
+	}
+
+	v, ok := e.at(ledger)
+	if !ok || !t.live(hash, ledger) {
+		return nil, false, nil
+	}
+
+	result, err := instanceEntries(*v.value)
+	return result, err == nil, err
+}
+
+// instanceEntries returns the storage of a contract instance sorted by key.
+func instanceEntries(instance xdr.ScVal) ([]StorageEntry, error) {
+	if instance.Instance == nil || instance.Instance.Storage == nil {
+		return nil, nil
+	}
+
+	var result []StorageEntry
+	for _, item := range *instance.Instance.Storage {
+		keyXdr, err := xdr.MarshalBase64(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		key, err := converter.ConvertScVal(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := converter.ConvertScVal(item.Val)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, StorageEntry{Key: key, KeyXdr: keyXdr, Value: value})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].KeyXdr < result[j].KeyXdr
+	})
+
+	return result, nil
+}
+
+func contractAddress(contractId string) (xdr.ScAddress, error) {
+	raw, err := strkey.Decode(strkey.VersionByteContract, contractId)
+	if err != nil {
+		return xdr.ScAddress{}, errors.Wrapf(err, "error invalid contract id %v", contractId)
+	}
+
+	var id xdr.ContractId
+	copy(id[:], raw)
+
+	return xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id}, nil
+}
+
+func contractDataKey(contract xdr.ScAddress, key xdr.ScVal, durability xdr.ContractDataDurability) xdr.LedgerKey {
+	return xdr.LedgerKey{
+		Type: xdr.LedgerEntryTypeContractData,
+		ContractData: &xdr.LedgerKeyContractData{
+			Contract:   contract,
+			Key:        key,
+			Durability: durability,
+		},
+	}
+}
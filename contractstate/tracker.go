@@ -0,0 +1,262 @@
+// Package contractstate rebuilds the storage of contracts from ledger entry
+// changes, so that a contract's storage can be read as of any ledger the
+// tracker has seen.
+//
+// Contract data entries and their TTL entries are versioned by the ledger
+// that changed them. An entry is readable at a ledger when it exists and its
+// TTL, if one was seen, has not run out: temporary entries past their TTL are
+// gone, persistent ones are archived until restored.
+package contractstate
+
+import (
+	"sort"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+type Storage string
+
+const (
+	StoragePersistent Storage = "persistent"
+	StorageTemporary  Storage = "temporary"
+	StorageInstance   Storage = "instance"
+)
+
+func ParseStorage(s string) (Storage, error) {
+	switch Storage(s) {
+	case StoragePersistent, StorageTemporary, StorageInstance:
+		return Storage(s), nil
+	}
+
+	return "", errors.Errorf("error invalid storage %q, expected persistent, temporary or instance", s)
+}
+
+// Tracker holds every version of the contract data and TTL entries it was
+// given. It is not safe for concurrent use.
+type Tracker struct {
+	entries map[xdr.Hash]*entry
+	ttls    map[xdr.Hash][]ttlVersion
+	ledger  uint32
+}
+
+type entry struct {
+	contractId string
+	key        xdr.ScVal
+	// keyXdr orders entries in snapshots.
+	keyXdr     string
+	durability xdr.ContractDataDurability
+	versions   []version
+}
+
+type version struct {
+	ledger       uint32
+	lastModified uint32
+	// value is nil from the ledger the entry was removed in.
+	value *xdr.ScVal
+}
+
+type ttlVersion struct {
+	ledger    uint32
+	liveUntil uint32
+	removed   bool
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		entries: make(map[xdr.Hash]*entry),
+		ttls:    make(map[xdr.Hash][]ttlVersion),
+	}
+}
+
+// Ledger returns the last ledger changes were applied for.
+func (t *Tracker) Ledger() uint32 {
+	return t.ledger
+}
+
+// ApplyLedgerCloseMeta applies every change of a ledger: fees, transactions,
+// fee refunds, upgrades and evictions.
+func (t *Tracker) ApplyLedgerCloseMeta(m xdr.LedgerCloseMeta) error {
+	if m.V > 2 {
+		return errors.Errorf("error invalid LedgerCloseMeta version %v", m.V)
+	}
+	ledger := m.LedgerSequence()
+
+	for i := 0; i < m.CountTransactions(); i++ {
+		if err := t.ApplyChanges(ledger, m.FeeProcessing(i)); err != nil {
+			return err
+		}
+		changes, err := converter.TransactionMetaChanges(m.TxApplyProcessing(i))
+		if err != nil {
+			return err
+		}
+		if err := t.ApplyChanges(ledger, changes); err != nil {
+			return err
+		}
+	}
+
+	if m.V == 2 {
+		for _, processing := range m.V2.TxProcessing {
+			if err := t.ApplyChanges(ledger, processing.PostTxApplyFeeProcessing); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, upgrade := range m.UpgradesProcessing() {
+		if err := t.ApplyChanges(ledger, upgrade.Changes); err != nil {
+			return err
+		}
+	}
+
+	evicted, err := m.EvictedLedgerKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range evicted {
+		removed := key
+		change := xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved, Removed: &removed}
+		if err := t.ApplyChanges(ledger, xdr.LedgerEntryChanges{change}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyChanges applies changes made in ledger. Ledgers must be applied in
+// order. Changes to entries other than contract data and TTLs are ignored.
+//
+// A STATE change of an entry the tracker has not seen records the entry as
+// of its last modified ledger, so that a tracker started mid-stream learns
+// the values of entries as they are touched.
+func (t *Tracker) ApplyChanges(ledger uint32, changes xdr.LedgerEntryChanges) error {
+	if ledger < t.ledger {
+		return errors.Errorf("error changes for ledger %d after ledger %d", ledger, t.ledger)
+	}
+	t.ledger = ledger
+
+	for _, change := range changes {
+		key, err := change.LedgerKey()
+		if err != nil {
+			return err
+		}
+
+		switch key.Type {
+		case xdr.LedgerEntryTypeContractData:
+			err = t.applyContractData(ledger, key, change)
+		case xdr.LedgerEntryTypeTtl:
+			t.applyTtl(ledger, key.Ttl.KeyHash, change)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Tracker) applyContractData(ledger uint32, key xdr.LedgerKey, change xdr.LedgerEntryChange) error {
+	hash, err := converter.TtlKeyHash(key)
+	if err != nil {
+		return err
+	}
+
+	e, ok := t.entries[hash]
+	if !ok {
+		e, err = newEntry(*key.ContractData)
+		if err != nil {
+			return err
+		}
+		t.entries[hash] = e
+	}
+
+	switch change.Type {
+	case xdr.LedgerEntryChangeTypeLedgerEntryState:
+		if len(e.versions) == 0 {
+			e.versions = append(e.versions, entryVersion(uint32(change.State.LastModifiedLedgerSeq), *change.State))
+		}
+	case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+		e.versions = append(e.versions, entryVersion(ledger, *change.Created))
+	case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+		e.versions = append(e.versions, entryVersion(ledger, *change.Updated))
+	case xdr.LedgerEntryChangeTypeLedgerEntryRestored:
+		e.versions = append(e.versions, entryVersion(ledger, *change.Restored))
+	case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+		e.versions = append(e.versions, version{ledger: ledger, lastModified: ledger})
+	}
+
+	return nil
+}
+
+func (t *Tracker) applyTtl(ledger uint32, hash xdr.Hash, change xdr.LedgerEntryChange) {
+	versions := t.ttls[hash]
+	switch change.Type {
+	case xdr.LedgerEntryChangeTypeLedgerEntryState:
+		if len(versions) == 0 {
+			entry := change.State
+			versions = append(versions, ttlVersion{ledger: uint32(entry.LastModifiedLedgerSeq), liveUntil: uint32(entry.Data.Ttl.LiveUntilLedgerSeq)})
+		}
+	case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+		versions = append(versions, ttlVersion{ledger: ledger, liveUntil: uint32(change.Created.Data.Ttl.LiveUntilLedgerSeq)})
+	case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+		versions = append(versions, ttlVersion{ledger: ledger, liveUntil: uint32(change.Updated.Data.Ttl.LiveUntilLedgerSeq)})
+	case xdr.LedgerEntryChangeTypeLedgerEntryRestored:
+		versions = append(versions, ttlVersion{ledger: ledger, liveUntil: uint32(change.Restored.Data.Ttl.LiveUntilLedgerSeq)})
+	case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+		versions = append(versions, ttlVersion{ledger: ledger, removed: true})
+	}
+	t.ttls[hash] = versions
+}
+
+func newEntry(key xdr.LedgerKeyContractData) (*entry, error) {
+	contractId, err := key.Contract.String()
+	if err != nil {
+		return nil, err
+	}
+
+	keyXdr, err := xdr.MarshalBase64(key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry{
+		contractId: contractId,
+		key:        key.Key,
+		keyXdr:     keyXdr,
+		durability: key.Durability,
+	}, nil
+}
+
+func entryVersion(ledger uint32, e xdr.LedgerEntry) version {
+	value := e.Data.ContractData.Val
+	return version{ledger: ledger, lastModified: uint32(e.LastModifiedLedgerSeq), value: &value}
+}
+
+// at returns the version of the entry as of the end of ledger.
+func (e *entry) at(ledger uint32) (version, bool) {
+	i := sort.Search(len(e.versions), func(i int) bool {
+		return e.versions[i].ledger > ledger
+	})
+	if i == 0 {
+		return version{}, false
+	}
+
+	v := e.versions[i-1]
+	return v, v.value != nil
+}
+
+// liveUntil returns the TTL of the entry with the given key hash as of the
+// end of ledger. ok is false when no TTL was seen.
+func (t *Tracker) liveUntil(hash xdr.Hash, ledger uint32) (uint32, bool) {
+	versions := t.ttls[hash]
+	i := sort.Search(len(versions), func(i int) bool {
+		return versions[i].ledger > ledger
+	})
+	if i == 0 || versions[i-1].removed {
+		return 0, false
+	}
+
+	return versions[i-1].liveUntil, true
+}
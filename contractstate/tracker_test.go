@@ -0,0 +1,303 @@
+package contractstate
+
+import (
+	"testing"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+var testContract = xdr.ContractId{0xcc}
+
+func testContractId() string {
+	return strkey.MustEncode(strkey.VersionByteContract, testContract[:])
+}
+
+func symbol(s string) xdr.ScVal {
+	sym := xdr.ScSymbol(s)
+	return xdr.ScVal{Type: xdr.ScValTypeScvSymbol, Sym: &sym}
+}
+
+func u32(v uint32) xdr.ScVal {
+	u := xdr.Uint32(v)
+	return xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &u}
+}
+
+func dataEntry(key xdr.ScVal, durability xdr.ContractDataDurability, val xdr.ScVal, lastModified uint32) xdr.LedgerEntry {
+	id := testContract
+	return xdr.LedgerEntry{
+		LastModifiedLedgerSeq: xdr.Uint32(lastModified),
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeContractData,
+			ContractData: &xdr.ContractDataEntry{
+				Contract:   xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id},
+				Key:        key,
+				Durability: durability,
+				Val:        val,
+			},
+		},
+	}
+}
+
+func ttlEntry(t *testing.T, e xdr.LedgerEntry, liveUntil uint32) xdr.LedgerEntry {
+	t.Helper()
+
+	key, err := e.LedgerKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := converter.TtlKeyHash(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return xdr.LedgerEntry{
+		LastModifiedLedgerSeq: e.LastModifiedLedgerSeq,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeTtl,
+			Ttl:  &xdr.TtlEntry{KeyHash: hash, LiveUntilLedgerSeq: xdr.Uint32(liveUntil)},
+		},
+	}
+}
+
+func created(e xdr.LedgerEntry) xdr.LedgerEntryChange {
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryCreated, Created: &e}
+}
+
+func updated(e xdr.LedgerEntry) xdr.LedgerEntryChange {
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated, Updated: &e}
+}
+
+func state(e xdr.LedgerEntry) xdr.LedgerEntryChange {
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryState, State: &e}
+}
+
+func removed(t *testing.T, e xdr.LedgerEntry) xdr.LedgerEntryChange {
+	t.Helper()
+
+	key, err := e.LedgerKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved, Removed: &key}
+}
+
+func apply(t *testing.T, tracker *Tracker, ledger uint32, changes ...xdr.LedgerEntryChange) {
+	t.Helper()
+
+	if err := tracker.ApplyChanges(ledger, changes); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// expectGet checks the value of key at each ledger, where a want of 0 means
+// no readable value.
+func expectGet(t *testing.T, tracker *Tracker, storage Storage, key xdr.ScVal, want map[uint32]uint32) {
+	t.Helper()
+
+	for ledger, value := range want {
+		got, ok, err := tracker.Get(testContractId(), storage, key, ledger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case value == 0 && ok:
+			t.Errorf("ledger %d: got %+v, want no value", ledger, got.Value)
+		case value != 0 && (!ok || got.Value.U32 == nil || *got.Value.U32 != value):
+			t.Errorf("ledger %d: got %+v, %v, want u32 %d", ledger, got.Value, ok, value)
+		}
+	}
+}
+
+func TestTemporaryExpiry(t *testing.T) {
+	tracker := NewTracker()
+	e := dataEntry(symbol("nonce"), xdr.ContractDataDurabilityTemporary, u32(1), 10)
+	apply(t, tracker, 10, created(e), created(ttlEntry(t, e, 20)))
+
+	expectGet(t, tracker, StorageTemporary, symbol("nonce"), map[uint32]uint32{9: 0, 10: 1, 20: 1, 21: 0})
+	// The same key in persistent storage is another entry.
+	expectGet(t, tracker, StoragePersistent, symbol("nonce"), map[uint32]uint32{10: 0})
+
+	got, _, err := tracker.Get(testContractId(), StorageTemporary, symbol("nonce"), 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LastModifiedLedger != 10 || got.LiveUntilLedger == nil || *got.LiveUntilLedger != 20 {
+		t.Errorf("got last modified %d, live until %v", got.LastModifiedLedger, got.LiveUntilLedger)
+	}
+}
+
+func TestArchivedPersistent(t *testing.T) {
+	tracker := NewTracker()
+	e := dataEntry(symbol("balance"), xdr.ContractDataDurabilityPersistent, u32(5), 10)
+	apply(t, tracker, 10, created(e), created(ttlEntry(t, e, 20)))
+	// Restored at ledger 30.
+	apply(t, tracker, 30, updated(ttlEntry(t, e, 50)))
+
+	expectGet(t, tracker, StoragePersistent, symbol("balance"), map[uint32]uint32{20: 5, 21: 0, 29: 0, 30: 5, 50: 5, 51: 0})
+
+	snapshot, err := tracker.Snapshot(25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Contracts) != 0 {
+		t.Errorf("got archived entries in %+v", snapshot)
+	}
+}
+
+func TestInstanceStorage(t *testing.T) {
+	tracker := NewTracker()
+	storage := xdr.ScMap{
+		{Key: symbol("admin"), Val: u32(1)},
+		{Key: symbol("fee"), Val: u32(3)},
+	}
+	instance := xdr.ScVal{Type: xdr.ScValTypeScvContractInstance, Instance: &xdr.ScContractInstance{
+		Executable: xdr.ContractExecutable{Type: xdr.ContractExecutableTypeContractExecutableStellarAsset},
+		Storage:    &storage,
+	}}
+	e := dataEntry(xdr.ScVal{Type: xdr.ScValTypeScvLedgerKeyContractInstance}, xdr.ContractDataDurabilityPersistent, instance, 10)
+	apply(t, tracker, 10, created(e), created(ttlEntry(t, e, 100)))
+
+	expectGet(t, tracker, StorageInstance, symbol("admin"), map[uint32]uint32{9: 0, 10: 1, 101: 0})
+	expectGet(t, tracker, StorageInstance, symbol("missing"), map[uint32]uint32{10: 0})
+
+	snapshot, err := tracker.Snapshot(50, testContractId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Contracts) != 1 {
+		t.Fatalf("got %+v", snapshot)
+	}
+	contract := snapshot.Contracts[0]
+	// Keys sort by their XDR, where the shorter symbol comes first.
+	if len(contract.Instance) != 2 || contract.Instance[0].Key.Sym == nil || *contract.Instance[0].Key.Sym != "fee" {
+		t.Errorf("got instance storage %+v", contract.Instance)
+	}
+	if contract.InstanceLiveUntilLedger == nil || *contract.InstanceLiveUntilLedger != 100 {
+		t.Errorf("got instance live until %v", contract.InstanceLiveUntilLedger)
+	}
+	if len(contract.Persistent) != 0 {
+		t.Errorf("got the instance as a persistent entry: %+v", contract.Persistent)
+	}
+}
+
+// A tracker started mid-stream learns an entry from the STATE change before
+// its first update.
+func TestFirstSeenThroughState(t *testing.T) {
+	tracker := NewTracker()
+	before := dataEntry(symbol("counter"), xdr.ContractDataDurabilityPersistent, u32(7), 50)
+	after := dataEntry(symbol("counter"), xdr.ContractDataDurabilityPersistent, u32(8), 100)
+	apply(t, tracker, 100, state(before), updated(after))
+	// A later STATE change does not rewrite what is known.
+	apply(t, tracker, 110, state(after))
+
+	expectGet(t, tracker, StoragePersistent, symbol("counter"), map[uint32]uint32{49: 0, 50: 7, 99: 7, 100: 8, 110: 8})
+
+	got, _, err := tracker.Get(testContractId(), StoragePersistent, symbol("counter"), 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LastModifiedLedger != 50 || got.LiveUntilLedger != nil {
+		t.Errorf("got last modified %d, live until %v", got.LastModifiedLedger, got.LiveUntilLedger)
+	}
+}
+
+func TestRemoveAndRecreate(t *testing.T) {
+	tracker := NewTracker()
+	first := dataEntry(symbol("offer"), xdr.ContractDataDurabilityPersistent, u32(1), 10)
+	second := dataEntry(symbol("offer"), xdr.ContractDataDurabilityPersistent, u32(2), 14)
+	apply(t, tracker, 10, created(first), created(ttlEntry(t, first, 100)))
+	apply(t, tracker, 12, removed(t, first), removed(t, ttlEntry(t, first, 100)))
+	apply(t, tracker, 14, created(second), created(ttlEntry(t, second, 100)))
+
+	expectGet(t, tracker, StoragePersistent, symbol("offer"), map[uint32]uint32{10: 1, 11: 1, 12: 0, 13: 0, 14: 2})
+
+	for ledger, want := range map[uint32]int{11: 1, 13: 0, 14: 1} {
+		snapshot, err := tracker.Snapshot(ledger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got int
+		for _, c := range snapshot.Contracts {
+			got += len(c.Persistent)
+		}
+		if got != want {
+			t.Errorf("ledger %d: got %d persistent entries, want %d", ledger, got, want)
+		}
+	}
+}
+
+func TestSnapshotOrder(t *testing.T) {
+	tracker := NewTracker()
+	var changes []xdr.LedgerEntryChange
+	for _, key := range []string{"b", "a", "c"} {
+		changes = append(changes, created(dataEntry(symbol(key), xdr.ContractDataDurabilityPersistent, u32(1), 10)))
+	}
+	changes = append(changes, created(dataEntry(symbol("t"), xdr.ContractDataDurabilityTemporary, u32(1), 10)))
+	apply(t, tracker, 10, changes...)
+
+	snapshot, err := tracker.Snapshot(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Contracts) != 1 || snapshot.Contracts[0].ContractId != testContractId() {
+		t.Fatalf("got %+v", snapshot)
+	}
+	var keys []string
+	for _, e := range snapshot.Contracts[0].Persistent {
+		keys = append(keys, string(*e.Key.Sym))
+	}
+	if len(keys) != 3 || keys[0] != "a" || keys[1] != "b" || keys[2] != "c" {
+		t.Errorf("got persistent keys %v, want [a b c]", keys)
+	}
+	if len(snapshot.Contracts[0].Temporary) != 1 {
+		t.Errorf("got temporary entries %+v", snapshot.Contracts[0].Temporary)
+	}
+
+	if _, err := tracker.Snapshot(10, "not a contract"); err == nil {
+		t.Error("got no error for an invalid contract id")
+	}
+	if err := tracker.ApplyChanges(9, nil); err == nil {
+		t.Error("got no error applying ledger 9 after 10")
+	}
+}
+
+// Protocol 23 meta restores archived entries with RESTORED changes.
+func TestRestoredInLedgerCloseMetaV2(t *testing.T) {
+	tracker := NewTracker()
+	e := dataEntry(symbol("balance"), xdr.ContractDataDurabilityPersistent, u32(5), 10)
+	apply(t, tracker, 10, created(e), created(ttlEntry(t, e, 20)))
+
+	restoredEntry := dataEntry(symbol("balance"), xdr.ContractDataDurabilityPersistent, u32(6), 30)
+	restoredTtl := ttlEntry(t, restoredEntry, 50)
+	meta := xdr.LedgerCloseMeta{V: 2, V2: &xdr.LedgerCloseMetaV2{
+		LedgerHeader: xdr.LedgerHeaderHistoryEntry{Header: xdr.LedgerHeader{LedgerSeq: 30}},
+		TxSet:        xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{}},
+		TxProcessing: []xdr.TransactionResultMetaV1{{
+			TxApplyProcessing: xdr.TransactionMeta{V: 4, V4: &xdr.TransactionMetaV4{
+				Operations: []xdr.OperationMetaV2{{Changes: xdr.LedgerEntryChanges{
+					{Type: xdr.LedgerEntryChangeTypeLedgerEntryRestored, Restored: &restoredEntry},
+					{Type: xdr.LedgerEntryChangeTypeLedgerEntryRestored, Restored: &restoredTtl},
+				}}},
+			}},
+		}},
+	}}
+	if err := tracker.ApplyLedgerCloseMeta(meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expectGet(t, tracker, StoragePersistent, symbol("balance"), map[uint32]uint32{20: 5, 21: 0, 30: 6, 50: 6, 51: 0})
+
+	meta.V = 3
+	if err := tracker.ApplyLedgerCloseMeta(meta); err == nil {
+		t.Error("applied LedgerCloseMeta V3")
+	}
+	meta.V = 2
+	meta.V2.LedgerHeader.Header.LedgerSeq = 31
+	meta.V2.TxProcessing[0].TxApplyProcessing.V = 5
+	if err := tracker.ApplyLedgerCloseMeta(meta); err == nil {
+		t.Error("applied TransactionMeta V5")
+	}
+}
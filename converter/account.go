@@ -17,7 +17,12 @@ func ConvertAccountId(a xdr.AccountId) (AccountId, error) {
 	return result, nil
 }
 
+// ConvertAccountEntry is ConvertAccountEntryWithOptions with the zero Options.
 func ConvertAccountEntry(e xdr.AccountEntry) (AccountEntry, error) {
+	return ConvertAccountEntryWithOptions(e, Options{})
+}
+
+func ConvertAccountEntryWithOptions(e xdr.AccountEntry, opts Options) (AccountEntry, error) {
 	var result AccountEntry
 
 	accountId, err := ConvertAccountId(e.AccountId)
@@ -51,6 +56,7 @@ func ConvertAccountEntry(e xdr.AccountEntry) (AccountEntry, error) {
 	result.NumSubEntries = uint32(e.NumSubEntries)
 	result.InflationDest = &inflationDest
 	result.Flags = uint32(e.Flags)
+	result.FlagNames = opts.accountFlagNames(uint32(e.Flags))
 	result.HomeDomain = string(e.HomeDomain)
 	result.Thresholds = e.Thresholds[:]
 	result.Signers = signers
@@ -178,6 +184,18 @@ func ConvertMuxedAccount(ma xdr.MuxedAccount) (MuxedAccount, error) {
 	}
 	result.Address = address
 
+	accountId := ma.ToAccountId()
+	result.AccountId, err = accountId.GetAddress()
+	if err != nil {
+		return result, err
+	}
+
+	if ma.Type == xdr.CryptoKeyTypeKeyTypeMuxedEd25519 {
+		muxedId := uint64(ma.Med25519.Id)
+		result.MuxedId = &muxedId
+		result.IsMuxed = true
+	}
+
 	return result, nil
 }
 
@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+)
+
+func TestConvertMuxedAccount(t *testing.T) {
+	// The muxed account test vectors of SEP-23 and their underlying G
+	// address.
+	const account = "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"
+
+	for _, tc := range []struct {
+		address string
+		muxedId *uint64
+	}{
+		{account, nil},
+		{"MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK", newUint64(9223372036854775808)},
+		{"MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ", newUint64(0)},
+	} {
+		var ma xdr.MuxedAccount
+		if err := ma.SetAddress(tc.address); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ConvertMuxedAccount(ma)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Address != tc.address || got.AccountId != account {
+			t.Errorf("%s: got address %s and account %s", tc.address, got.Address, got.AccountId)
+		}
+		if got.IsMuxed != (tc.muxedId != nil) {
+			t.Errorf("%s: got is_muxed %v", tc.address, got.IsMuxed)
+		}
+		if (got.MuxedId == nil) != (tc.muxedId == nil) || got.MuxedId != nil && *got.MuxedId != *tc.muxedId {
+			t.Errorf("%s: got muxed ID %v, want %v", tc.address, got.MuxedId, tc.muxedId)
+		}
+	}
+}
+
+func newUint64(v uint64) *uint64 {
+	return &v
+}
@@ -1,13 +1,20 @@
 package converter
 
 import (
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/xdr"
 )
 
+// ConvertTrustLineEntry is ConvertTrustLineEntryWithOptions with the zero Options.
 func ConvertTrustLineEntry(e xdr.TrustLineEntry) (TrustLineEntry, error) {
+	return ConvertTrustLineEntryWithOptions(e, Options{})
+}
+
+func ConvertTrustLineEntryWithOptions(e xdr.TrustLineEntry, opts Options) (TrustLineEntry, error) {
 	var result TrustLineEntry
 	accountId, err := ConvertAccountId(e.AccountId)
 	if err != nil {
@@ -26,6 +33,7 @@ func ConvertTrustLineEntry(e xdr.TrustLineEntry) (TrustLineEntry, error) {
 	result.Balance = int64(e.Balance)
 	result.Limit = int64(e.Limit)
 	result.Flags = uint32(e.Flags)
+	result.FlagNames = opts.trustLineFlagNames(uint32(e.Flags))
 	result.Ext = ext
 
 	return result, nil
@@ -79,6 +87,8 @@ func ConvertAsset(as xdr.Asset) (Asset, error) {
 	switch as.Type {
 	case xdr.AssetTypeAssetTypeNative:
 		result.AssetType = "native"
+		result.Canonical = "native"
+		result.Sep11 = "XLM"
 	case xdr.AssetTypeAssetTypeCreditAlphanum4:
 		result.AssetType = "alphanum4"
 		result.AssetCode = as.AlphaNum4.AssetCode[:]
@@ -88,6 +98,7 @@ func ConvertAsset(as xdr.Asset) (Asset, error) {
 			return result, err
 		}
 		result.Issuer = issuer
+		setCreditAssetNames(&result)
 
 		return result, nil
 	case xdr.AssetTypeAssetTypeCreditAlphanum12:
@@ -99,6 +110,7 @@ func ConvertAsset(as xdr.Asset) (Asset, error) {
 			return result, err
 		}
 		result.Issuer = issuer
+		setCreditAssetNames(&result)
 
 		return result, nil
 	case xdr.AssetTypeAssetTypePoolShare:
@@ -109,21 +121,48 @@ func ConvertAsset(as xdr.Asset) (Asset, error) {
 	return result, nil
 }
 
+// setCreditAssetNames fills in the human readable forms of a credit asset
+// once its code and issuer are set. Codes are NUL padded in XDR.
+func setCreditAssetNames(a *Asset) {
+	a.Code = strings.TrimRight(string(a.AssetCode), "\x00")
+	a.Canonical = a.Code + ":" + a.Issuer.Address
+	a.Sep11 = a.Canonical
+}
+
+// ConvertPoolShareAsset returns the asset representing shares of the given pool.
+// Pool shares have no SEP-11 form, so Sep11 is left empty and Canonical holds
+// the pool ID.
+func ConvertPoolShareAsset(poolId xdr.PoolId) Asset {
+	id := xdr.Hash(poolId).HexString()
+
+	return Asset{
+		AssetType:       "poolshare",
+		Canonical:       id,
+		LiquidityPoolId: id,
+	}
+}
+
 // TODO: testing
 func ConvertTrustLineAsset(a xdr.TrustLineAsset) (TrustLineAsset, error) {
 	var result TrustLineAsset
-	asset, err := ConvertAsset(a.ToAsset())
-	if err != nil {
-		return result, err
-	}
-	result.Asset = &asset
 
-	if a.LiquidityPoolId != nil {
+	if a.Type == xdr.AssetTypeAssetTypePoolShare {
 		xdrLpId := xdr.Hash(*a.LiquidityPoolId)
 		lpId := PoolId(xdrLpId[:])
+		asset := ConvertPoolShareAsset(*a.LiquidityPoolId)
+
+		result.Asset = &asset
 		result.LiquidityPoolId = &lpId
+
+		return result, nil
 	}
 
+	asset, err := ConvertAsset(a.ToAsset())
+	if err != nil {
+		return result, err
+	}
+	result.Asset = &asset
+
 	return result, nil
 }
 
@@ -161,7 +200,17 @@ func ConvertLiquidityPoolParameters(lpp xdr.LiquidityPoolParameters) (LiquidityP
 			return result, err
 		}
 
+		poolId, err := LiquidityPoolIdFromParameters(*lpp.ConstantProduct)
+		if err != nil {
+			return result, err
+		}
+
 		result.ConstantProduct = &lpcpp
+		result.LiquidityPoolId = xdr.Hash(poolId).HexString()
+		result.LiquidityPoolStrKey, err = LiquidityPoolStrKey(poolId)
+		if err != nil {
+			return result, err
+		}
 
 		return result, nil
 	}
@@ -173,21 +222,29 @@ func ConvertLiquidityPoolParameters(lpp xdr.LiquidityPoolParameters) (LiquidityP
 func ConvertChangeTrustAsset(ta xdr.ChangeTrustAsset) (ChangeTrustAsset, error) {
 	var result ChangeTrustAsset
 
-	asset, err := ConvertAsset(ta.ToAsset())
-	if err != nil {
-		return result, err
-	}
-	result.Asset = &asset
+	if ta.Type == xdr.AssetTypeAssetTypePoolShare {
+		liquidityPool, err := ConvertLiquidityPoolParameters(*ta.LiquidityPool)
+		if err != nil {
+			return result, err
+		}
 
-	var liquidityPool LiquidityPoolParameters
-	if ta.LiquidityPool != nil {
-		var err error
-		liquidityPool, err = ConvertLiquidityPoolParameters(*ta.LiquidityPool)
+		poolId, err := LiquidityPoolIdFromParameters(*ta.LiquidityPool.ConstantProduct)
 		if err != nil {
 			return result, err
 		}
+		asset := ConvertPoolShareAsset(poolId)
+
+		result.Asset = &asset
+		result.LiquidityPool = &liquidityPool
+
+		return result, nil
 	}
-	result.LiquidityPool = &liquidityPool
+
+	asset, err := ConvertAsset(ta.ToAsset())
+	if err != nil {
+		return result, err
+	}
+	result.Asset = &asset
 
 	return result, nil
 }
@@ -289,7 +346,12 @@ func ConvertClaimant(c xdr.Claimant) (Claimant, error) {
 	return result, errors.Errorf("invalid claimant type %v", c.Type)
 }
 
+// ConvertConvertClaimableBalanceEntry is ConvertConvertClaimableBalanceEntryWithOptions with the zero Options.
 func ConvertConvertClaimableBalanceEntry(e xdr.ClaimableBalanceEntry) (ClaimableBalanceEntry, error) {
+	return ConvertConvertClaimableBalanceEntryWithOptions(e, Options{})
+}
+
+func ConvertConvertClaimableBalanceEntryWithOptions(e xdr.ClaimableBalanceEntry, opts Options) (ClaimableBalanceEntry, error) {
 	var result ClaimableBalanceEntry
 
 	balanceId, err := ConvertClaimableBalanceId(e.BalanceId)
@@ -312,7 +374,7 @@ func ConvertConvertClaimableBalanceEntry(e xdr.ClaimableBalanceEntry) (Claimable
 		return result, err
 	}
 
-	ext := ConvertClaimableBalanceEntryExt(e.Ext)
+	ext := ConvertClaimableBalanceEntryExtWithOptions(e.Ext, opts)
 
 	result.BalanceId = balanceId
 	result.Claimants = claimants
@@ -323,10 +385,15 @@ func ConvertConvertClaimableBalanceEntry(e xdr.ClaimableBalanceEntry) (Claimable
 	return result, nil
 }
 
+// ConvertClaimableBalanceEntryExt is ConvertClaimableBalanceEntryExtWithOptions with the zero Options.
 func ConvertClaimableBalanceEntryExt(e xdr.ClaimableBalanceEntryExt) ClaimableBalanceEntryExt {
+	return ConvertClaimableBalanceEntryExtWithOptions(e, Options{})
+}
+
+func ConvertClaimableBalanceEntryExtWithOptions(e xdr.ClaimableBalanceEntryExt, opts Options) ClaimableBalanceEntryExt {
 	var v1 ClaimableBalanceEntryExtensionV1
 	if e.V1 != nil {
-		v1 = ConvertClaimableBalanceEntryExtensionV1(*e.V1)
+		v1 = ConvertClaimableBalanceEntryExtensionV1WithOptions(*e.V1, opts)
 	}
 
 	return ClaimableBalanceEntryExt{
@@ -335,10 +402,16 @@ func ConvertClaimableBalanceEntryExt(e xdr.ClaimableBalanceEntryExt) ClaimableBa
 	}
 }
 
+// ConvertClaimableBalanceEntryExtensionV1 is ConvertClaimableBalanceEntryExtensionV1WithOptions with the zero Options.
 func ConvertClaimableBalanceEntryExtensionV1(e xdr.ClaimableBalanceEntryExtensionV1) ClaimableBalanceEntryExtensionV1 {
+	return ConvertClaimableBalanceEntryExtensionV1WithOptions(e, Options{})
+}
+
+func ConvertClaimableBalanceEntryExtensionV1WithOptions(e xdr.ClaimableBalanceEntryExtensionV1, opts Options) ClaimableBalanceEntryExtensionV1 {
 	return ClaimableBalanceEntryExtensionV1{
-		Flags: uint32(e.Flags),
-		Ext:   ConvertClaimableBalanceEntryExtensionV1Ext(e.Ext),
+		Flags:     uint32(e.Flags),
+		FlagNames: opts.claimableBalanceFlagNames(uint32(e.Flags)),
+		Ext:       ConvertClaimableBalanceEntryExtensionV1Ext(e.Ext),
 	}
 }
 
@@ -354,6 +427,17 @@ func ConvertClaimableBalanceId(id xdr.ClaimableBalanceId) (ClaimableBalanceId, e
 	case xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0:
 		v0 := (*id.V0).HexString()
 		result.V0 = &v0
+
+		hex, err := xdr.MarshalHex(id)
+		if err != nil {
+			return result, err
+		}
+		result.Hex = hex
+		result.StrKey, err = strkey.Encode(strkey.VersionByteClaimableBalance, append([]byte{byte(id.Type)}, (*id.V0)[:]...))
+		if err != nil {
+			return result, err
+		}
+
 		return result, nil
 	}
 
@@ -553,7 +637,12 @@ func ConvertSimplePaymentResult(r xdr.SimplePaymentResult) (SimplePaymentResult,
 	return result, nil
 }
 
+// ConvertManageOfferSuccessResult is ConvertManageOfferSuccessResultWithOptions with the zero Options.
 func ConvertManageOfferSuccessResult(r xdr.ManageOfferSuccessResult) (ManageOfferSuccessResult, error) {
+	return ConvertManageOfferSuccessResultWithOptions(r, Options{})
+}
+
+func ConvertManageOfferSuccessResultWithOptions(r xdr.ManageOfferSuccessResult, opts Options) (ManageOfferSuccessResult, error) {
 	var result ManageOfferSuccessResult
 
 	var offersClaimed []ClaimAtom
@@ -566,7 +655,7 @@ func ConvertManageOfferSuccessResult(r xdr.ManageOfferSuccessResult) (ManageOffe
 		offersClaimed = append(offersClaimed, offer)
 	}
 
-	offer, err := ConvertManageOfferSuccessResultOffer(r.Offer)
+	offer, err := ConvertManageOfferSuccessResultOfferWithOptions(r.Offer, opts)
 	if err != nil {
 		return result, err
 	}
@@ -576,15 +665,21 @@ func ConvertManageOfferSuccessResult(r xdr.ManageOfferSuccessResult) (ManageOffe
 	return result, nil
 }
 
+// ConvertManageOfferSuccessResultOffer is ConvertManageOfferSuccessResultOfferWithOptions with the zero Options.
 func ConvertManageOfferSuccessResultOffer(r xdr.ManageOfferSuccessResultOffer) (ManageOfferSuccessResultOffer, error) {
+	return ConvertManageOfferSuccessResultOfferWithOptions(r, Options{})
+}
+
+func ConvertManageOfferSuccessResultOfferWithOptions(r xdr.ManageOfferSuccessResultOffer, opts Options) (ManageOfferSuccessResultOffer, error) {
 	var result ManageOfferSuccessResultOffer
 
 	result.Effect = int32(r.Effect)
+	result.EffectName = opts.enumName(r.Effect)
 
 	var offer OfferEntry
 	var err error
 	if r.Offer != nil {
-		offer, err = ConvertOfferEntry(*r.Offer)
+		offer, err = ConvertOfferEntryWithOptions(*r.Offer, opts)
 		if err != nil {
 			return result, err
 		}
@@ -594,7 +689,12 @@ func ConvertManageOfferSuccessResultOffer(r xdr.ManageOfferSuccessResultOffer) (
 	return result, nil
 }
 
+// ConvertOfferEntry is ConvertOfferEntryWithOptions with the zero Options.
 func ConvertOfferEntry(e xdr.OfferEntry) (OfferEntry, error) {
+	return ConvertOfferEntryWithOptions(e, Options{})
+}
+
+func ConvertOfferEntryWithOptions(e xdr.OfferEntry, opts Options) (OfferEntry, error) {
 	var result OfferEntry
 
 	sellerId, err := ConvertAccountId(e.SellerId)
@@ -621,6 +721,7 @@ func ConvertOfferEntry(e xdr.OfferEntry) (OfferEntry, error) {
 	result.Amount = int64(e.Amount)
 	result.Price = price
 	result.Flags = uint32(e.Flags)
+	result.FlagNames = opts.offerEntryFlagNames(uint32(e.Flags))
 	result.Ext = ConvertOfferEntryExt(e.Ext)
 
 	return result, nil
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+)
+
+const usdcIssuer = "GA5ZSEJYB37JRC5AVCIA5MOP4RHTM335X2KGX3IHOJAPP5RE34K4KZVN"
+
+func TestConvertAssetNames(t *testing.T) {
+	for _, tc := range []struct {
+		asset     xdr.Asset
+		code      string
+		canonical string
+		sep11     string
+	}{
+		{xdr.MustNewNativeAsset(), "", "native", "XLM"},
+		{xdr.MustNewCreditAsset("USDC", usdcIssuer), "USDC", "USDC:" + usdcIssuer, "USDC:" + usdcIssuer},
+		{xdr.MustNewCreditAsset("AB", usdcIssuer), "AB", "AB:" + usdcIssuer, "AB:" + usdcIssuer},
+		{xdr.MustNewCreditAsset("LONGERCODE", usdcIssuer), "LONGERCODE", "LONGERCODE:" + usdcIssuer, "LONGERCODE:" + usdcIssuer},
+	} {
+		got, err := ConvertAsset(tc.asset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Code != tc.code || got.Canonical != tc.canonical || got.Sep11 != tc.sep11 {
+			t.Errorf("got code %q, canonical %q and sep11 %q, want %q, %q and %q",
+				got.Code, got.Canonical, got.Sep11, tc.code, tc.canonical, tc.sep11)
+		}
+	}
+}
+
+func TestConvertChangeTrustAsset(t *testing.T) {
+	credit := xdr.MustNewCreditAsset("USDC", usdcIssuer)
+
+	got, err := ConvertChangeTrustAsset(credit.ToChangeTrustAsset())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Asset == nil || got.Asset.Canonical != "USDC:"+usdcIssuer || got.LiquidityPool != nil {
+		t.Errorf("credit: got asset %+v and pool %+v", got.Asset, got.LiquidityPool)
+	}
+
+	params := xdr.LiquidityPoolConstantProductParameters{
+		AssetA: xdr.MustNewNativeAsset(),
+		AssetB: credit,
+		Fee:    xdr.LiquidityPoolFeeV18,
+	}
+	poolId, err := xdr.NewPoolId(params.AssetA, params.AssetB, params.Fee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := hex.EncodeToString(poolId[:])
+
+	got, err = ConvertChangeTrustAsset(xdr.ChangeTrustAsset{
+		Type: xdr.AssetTypeAssetTypePoolShare,
+		LiquidityPool: &xdr.LiquidityPoolParameters{
+			Type:            xdr.LiquidityPoolTypeLiquidityPoolConstantProduct,
+			ConstantProduct: &params,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LiquidityPool == nil || got.Asset == nil {
+		t.Fatalf("pool share: got asset %+v and pool %+v", got.Asset, got.LiquidityPool)
+	}
+	if got.Asset.LiquidityPoolId != want || got.Asset.Canonical != want || got.Asset.Sep11 != "" {
+		t.Errorf("pool share: got %+v, want pool %s and no sep11 name", got.Asset, want)
+	}
+}
+
+func TestConvertClaimableBalanceId(t *testing.T) {
+	var hash xdr.Hash
+	bz, _ := hex.DecodeString(strkeyPayload)
+	copy(hash[:], bz)
+
+	got, err := ConvertClaimableBalanceId(xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &hash,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.V0 == nil || *got.V0 != strkeyPayload || got.Hex != "00000000"+strkeyPayload {
+		t.Errorf("got %+v", got)
+	}
+	if got.StrKey != "BAAD6DBUX6J22DMZOHIEZTEQ64CVCHEDRKWZONFEUL5Q26QD7R76RGR4TU" {
+		t.Errorf("got strkey %s", got.StrKey)
+	}
+}
@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ConvertBucketEntry converts an entry of a bucket file. LIVEENTRY and
+// INITENTRY both carry the entry in LiveEntry.
+func ConvertBucketEntry(e xdr.BucketEntry, opts Options) (BucketEntry, error) {
+	var result BucketEntry
+	result.Type = int32(e.Type)
+	result.TypeName = opts.enumName(e.Type)
+
+	switch e.Type {
+	case xdr.BucketEntryTypeLiveentry, xdr.BucketEntryTypeInitentry:
+		entry, err := ConvertLedgerEntryWithOptions(*e.LiveEntry, opts)
+		if err != nil {
+			return result, err
+		}
+
+		result.LiveEntry = &entry
+		return result, nil
+	case xdr.BucketEntryTypeDeadentry:
+		key, err := ConvertLedgerKeyWithOptions(*e.DeadEntry, opts)
+		if err != nil {
+			return result, err
+		}
+
+		result.DeadEntry = &key
+		return result, nil
+	case xdr.BucketEntryTypeMetaentry:
+		meta := ConvertBucketMetadata(*e.MetaEntry)
+		result.MetaEntry = &meta
+		return result, nil
+	}
+
+	return result, errors.Errorf("error invalid BucketEntry type %v", e.Type)
+}
+
+func ConvertBucketMetadata(m xdr.BucketMetadata) BucketMetadata {
+	return BucketMetadata{
+		LedgerVersion: uint32(m.LedgerVersion),
+		Ext:           BucketMetadataExt{V: m.Ext.V},
+	}
+}
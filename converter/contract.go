@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -8,10 +9,16 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/xdr"
 )
 
+// ConvertSorobanAuthorizationEntry is ConvertSorobanAuthorizationEntryWithOptions with the zero Options.
 func ConvertSorobanAuthorizationEntry(e xdr.SorobanAuthorizationEntry) (SorobanAuthorizationEntry, error) {
+	return ConvertSorobanAuthorizationEntryWithOptions(e, Options{})
+}
+
+func ConvertSorobanAuthorizationEntryWithOptions(e xdr.SorobanAuthorizationEntry, opts Options) (SorobanAuthorizationEntry, error) {
 	var result SorobanAuthorizationEntry
 
 	credentials, err := ConvertSorobanCredentials(e.Credentials)
@@ -19,7 +26,7 @@ func ConvertSorobanAuthorizationEntry(e xdr.SorobanAuthorizationEntry) (SorobanA
 		return result, err
 	}
 
-	rootInvocation, err := ConvertSorobanAuthorizedInvocation(e.RootInvocation)
+	rootInvocation, err := ConvertSorobanAuthorizedInvocationWithOptions(e.RootInvocation, opts)
 	if err != nil {
 		return result, err
 	}
@@ -70,9 +77,14 @@ func ConvertSorobanAddressCredentials(c xdr.SorobanAddressCredentials) (SorobanA
 	return result, nil
 }
 
+// ConvertSorobanAuthorizedInvocation is ConvertSorobanAuthorizedInvocationWithOptions with the zero Options.
 func ConvertSorobanAuthorizedInvocation(i xdr.SorobanAuthorizedInvocation) (SorobanAuthorizedInvocation, error) {
+	return ConvertSorobanAuthorizedInvocationWithOptions(i, Options{})
+}
+
+func ConvertSorobanAuthorizedInvocationWithOptions(i xdr.SorobanAuthorizedInvocation, opts Options) (SorobanAuthorizedInvocation, error) {
 	var result SorobanAuthorizedInvocation
-	function, err := ConvertSorobanAuthorizedFunction(i.Function)
+	function, err := ConvertSorobanAuthorizedFunctionWithOptions(i.Function, opts)
 	if err != nil {
 		return result, err
 	}
@@ -80,7 +92,7 @@ func ConvertSorobanAuthorizedInvocation(i xdr.SorobanAuthorizedInvocation) (Soro
 
 	var subs []SorobanAuthorizedInvocation
 	for _, xdrSub := range i.SubInvocations {
-		sub, err := ConvertSorobanAuthorizedInvocation(xdrSub)
+		sub, err := ConvertSorobanAuthorizedInvocationWithOptions(xdrSub, opts)
 		if err != nil {
 			return result, err
 		}
@@ -92,7 +104,12 @@ func ConvertSorobanAuthorizedInvocation(i xdr.SorobanAuthorizedInvocation) (Soro
 	return result, nil
 }
 
+// ConvertSorobanAuthorizedFunction is ConvertSorobanAuthorizedFunctionWithOptions with the zero Options.
 func ConvertSorobanAuthorizedFunction(f xdr.SorobanAuthorizedFunction) (SorobanAuthorizedFunction, error) {
+	return ConvertSorobanAuthorizedFunctionWithOptions(f, Options{})
+}
+
+func ConvertSorobanAuthorizedFunctionWithOptions(f xdr.SorobanAuthorizedFunction, opts Options) (SorobanAuthorizedFunction, error) {
 	var result SorobanAuthorizedFunction
 	switch f.Type {
 	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn:
@@ -104,7 +121,7 @@ func ConvertSorobanAuthorizedFunction(f xdr.SorobanAuthorizedFunction) (SorobanA
 
 		return result, nil
 	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn:
-		createContract, err := ConvertCreateContractArgs(*f.CreateContractHostFn)
+		createContract, err := ConvertCreateContractArgsWithOptions(*f.CreateContractHostFn, opts)
 		if err != nil {
 			return result, err
 		}
@@ -116,38 +133,53 @@ func ConvertSorobanAuthorizedFunction(f xdr.SorobanAuthorizedFunction) (SorobanA
 	return result, errors.Errorf("Invalid SorobanAuthorizedFunction type %v", f.Type)
 }
 
+// ConvertSorobanTransactionData is ConvertSorobanTransactionDataWithOptions with the zero Options.
 func ConvertSorobanTransactionData(d xdr.SorobanTransactionData) (SorobanTransactionData, error) {
+	return ConvertSorobanTransactionDataWithOptions(d, Options{})
+}
+
+func ConvertSorobanTransactionDataWithOptions(d xdr.SorobanTransactionData, opts Options) (SorobanTransactionData, error) {
 	var result SorobanTransactionData
 
-	resources, err := ConvertSorobanResources(d.Resources)
+	resources, err := ConvertSorobanResourcesWithOptions(d.Resources, opts)
 	if err != nil {
 		return result, err
 	}
 
-	result.Ext = ConvertExtensionPoint(d.Ext)
+	result.Ext = ExtensionPoint{V: d.Ext.V}
 	result.Resources = resources
 	result.ResourceFee = int64(d.ResourceFee)
 
 	return result, nil
 }
 
+// ConvertSorobanResources is ConvertSorobanResourcesWithOptions with the zero Options.
 func ConvertSorobanResources(r xdr.SorobanResources) (SorobanResources, error) {
+	return ConvertSorobanResourcesWithOptions(r, Options{})
+}
+
+func ConvertSorobanResourcesWithOptions(r xdr.SorobanResources, opts Options) (SorobanResources, error) {
 	var result SorobanResources
 
-	footPrint, err := ConvertLedgerFootprint(r.Footprint)
+	footPrint, err := ConvertLedgerFootprintWithOptions(r.Footprint, opts)
 	if err != nil {
 		return result, err
 	}
 
 	result.Footprint = footPrint
 	result.Instructions = uint32(r.Instructions)
-	result.ReadBytes = uint32(r.ReadBytes)
+	result.ReadBytes = uint32(r.DiskReadBytes)
 	result.WriteBytes = uint32(r.WriteBytes)
 
 	return result, nil
 }
 
+// ConvertHostFunction is ConvertHostFunctionWithOptions with the zero Options.
 func ConvertHostFunction(f xdr.HostFunction) (HostFunction, error) {
+	return ConvertHostFunctionWithOptions(f, Options{})
+}
+
+func ConvertHostFunctionWithOptions(f xdr.HostFunction, opts Options) (HostFunction, error) {
 	var result HostFunction
 	switch f.Type {
 	case xdr.HostFunctionTypeHostFunctionTypeInvokeContract:
@@ -159,7 +191,7 @@ func ConvertHostFunction(f xdr.HostFunction) (HostFunction, error) {
 
 		return result, nil
 	case xdr.HostFunctionTypeHostFunctionTypeCreateContract:
-		createContract, err := ConvertCreateContractArgs(*f.CreateContract)
+		createContract, err := ConvertCreateContractArgsWithOptions(*f.CreateContract, opts)
 		if err != nil {
 			return result, err
 		}
@@ -203,7 +235,12 @@ func ConvertInvokeContractArgs(a xdr.InvokeContractArgs) (InvokeContractArgs, er
 	return result, nil
 }
 
+// ConvertCreateContractArgs is ConvertCreateContractArgsWithOptions with the zero Options.
 func ConvertCreateContractArgs(a xdr.CreateContractArgs) (CreateContractArgs, error) {
+	return ConvertCreateContractArgsWithOptions(a, Options{})
+}
+
+func ConvertCreateContractArgsWithOptions(a xdr.CreateContractArgs, opts Options) (CreateContractArgs, error) {
 	var result CreateContractArgs
 
 	contractIdPreimage, err := ConvertContractIdPreimage(a.ContractIdPreimage)
@@ -216,8 +253,14 @@ func ConvertCreateContractArgs(a xdr.CreateContractArgs) (CreateContractArgs, er
 		return result, err
 	}
 
+	contractId, err := ContractIdFromPreimage(a.ContractIdPreimage, opts.Passphrase())
+	if err != nil {
+		return result, err
+	}
+
 	result.ContractIdPreimage = contractIdPreimage
 	result.Executable = executable
+	result.ContractId = contractId
 
 	return result, nil
 }
@@ -273,6 +316,38 @@ func ConvertContractIdPreimageFromAddress(p xdr.ContractIdPreimageFromAddress) (
 	return result, nil
 }
 
+// ContractIdFromPreimage derives the contract address (C...) that a contract
+// created from the given preimage receives on the network identified by
+// passphrase. An empty passphrase means DefaultNetworkPassphrase, as it does in
+// Options.
+func ContractIdFromPreimage(p xdr.ContractIdPreimage, passphrase string) (string, error) {
+	networkId := xdr.Hash(sha256.Sum256([]byte(PassphraseOrDefault(passphrase))))
+	preimage := xdr.HashIdPreimage{
+		Type: xdr.EnvelopeTypeEnvelopeTypeContractId,
+		ContractId: &xdr.HashIdPreimageContractId{
+			NetworkId:          networkId,
+			ContractIdPreimage: p,
+		},
+	}
+
+	bz, err := preimage.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	contractId := sha256.Sum256(bz)
+
+	return strkey.Encode(strkey.VersionByteContract, contractId[:])
+}
+
+// AssetContractId returns the address of the Stellar Asset Contract for the given asset.
+func AssetContractId(a xdr.Asset, passphrase string) (string, error) {
+	return ContractIdFromPreimage(xdr.ContractIdPreimage{
+		Type:      xdr.ContractIdPreimageTypeContractIdPreimageFromAsset,
+		FromAsset: &a,
+	}, passphrase)
+}
+
 func ConvertContractCodeEntry(e xdr.ContractCodeEntry) ContractCodeEntry {
 	return ContractCodeEntry{
 		Ext:  ConvertContractCodeEntryExt(e.Ext),
@@ -319,7 +394,12 @@ func ConvertContractCodeCostInputs(i xdr.ContractCodeCostInputs) ContractCodeCos
 	}
 }
 
+// ConvertContractDataEntry is ConvertContractDataEntryWithOptions with the zero Options.
 func ConvertContractDataEntry(e xdr.ContractDataEntry) (ContractDataEntry, error) {
+	return ConvertContractDataEntryWithOptions(e, Options{})
+}
+
+func ConvertContractDataEntryWithOptions(e xdr.ContractDataEntry, opts Options) (ContractDataEntry, error) {
 	var result ContractDataEntry
 
 	ext := ConvertExtensionPoint(e.Ext)
@@ -343,6 +423,7 @@ func ConvertContractDataEntry(e xdr.ContractDataEntry) (ContractDataEntry, error
 	result.Contract = contract
 	result.Key = key
 	result.Durability = int32(e.Durability)
+	result.DurabilityName = opts.enumName(e.Durability)
 	result.Val = val
 
 	return result, nil
@@ -359,21 +440,21 @@ func ConvertConfigSettingContractComputeV0(c xdr.ConfigSettingContractComputeV0)
 
 func ConvertConfigSettingContractLedgerCostV0(c xdr.ConfigSettingContractLedgerCostV0) ConfigSettingContractLedgerCostV0 {
 	return ConfigSettingContractLedgerCostV0{
-		LedgerMaxReadLedgerEntries:     uint32(c.LedgerMaxReadLedgerEntries),
-		LedgerMaxReadBytes:             uint32(c.LedgerMaxReadBytes),
+		LedgerMaxReadLedgerEntries:     uint32(c.LedgerMaxDiskReadEntries),
+		LedgerMaxReadBytes:             uint32(c.LedgerMaxDiskReadBytes),
 		LedgerMaxWriteLedgerEntries:    uint32(c.LedgerMaxWriteLedgerEntries),
 		LedgerMaxWriteBytes:            uint32(c.LedgerMaxWriteBytes),
-		TxMaxReadLedgerEntries:         uint32(c.TxMaxReadLedgerEntries),
-		TxMaxReadBytes:                 uint32(c.TxMaxReadBytes),
+		TxMaxReadLedgerEntries:         uint32(c.TxMaxDiskReadEntries),
+		TxMaxReadBytes:                 uint32(c.TxMaxDiskReadBytes),
 		TxMaxWriteLedgerEntries:        uint32(c.TxMaxWriteLedgerEntries),
 		TxMaxWriteBytes:                uint32(c.TxMaxWriteBytes),
-		FeeReadLedgerEntry:             int64(c.FeeReadLedgerEntry),
+		FeeReadLedgerEntry:             int64(c.FeeDiskReadLedgerEntry),
 		FeeWriteLedgerEntry:            int64(c.FeeWriteLedgerEntry),
-		FeeRead1Kb:                     int64(c.FeeRead1Kb),
-		BucketListTargetSizeBytes:      int64(c.BucketListTargetSizeBytes),
-		WriteFee1KbBucketListLow:       int64(c.WriteFee1KbBucketListLow),
-		WriteFee1KbBucketListHigh:      int64(c.WriteFee1KbBucketListHigh),
-		BucketListWriteFeeGrowthFactor: uint32(c.BucketListWriteFeeGrowthFactor),
+		FeeRead1Kb:                     int64(c.FeeDiskRead1Kb),
+		BucketListTargetSizeBytes:      int64(c.SorobanStateTargetSizeBytes),
+		WriteFee1KbBucketListLow:       int64(c.RentFee1KbSorobanStateSizeLow),
+		WriteFee1KbBucketListHigh:      int64(c.RentFee1KbSorobanStateSizeHigh),
+		BucketListWriteFeeGrowthFactor: uint32(c.SorobanStateRentFeeGrowthFactor),
 	}
 }
 
@@ -65,19 +65,27 @@ func getEventType(eventBody xdr.ContractEventBody) (string, bool) {
 	return eventType, true
 }
 
+// ConvertContractEvent is ConvertContractEventWithOptions with the zero Options.
 func ConvertContractEvent(e xdr.ContractEvent) (ContractEvent, error) {
+	return ConvertContractEventWithOptions(e, Options{})
+}
+
+func ConvertContractEventWithOptions(e xdr.ContractEvent, opts Options) (ContractEvent, error) {
 	var result ContractEvent
 
 	result.Ext = ConvertExtensionPoint(e.Ext)
 
-	// contractId := e.ContractId.HexString()
-	contractId, err := strkey.Encode(strkey.VersionByteContract, e.ContractId[:])
-	if err != nil {
-		return result, err
+	// Diagnostic events raised outside of any contract, such as the
+	// fn_call of the invoked contract, have no contract id.
+	if e.ContractId != nil {
+		contractId, err := strkey.Encode(strkey.VersionByteContract, e.ContractId[:])
+		if err != nil {
+			return result, err
+		}
+		result.ContractId = &contractId
 	}
-
-	result.ContractId = &contractId
 	result.ContractEventType = int32(e.Type)
+	result.ContractEventTypeName = opts.enumName(e.Type)
 
 	eventType, found := getEventType(e.Body)
 	result.EventType = eventType
@@ -152,10 +160,15 @@ func ConvertContractEventV0(e xdr.ContractEventV0) (ContractEventV0, error) {
 	}, nil
 }
 
+// ConvertDiagnosticEvent is ConvertDiagnosticEventWithOptions with the zero Options.
 func ConvertDiagnosticEvent(e xdr.DiagnosticEvent) (DiagnosticEvent, error) {
+	return ConvertDiagnosticEventWithOptions(e, Options{})
+}
+
+func ConvertDiagnosticEventWithOptions(e xdr.DiagnosticEvent, opts Options) (DiagnosticEvent, error) {
 	var result DiagnosticEvent
 
-	event, err := ConvertContractEvent(e.Event)
+	event, err := ConvertContractEventWithOptions(e.Event, opts)
 	if err != nil {
 		return result, err
 	}
@@ -166,6 +179,21 @@ func ConvertDiagnosticEvent(e xdr.DiagnosticEvent) (DiagnosticEvent, error) {
 	return result, nil
 }
 
+func ConvertTransactionEvent(e xdr.TransactionEvent, opts Options) (TransactionEvent, error) {
+	var result TransactionEvent
+
+	event, err := ConvertContractEventWithOptions(e.Event, opts)
+	if err != nil {
+		return result, err
+	}
+
+	result.Stage = int32(e.Stage)
+	result.StageName = opts.enumName(e.Stage)
+	result.Event = event
+
+	return result, nil
+}
+
 func (event *TransferEvent) parse(topics xdr.ScVec, value xdr.ScVal) error {
 	//
 	// The transfer event format is:
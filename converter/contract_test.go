@@ -0,0 +1,162 @@
+package converter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+// The published Stellar Asset Contract addresses of lumens on the public and
+// test networks.
+const (
+	pubnetNativeContract  = "CAS3J7GYLGXMF6TDJBBYYSE3HQ6BBSMLNUQ34T6TZMYMW2EVH34XOWMA"
+	testnetNativeContract = "CDLZFC3SYJYDZT7K67VZ75HPJVIEUVNIXF47ZG2FB2RMQQVU2HHGCYSC"
+)
+
+func nativeAsset() xdr.Asset {
+	return xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}
+}
+
+func TestAssetContractId(t *testing.T) {
+	usdc := xdr.MustNewCreditAsset("USDC", "GA5ZSEJYB37JRC5AVCIA5MOP4RHTM335X2KGX3IHOJAPP5RE34K4KZVN")
+
+	for _, tc := range []struct {
+		name       string
+		asset      xdr.Asset
+		passphrase string
+		want       string
+	}{
+		{"native pubnet", nativeAsset(), network.PublicNetworkPassphrase, pubnetNativeContract},
+		{"native testnet", nativeAsset(), network.TestNetworkPassphrase, testnetNativeContract},
+		{"native default", nativeAsset(), "", pubnetNativeContract},
+		{"usdc pubnet", usdc, network.PublicNetworkPassphrase, "CCW67TSZV3SSS2HXMBQ5JFGCKJNXKZM7UQUWUZPUTHXSTZLEO7SJMI75"},
+	} {
+		got, err := AssetContractId(tc.asset, tc.passphrase)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestAssetContractIdMatchesLedger(t *testing.T) {
+	meta := readProtocol23Ledger(t)
+
+	// The transfers of the load test ledger are emitted by the lumen SAC of
+	// its network, with the asset as their fourth topic.
+	event := meta.V2.TxProcessing[0].TxApplyProcessing.V4.Operations[0].Events[0]
+	if sym := event.Body.V0.Topics[3].MustStr(); sym != "native" {
+		t.Fatalf("got asset topic %s, want native", sym)
+	}
+
+	want, err := strkey.Encode(strkey.VersionByteContract, event.ContractId[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := AssetContractId(nativeAsset(), loadTestPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestContractIdFromAddressPreimage(t *testing.T) {
+	deployer := "GDAENEQHN3V5LMYN3KBQUUHEOJ4C7FQJYFRJO2A4WP7ZDX3TCTFONWLL"
+	key := strkey.MustDecode(strkey.VersionByteAccountID, deployer)
+	var salt xdr.Uint256
+	for i := range salt {
+		salt[i] = byte(i)
+	}
+
+	preimage := xdr.ContractIdPreimage{
+		Type: xdr.ContractIdPreimageTypeContractIdPreimageFromAddress,
+		FromAddress: &xdr.ContractIdPreimageFromAddress{
+			Address: xdr.ScAddress{
+				Type:      xdr.ScAddressTypeScAddressTypeAccount,
+				AccountId: xdr.MustAddressPtr(deployer),
+			},
+			Salt: salt,
+		},
+	}
+
+	// Spell out the XDR of the HashIdPreimage the host hashes:
+	// ENVELOPE_TYPE_CONTRACT_ID, the network ID,
+	// CONTRACT_ID_PREIMAGE_FROM_ADDRESS, an account SC_ADDRESS holding an
+	// ed25519 key, and the salt.
+	networkId := sha256.Sum256([]byte(network.TestNetworkPassphrase))
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, uint32(xdr.EnvelopeTypeEnvelopeTypeContractId))
+	buf.Write(networkId[:])
+	for _, discriminant := range []uint32{0, 0, 0} {
+		binary.Write(&buf, binary.BigEndian, discriminant)
+	}
+	buf.Write(key)
+	buf.Write(salt[:])
+	hash := sha256.Sum256(buf.Bytes())
+	want := strkey.MustEncode(strkey.VersionByteContract, hash[:])
+
+	got, err := ContractIdFromPreimage(preimage, network.TestNetworkPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	pubnet, err := ContractIdFromPreimage(preimage, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pubnet == got {
+		t.Errorf("got the testnet ID %s for the default network", pubnet)
+	}
+}
+
+func TestCreateContractIdInjection(t *testing.T) {
+	args := xdr.CreateContractArgs{
+		ContractIdPreimage: xdr.ContractIdPreimage{
+			Type:      xdr.ContractIdPreimageTypeContractIdPreimageFromAsset,
+			FromAsset: &xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+		},
+		Executable: xdr.ContractExecutable{Type: xdr.ContractExecutableTypeContractExecutableStellarAsset},
+	}
+	fn := xdr.HostFunction{Type: xdr.HostFunctionTypeHostFunctionTypeCreateContract, CreateContract: &args}
+	testnet := Options{NetworkPassphrase: network.TestNetworkPassphrase}
+
+	converted, err := ConvertHostFunctionWithOptions(fn, testnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.CreateContract == nil || converted.CreateContract.ContractId != testnetNativeContract {
+		t.Errorf("got host function %+v, want contract %s", converted.CreateContract, testnetNativeContract)
+	}
+
+	converted, err = ConvertHostFunction(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.CreateContract.ContractId != pubnetNativeContract {
+		t.Errorf("got contract %s without options, want %s", converted.CreateContract.ContractId, pubnetNativeContract)
+	}
+
+	authorized, err := ConvertSorobanAuthorizedFunctionWithOptions(xdr.SorobanAuthorizedFunction{
+		Type:                 xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn,
+		CreateContractHostFn: &args,
+	}, testnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authorized.CreateContractHostFn == nil || authorized.CreateContractHostFn.ContractId != testnetNativeContract {
+		t.Errorf("got authorized function %+v, want contract %s", authorized.CreateContractHostFn, testnetNativeContract)
+	}
+}
@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/toid"
+	"github.com/stellar/go/xdr"
+)
+
+// ClaimableBalanceIdFromOperation derives the ID of the claimable balance
+// created by the CreateClaimableBalance operation at opIndex (0-based) of a
+// transaction with the given source account and sequence number.
+func ClaimableBalanceIdFromOperation(source xdr.AccountId, seqNum int64, opIndex uint32) (ClaimableBalanceId, error) {
+	preimage := xdr.HashIdPreimage{
+		Type: xdr.EnvelopeTypeEnvelopeTypeOpId,
+		OperationId: &xdr.HashIdPreimageOperationId{
+			SourceAccount: source,
+			SeqNum:        xdr.SequenceNumber(seqNum),
+			OpNum:         xdr.Uint32(opIndex),
+		},
+	}
+
+	bz, err := preimage.MarshalBinary()
+	if err != nil {
+		return ClaimableBalanceId{}, err
+	}
+
+	hash := xdr.Hash(sha256.Sum256(bz))
+
+	return ConvertClaimableBalanceId(xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &hash,
+	})
+}
+
+// TtlKeyHash returns the hash of a contract data or code key, which the
+// TtlEntry governing the entry refers to it by.
+func TtlKeyHash(k xdr.LedgerKey) (xdr.Hash, error) {
+	bz, err := k.MarshalBinary()
+	if err != nil {
+		return xdr.Hash{}, err
+	}
+
+	return sha256.Sum256(bz), nil
+}
+
+// LiquidityPoolIdFromParameters derives the ID of the constant product pool
+// described by the given parameters.
+func LiquidityPoolIdFromParameters(p xdr.LiquidityPoolConstantProductParameters) (xdr.PoolId, error) {
+	return xdr.NewPoolId(p.AssetA, p.AssetB, p.Fee)
+}
+
+// LiquidityPoolStrKey encodes a liquidity pool ID as an L... strkey.
+func LiquidityPoolStrKey(id xdr.PoolId) (string, error) {
+	return strkey.Encode(strkey.VersionByteLiquidityPool, id[:])
+}
+
+// TransactionToid returns the TOID of the transaction at txIndex (1-based)
+// in the given ledger.
+func TransactionToid(ledgerSeq uint32, txIndex uint32) (int64, error) {
+	return OperationToid(ledgerSeq, txIndex, 0)
+}
+
+// OperationToid returns the TOID of the operation at opIndex (1-based) of the
+// transaction at txIndex (1-based) in the given ledger.
+func OperationToid(ledgerSeq uint32, txIndex uint32, opIndex uint32) (int64, error) {
+	if ledgerSeq > math.MaxInt32 {
+		return 0, errors.Errorf("ledger sequence %d overflows toid", ledgerSeq)
+	}
+
+	if txIndex > toid.TransactionMask {
+		return 0, errors.Errorf("transaction index %d overflows toid", txIndex)
+	}
+
+	if opIndex > toid.OperationMask {
+		return 0, errors.Errorf("operation index %d overflows toid", opIndex)
+	}
+
+	return toid.New(int32(ledgerSeq), int32(txIndex), int32(opIndex)).ToInt64(), nil
+}
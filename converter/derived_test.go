@@ -0,0 +1,143 @@
+package converter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+// strkeyPayload is the payload of the liquidity pool and claimable balance
+// strkey test vectors of SEP-23.
+const strkeyPayload = "3f0c34bf93ad0d9971d04ccc90f705511c838aad9734a4a2fb0d7a03fc7fe89a"
+
+func TestClaimableBalanceIdFromOperation(t *testing.T) {
+	// The balance created by the first operation of a transaction with
+	// sequence number 124, as computed by txnbuild.
+	source := xdr.MustAddress("GC2BKLYOOYPDEFJKLKY6FNNRQMGFLVHJKQRGNSSRRGSMPGF32LHCQVGF")
+	want := "95001252ab3b4d16adbfa5364ce526dfcda03cb2258b827edbb2e0450087be51"
+
+	id, err := ClaimableBalanceIdFromOperation(source, 124, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.V0 == nil || *id.V0 != want || id.Hex != "00000000"+want {
+		t.Errorf("got %+v, want %s", id, want)
+	}
+
+	payload, err := strkey.Decode(strkey.VersionByteClaimableBalance, id.StrKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(payload) != "00"+want {
+		t.Errorf("strkey %s decodes to %x, want 00%s", id.StrKey, payload, want)
+	}
+
+	other, err := ClaimableBalanceIdFromOperation(source, 124, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Hex == id.Hex {
+		t.Errorf("got the same ID %s for the second operation", other.Hex)
+	}
+}
+
+func TestLiquidityPoolId(t *testing.T) {
+	// The native/AbC pool of the stellar/go pool ID tests.
+	var key xdr.Uint256
+	bz, _ := hex.DecodeString("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	copy(key[:], bz)
+	issuer, err := xdr.NewAccountId(xdr.PublicKeyTypePublicKeyTypeEd25519, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := LiquidityPoolIdFromParameters(xdr.LiquidityPoolConstantProductParameters{
+		AssetA: xdr.MustNewNativeAsset(),
+		AssetB: xdr.MustNewCreditAsset("AbC", issuer.Address()),
+		Fee:    xdr.LiquidityPoolFeeV18,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(id[:]); got != "c17f36fbd210e43dca1cda8edc5b6c0f825fcb72b39f0392fd6309844d77ff7d" {
+		t.Errorf("got pool ID %s", got)
+	}
+
+	_, err = LiquidityPoolIdFromParameters(xdr.LiquidityPoolConstantProductParameters{
+		AssetA: xdr.MustNewCreditAsset("AbC", issuer.Address()),
+		AssetB: xdr.MustNewNativeAsset(),
+		Fee:    xdr.LiquidityPoolFeeV18,
+	})
+	if err == nil {
+		t.Error("got no error for unordered assets")
+	}
+
+	var pool xdr.PoolId
+	bz, _ = hex.DecodeString(strkeyPayload)
+	copy(pool[:], bz)
+	s, err := LiquidityPoolStrKey(pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "LA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUPJN" {
+		t.Errorf("got strkey %s", s)
+	}
+}
+
+func TestTtlKeyHash(t *testing.T) {
+	var code xdr.Hash
+	for i := range code {
+		code[i] = byte(i)
+	}
+
+	// CONTRACT_CODE followed by the code hash.
+	want := sha256.Sum256(append([]byte{0, 0, 0, 7}, code[:]...))
+
+	got, err := TtlKeyHash(xdr.LedgerKey{
+		Type:         xdr.LedgerEntryTypeContractCode,
+		ContractCode: &xdr.LedgerKeyContractCode{Hash: code},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got[:], want[:]) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestToid(t *testing.T) {
+	// The ledger takes the upper 32 bits, the transaction the next 20 and the
+	// operation the lower 12.
+	for _, tc := range []struct {
+		ledger, tx, op uint32
+		want           int64
+	}{
+		{1, 0, 0, 1 << 32},
+		{1, 1, 1, 1<<32 | 1<<12 | 1},
+		{53312000, 3, 0, 53312000<<32 | 3<<12},
+		{2, 1<<20 - 1, 1<<12 - 1, 3<<32 - 1},
+	} {
+		got, err := OperationToid(tc.ledger, tc.tx, tc.op)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("%d/%d/%d: got %d, want %d", tc.ledger, tc.tx, tc.op, got, tc.want)
+		}
+	}
+
+	tx, err := TransactionToid(53312000, 3)
+	if err != nil || tx != 53312000<<32|3<<12 {
+		t.Errorf("got transaction toid %d, %v", tx, err)
+	}
+
+	for _, tc := range [][3]uint32{{1 << 31, 0, 0}, {1, 1 << 20, 0}, {1, 0, 1 << 12}} {
+		if _, err := OperationToid(tc[0], tc[1], tc[2]); err == nil {
+			t.Errorf("%v: got no overflow error", tc)
+		}
+	}
+}
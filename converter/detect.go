@@ -0,0 +1,174 @@
+package converter
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	XdrTypeTransactionEnvelope   = "TransactionEnvelope"
+	XdrTypeTransactionResultPair = "TransactionResultPair"
+	XdrTypeTransactionResultMeta = "TransactionResultMeta"
+	XdrTypeContractEvent         = "ContractEvent"
+	XdrTypeLedgerEntry           = "LedgerEntry"
+	XdrTypeLedgerKey             = "LedgerKey"
+	XdrTypeScVal                 = "ScVal"
+)
+
+var ErrUnknownXdrType = errors.New("bytes do not decode as any supported XDR type")
+
+// ErrAmbiguousEncoding is returned by DecodeXdrString for a string that reads
+// as XDR both in hex and in base64.
+var ErrAmbiguousEncoding = errors.New("input is both valid hex and valid base64 XDR, name its encoding")
+
+// DetectedXdr is one way of reading a blob passed to DetectAndConvert.
+type DetectedXdr struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// xdrDetectors are tried in ranking order: small types such as ScVal and
+// LedgerKey are easy to hit by accident, so larger structures that decode
+// cleanly are the more likely reading.
+var xdrDetectors = []struct {
+	xdrType string
+	detect  func([]byte, Options) (interface{}, error)
+}{
+	{XdrTypeTransactionResultMeta, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.TransactionResultMeta
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		return ConvertTransactionResultMetaWithOptions(v, opts)
+	}},
+	{XdrTypeTransactionEnvelope, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.TransactionEnvelope
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		if len(v.Operations()) == 0 {
+			return nil, errors.New("transaction without operations")
+		}
+		return ConvertTransactionEnvelopeWithOptions(v, opts)
+	}},
+	{XdrTypeTransactionResultPair, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.TransactionResultPair
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		return ConvertTransactionResultPairWithOptions(v, opts)
+	}},
+	{XdrTypeLedgerEntry, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.LedgerEntry
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		return ConvertLedgerEntryWithOptions(v, opts)
+	}},
+	{XdrTypeContractEvent, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.ContractEvent
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		return ConvertContractEventWithOptions(v, opts)
+	}},
+	{XdrTypeLedgerKey, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.LedgerKey
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		return ConvertLedgerKeyWithOptions(v, opts)
+	}},
+	{XdrTypeScVal, func(inp []byte, opts Options) (interface{}, error) {
+		var v xdr.ScVal
+		if err := decodeExact(inp, &v); err != nil {
+			return nil, err
+		}
+		return ConvertScVal(v)
+	}},
+}
+
+// DecodeXdrString decodes an XDR blob given in hex or base64. XDR is a whole
+// number of 4 byte units, which rules out most misreadings. A string that
+// still reads both ways is hex when it has no upper case letters and base64
+// when it has both cases; upper case hex is ambiguous.
+func DecodeXdrString(s string) ([]byte, error) {
+	hexBz, hexErr := hex.DecodeString(s)
+	base64Bz, base64Err := base64.StdEncoding.DecodeString(s)
+	isHex := hexErr == nil && len(hexBz)%4 == 0
+	isBase64 := base64Err == nil && len(base64Bz)%4 == 0
+
+	switch {
+	case isHex && isBase64:
+		if !strings.ContainsAny(s, "ABCDEF") {
+			return hexBz, nil
+		}
+		if strings.ContainsAny(s, "abcdef") {
+			return base64Bz, nil
+		}
+		return nil, ErrAmbiguousEncoding
+	case isHex:
+		return hexBz, nil
+	case isBase64:
+		return base64Bz, nil
+	case hexErr == nil:
+		// Not XDR either way; let the XDR decoder report it.
+		return hexBz, nil
+	}
+
+	return base64Bz, base64Err
+}
+
+// decodeExact unmarshals inp into v and checks that encoding v again gives
+// back exactly inp, which rejects trailing bytes and non-canonical padding.
+func decodeExact(inp []byte, v interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}) error {
+	if err := v.UnmarshalBinary(inp); err != nil {
+		return err
+	}
+
+	bz, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(inp, bz) {
+		return errors.Errorf("input is not a canonical encoding of %T", v)
+	}
+
+	return nil
+}
+
+// DetectAndConvert tries every supported XDR type on inp and converts it with
+// each one that consumes all of the bytes and converts without error. The
+// readings are returned most likely first; a single element means the type
+// was unambiguous.
+func DetectAndConvert(inp []byte, opts Options) ([]DetectedXdr, error) {
+	var result []DetectedXdr
+	for _, d := range xdrDetectors {
+		value, err := d.detect(inp, opts)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, DetectedXdr{
+			Type:  d.xdrType,
+			Value: value,
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, ErrUnknownXdrType
+	}
+	opts.ErrorSpec.NameContractErrors(&result)
+
+	return result, nil
+}
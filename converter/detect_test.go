@@ -0,0 +1,125 @@
+package converter
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// An ScVal vec or map without a body decodes but has nothing to convert.
+func TestAbsentScValBody(t *testing.T) {
+	for _, h := range []string{
+		"0000001000000000", // SCV_VEC, absent
+		"0000001100000000", // SCV_MAP, absent
+	} {
+		inp, err := hex.DecodeString(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := MarshalJSONContractValueXdr(inp); err == nil {
+			t.Errorf("%s: converted as ScVal", h)
+		}
+		if _, err := MarshalJSONContractValueInfoXdr(inp); err == nil {
+			t.Errorf("%s: converted as ScVal info", h)
+		}
+		if matches, err := DetectAndConvert(inp, Options{}); err != ErrUnknownXdrType {
+			t.Errorf("%s: got %+v, %v, want ErrUnknownXdrType", h, matches, err)
+		}
+	}
+}
+
+func TestDetectAndConvertScVal(t *testing.T) {
+	inp, err := hex.DecodeString("000000030000002a") // SCV_U32 42
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matches, err := DetectAndConvert(inp, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range matches {
+		if m.Type == XdrTypeScVal {
+			if v := m.Value.(ScVal); v.U32 == nil || *v.U32 != 42 {
+				t.Errorf("got %+v, want u32 42", v)
+			}
+			return
+		}
+	}
+	t.Errorf("no ScVal reading in %+v", matches)
+}
+
+func TestDecodeXdrString(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		in   string
+		want string // hex of the decoded bytes
+		err  error
+	}{
+		{"base64", "AAAAAwAAACo=", "000000030000002a", nil},
+		{"lower case hex", "000000030000002a", "000000030000002a", nil},
+		// 16 digits are also base64 of 12 bytes; digits only read as hex.
+		{"digits", "0000000300000042", "0000000300000042", nil},
+		// 8 bytes of base64 decode to 6, not XDR.
+		{"short upper case hex", "0000000A", "0000000a", nil},
+		// Both cases of hex letters only occur in base64.
+		{"base64 of hex letters", "AbCdAbCdAbCdAbCd", "01b09d01b09d01b09d01b09d", nil},
+		{"upper case hex", "0000000A0000000B", "", ErrAmbiguousEncoding},
+	} {
+		bz, err := DecodeXdrString(c.in)
+		if err != c.err {
+			t.Errorf("%s: got error %v, want %v", c.name, err, c.err)
+			continue
+		}
+		if got := hex.EncodeToString(bz); err == nil && got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestDetectAndConvertRanking(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		hex  string
+		want []string
+	}{
+		{
+			// SCV_BYTES of 44 zero bytes, whose first 32 bytes read as the
+			// hash of a txSUCCESS result without operations.
+			name: "result pair or scval",
+			hex:  "0000000d0000002c" + strings.Repeat("00", 44),
+			want: []string{XdrTypeTransactionResultPair, XdrTypeScVal},
+		},
+		{
+			// A CONTRACT_CODE entry of empty code, whose ledger sequence,
+			// type, extension and first 20 hash bytes read as a transaction
+			// hash and the rest as a txSUCCESS result without operations.
+			name: "result pair or ledger entry",
+			hex:  "00000060" + "00000007" + "00000000" + strings.Repeat("ab", 28) + "00000000" + "00000000" + "00000000",
+			want: []string{XdrTypeTransactionResultPair, XdrTypeLedgerEntry},
+		},
+		{
+			name: "ledger key",
+			hex:  "00000000" + "00000000" + strings.Repeat("ab", 32),
+			want: []string{XdrTypeLedgerKey},
+		},
+	} {
+		inp, err := hex.DecodeString(tc.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matches, err := DetectAndConvert(inp, Options{})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		var got []string
+		for _, m := range matches {
+			got = append(got, m.Type)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package converter
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/stellar/go/xdr"
+)
+
+// XdrEnumName returns the name an enum value has in the XDR definitions,
+// e.g. txFAILED or PAYMENT_UNDERFUNDED. It returns an empty string for values
+// unknown to the xdr package.
+func XdrEnumName(e fmt.Stringer) string {
+	goName := e.String()
+	typeName := reflect.TypeOf(e).Name()
+	if goName == "" || !strings.HasPrefix(goName, typeName) {
+		return ""
+	}
+
+	words := splitCamelCase(strings.TrimPrefix(goName, typeName))
+	for i := range words {
+		words[i] = strings.ToUpper(words[i])
+	}
+
+	// Transaction and operation result codes keep a lower case prefix in
+	// the XDR, e.g. txBAD_AUTH and opNO_ACCOUNT.
+	switch e.(type) {
+	case xdr.TransactionResultCode, xdr.OperationResultCode:
+		return strings.ToLower(words[0]) + strings.Join(words[1:], "_")
+	}
+
+	return strings.Join(words, "_")
+}
+
+// XdrFlagNames decodes a bitflag value into the XDR names of the flags that
+// are set. flag converts a single bit into its enum type. Bits unknown to the
+// xdr package are rendered as numbers.
+func XdrFlagNames(flags uint32, flag func(bit uint32) fmt.Stringer) []string {
+	var names []string
+	for bit := uint32(1); bit != 0 && bit <= flags; bit <<= 1 {
+		if flags&bit == 0 {
+			continue
+		}
+
+		name := XdrEnumName(flag(bit))
+		if name == "" {
+			name = strconv.FormatUint(uint64(bit), 10)
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func splitCamelCase(s string) []string {
+	var words []string
+	start := 0
+	for i, r := range s {
+		if i > start && unicode.IsUpper(r) {
+			words = append(words, s[start:i])
+			start = i
+		}
+	}
+
+	if start < len(s) {
+		words = append(words, s[start:])
+	}
+
+	return words
+}
+
+// enumName returns the XDR name of e if o asks for enum names.
+func (o Options) enumName(e fmt.Stringer) string {
+	if !o.EnumNames {
+		return ""
+	}
+
+	return XdrEnumName(e)
+}
+
+func (o Options) accountFlagNames(flags uint32) []string {
+	if !o.EnumNames {
+		return nil
+	}
+
+	return XdrFlagNames(flags, func(bit uint32) fmt.Stringer { return xdr.AccountFlags(bit) })
+}
+
+func (o Options) trustLineFlagNames(flags uint32) []string {
+	if !o.EnumNames {
+		return nil
+	}
+
+	return XdrFlagNames(flags, func(bit uint32) fmt.Stringer { return xdr.TrustLineFlags(bit) })
+}
+
+func (o Options) offerEntryFlagNames(flags uint32) []string {
+	if !o.EnumNames {
+		return nil
+	}
+
+	return XdrFlagNames(flags, func(bit uint32) fmt.Stringer { return xdr.OfferEntryFlags(bit) })
+}
+
+func (o Options) claimableBalanceFlagNames(flags uint32) []string {
+	if !o.EnumNames {
+		return nil
+	}
+
+	return XdrFlagNames(flags, func(bit uint32) fmt.Stringer { return xdr.ClaimableBalanceFlags(bit) })
+}
+
+func (o Options) ledgerHeaderFlagNames(flags uint32) []string {
+	if !o.EnumNames {
+		return nil
+	}
+
+	return XdrFlagNames(flags, func(bit uint32) fmt.Stringer { return xdr.LedgerHeaderFlags(bit) })
+}
@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+)
+
+func TestEnumNamesOption(t *testing.T) {
+	pair := xdr.TransactionResultPair{
+		Result: xdr.TransactionResult{
+			Result: xdr.TransactionResultResult{Code: xdr.TransactionResultCodeTxSuccess, Results: &[]xdr.OperationResult{}},
+		},
+	}
+	account := xdr.AccountEntry{
+		AccountId: xdr.MustAddress("GDAENEQHN3V5LMYN3KBQUUHEOJ4C7FQJYFRJO2A4WP7ZDX3TCTFONWLL"),
+		Flags:     xdr.Uint32(xdr.AccountFlagsAuthRequiredFlag | xdr.AccountFlagsAuthRevocableFlag),
+	}
+
+	plain, err := ConvertTransactionResultPair(pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := plain.Result.Result.CodeName; name != "" {
+		t.Errorf("got code name %q without enum names", name)
+	}
+	named, err := ConvertTransactionResultPairWithOptions(pair, Options{EnumNames: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := named.Result.Result.CodeName; name != "txSUCCESS" {
+		t.Errorf("got code name %q, want txSUCCESS", name)
+	}
+
+	plainAccount, err := ConvertAccountEntry(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plainAccount.FlagNames != nil {
+		t.Errorf("got flag names %v without enum names", plainAccount.FlagNames)
+	}
+	namedAccount, err := ConvertAccountEntryWithOptions(account, Options{EnumNames: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"AUTH_REQUIRED_FLAG", "AUTH_REVOCABLE_FLAG"}
+	if !reflect.DeepEqual(namedAccount.FlagNames, want) {
+		t.Errorf("got flag names %v, want %v", namedAccount.FlagNames, want)
+	}
+}
+
+func TestDetectEnumNames(t *testing.T) {
+	// A TransactionResultPair of zeros is a txSUCCESS with no operations.
+	matches, err := DetectAndConvert(make([]byte, 52), Options{EnumNames: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range matches {
+		if m.Type == XdrTypeTransactionResultPair {
+			if name := m.Value.(TransactionResultPair).Result.Result.CodeName; name != "txSUCCESS" {
+				t.Errorf("got code name %q, want txSUCCESS", name)
+			}
+			return
+		}
+	}
+	t.Errorf("no TransactionResultPair reading in %+v", matches)
+}
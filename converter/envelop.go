@@ -5,12 +5,17 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
-// TODO: testing
+// ConvertTransactionEnvelope is ConvertTransactionEnvelopeWithOptions with the zero Options.
 func ConvertTransactionEnvelope(e xdr.TransactionEnvelope) (TransactionEnvelope, error) {
+	return ConvertTransactionEnvelopeWithOptions(e, Options{})
+}
+
+// TODO: testing
+func ConvertTransactionEnvelopeWithOptions(e xdr.TransactionEnvelope, opts Options) (TransactionEnvelope, error) {
 	var result TransactionEnvelope
 	switch e.Type {
 	case xdr.EnvelopeTypeEnvelopeTypeTxV0:
-		v0, err := ConvertTransactionV0Envelope(e.V0)
+		v0, err := ConvertTransactionV0EnvelopeWithOptions(e.V0, opts)
 		if err != nil {
 			return result, err
 		}
@@ -18,7 +23,7 @@ func ConvertTransactionEnvelope(e xdr.TransactionEnvelope) (TransactionEnvelope,
 
 		return result, nil
 	case xdr.EnvelopeTypeEnvelopeTypeTx:
-		v1, err := ConvertTransactionV1Envelope(e.V1)
+		v1, err := ConvertTransactionV1EnvelopeWithOptions(e.V1, opts)
 		if err != nil {
 			return result, err
 		}
@@ -26,7 +31,7 @@ func ConvertTransactionEnvelope(e xdr.TransactionEnvelope) (TransactionEnvelope,
 
 		return result, nil
 	case xdr.EnvelopeTypeEnvelopeTypeTxFeeBump:
-		f, err := ConvertFeeBumpTransactionEnvelope(e.FeeBump)
+		f, err := ConvertFeeBumpTransactionEnvelopeWithOptions(e.FeeBump, opts)
 		if err != nil {
 			return result, err
 		}
@@ -38,10 +43,15 @@ func ConvertTransactionEnvelope(e xdr.TransactionEnvelope) (TransactionEnvelope,
 	return result, errors.Errorf("error invalid type envelope: %v", e.Type)
 }
 
-// TODO: testing
+// ConvertTransactionV0Envelope is ConvertTransactionV0EnvelopeWithOptions with the zero Options.
 func ConvertTransactionV0Envelope(v0 *xdr.TransactionV0Envelope) (TransactionV0Envelope, error) {
+	return ConvertTransactionV0EnvelopeWithOptions(v0, Options{})
+}
+
+// TODO: testing
+func ConvertTransactionV0EnvelopeWithOptions(v0 *xdr.TransactionV0Envelope, opts Options) (TransactionV0Envelope, error) {
 	var result TransactionV0Envelope
-	tx, err := ConvertTransactionV0(v0.Tx)
+	tx, err := ConvertTransactionV0WithOptions(v0.Tx, opts)
 	if err != nil {
 		return result, err
 	}
@@ -58,10 +68,15 @@ func ConvertTransactionV0Envelope(v0 *xdr.TransactionV0Envelope) (TransactionV0E
 	return result, nil
 }
 
-// TODO: testing
+// ConvertTransactionV1Envelope is ConvertTransactionV1EnvelopeWithOptions with the zero Options.
 func ConvertTransactionV1Envelope(v1 *xdr.TransactionV1Envelope) (TransactionV1Envelope, error) {
+	return ConvertTransactionV1EnvelopeWithOptions(v1, Options{})
+}
+
+// TODO: testing
+func ConvertTransactionV1EnvelopeWithOptions(v1 *xdr.TransactionV1Envelope, opts Options) (TransactionV1Envelope, error) {
 	var result TransactionV1Envelope
-	tx, err := ConvertTransaction(v1.Tx)
+	tx, err := ConvertTransactionWithOptions(v1.Tx, opts)
 	if err != nil {
 		return result, err
 	}
@@ -78,9 +93,14 @@ func ConvertTransactionV1Envelope(v1 *xdr.TransactionV1Envelope) (TransactionV1E
 	return result, nil
 }
 
+// ConvertFeeBumpTransactionEnvelope is ConvertFeeBumpTransactionEnvelopeWithOptions with the zero Options.
 func ConvertFeeBumpTransactionEnvelope(f *xdr.FeeBumpTransactionEnvelope) (FeeBumpTransactionEnvelope, error) {
+	return ConvertFeeBumpTransactionEnvelopeWithOptions(f, Options{})
+}
+
+func ConvertFeeBumpTransactionEnvelopeWithOptions(f *xdr.FeeBumpTransactionEnvelope, opts Options) (FeeBumpTransactionEnvelope, error) {
 	var result FeeBumpTransactionEnvelope
-	tx, err := ConvertFeeBumpTransaction(f.Tx)
+	tx, err := ConvertFeeBumpTransactionWithOptions(f.Tx, opts)
 	if err != nil {
 		return result, err
 	}
@@ -6,7 +6,38 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
+// MarshalJSONFuncs maps the short type names used by the command line tool
+// and the HTTP server to the function converting that XDR type to JSON.
+var MarshalJSONFuncs = map[string]func([]byte, Options) ([]byte, error){
+	"envelope":      MarshalJSONEnvelopeXdrWithOptions,
+	"result":        MarshalJSONResultXdrWithOptions,
+	"meta":          MarshalJSONResultMetaXdrWithOptions,
+	"event":         MarshalJSONContractEventXdrWithOptions,
+	"event-body":    MarshalJSONContractEventBodyXdrWithOptions,
+	"scval":         MarshalJSONContractValueXdrWithOptions,
+	"scval-info":    MarshalJSONContractValueInfoXdrWithOptions,
+	"invoke-args":   MarshalJSONInvokeContractArgsXdrWithOptions,
+	"ledger-key":    MarshalJSONLedgerKeyXdrWithOptions,
+	"ledger-entry":  MarshalJSONLedgerEntryXdrWithOptions,
+	"ledger-header": MarshalJSONLedgerHeaderXdrWithOptions,
+	"ledger-meta":   MarshalJSONLedgerCloseMetaXdrWithOptions,
+	"bucket-entry":  MarshalJSONBucketEntryXdrWithOptions,
+}
+
+// marshalJSON names the contract errors of v, a pointer to a converted value,
+// after opts.ErrorSpec and encodes it.
+func marshalJSON(v interface{}, opts Options) ([]byte, error) {
+	opts.ErrorSpec.NameContractErrors(v)
+
+	return json.Marshal(v)
+}
+
+// MarshalJSONEnvelopeXdr is MarshalJSONEnvelopeXdrWithOptions with the zero Options.
 func MarshalJSONEnvelopeXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONEnvelopeXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONEnvelopeXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrTxEnvelope xdr.TransactionEnvelope
 
 	err := xdrTxEnvelope.UnmarshalBinary(inp)
@@ -14,12 +45,12 @@ func MarshalJSONEnvelopeXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	envelope, err := ConvertTransactionEnvelope(xdrTxEnvelope)
+	envelope, err := ConvertTransactionEnvelopeWithOptions(xdrTxEnvelope, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(envelope)
+	bz, err := marshalJSON(&envelope, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +58,12 @@ func MarshalJSONEnvelopeXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONResultXdr is MarshalJSONResultXdrWithOptions with the zero Options.
 func MarshalJSONResultXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONResultXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONResultXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrTxResultPair xdr.TransactionResultPair
 
 	err := xdrTxResultPair.UnmarshalBinary(inp)
@@ -35,12 +71,12 @@ func MarshalJSONResultXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	resultPair, err := ConvertTransactionResultPair(xdrTxResultPair)
+	resultPair, err := ConvertTransactionResultPairWithOptions(xdrTxResultPair, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(resultPair)
+	bz, err := marshalJSON(&resultPair, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +84,12 @@ func MarshalJSONResultXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONResultMetaXdr is MarshalJSONResultMetaXdrWithOptions with the zero Options.
 func MarshalJSONResultMetaXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONResultMetaXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONResultMetaXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrTxResultMeta xdr.TransactionResultMeta
 
 	err := xdrTxResultMeta.UnmarshalBinary(inp)
@@ -56,12 +97,12 @@ func MarshalJSONResultMetaXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	resultMeta, err := ConvertTransactionResultMeta(xdrTxResultMeta)
+	resultMeta, err := ConvertTransactionResultMetaWithOptions(xdrTxResultMeta, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(resultMeta)
+	bz, err := marshalJSON(&resultMeta, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +110,12 @@ func MarshalJSONResultMetaXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONContractEventXdr is MarshalJSONContractEventXdrWithOptions with the zero Options.
 func MarshalJSONContractEventXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONContractEventXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONContractEventXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrContractEvent xdr.ContractEvent
 
 	err := xdrContractEvent.UnmarshalBinary(inp)
@@ -77,12 +123,12 @@ func MarshalJSONContractEventXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	event, err := ConvertContractEvent(xdrContractEvent)
+	event, err := ConvertContractEventWithOptions(xdrContractEvent, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(event)
+	bz, err := marshalJSON(&event, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +136,12 @@ func MarshalJSONContractEventXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONContractEventBodyXdr is MarshalJSONContractEventBodyXdrWithOptions with the zero Options.
 func MarshalJSONContractEventBodyXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONContractEventBodyXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONContractEventBodyXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrContractEventBody xdr.ContractEventBody
 
 	err := xdrContractEventBody.UnmarshalBinary(inp)
@@ -103,7 +154,7 @@ func MarshalJSONContractEventBodyXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(eventBody)
+	bz, err := marshalJSON(&eventBody, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +162,12 @@ func MarshalJSONContractEventBodyXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONContractKeyXdr is MarshalJSONContractKeyXdrWithOptions with the zero Options.
 func MarshalJSONContractKeyXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONContractKeyXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONContractKeyXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrContractKey xdr.ScVal
 
 	err := xdrContractKey.UnmarshalBinary(inp)
@@ -124,7 +180,7 @@ func MarshalJSONContractKeyXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(key)
+	bz, err := marshalJSON(&key, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +188,12 @@ func MarshalJSONContractKeyXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONContractKeyInfoXdr is MarshalJSONContractKeyInfoXdrWithOptions with the zero Options.
 func MarshalJSONContractKeyInfoXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONContractKeyInfoXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONContractKeyInfoXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrContractKey xdr.ScVal
 
 	err := xdrContractKey.UnmarshalBinary(inp)
@@ -145,7 +206,7 @@ func MarshalJSONContractKeyInfoXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(key)
+	bz, err := marshalJSON(&key, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +214,12 @@ func MarshalJSONContractKeyInfoXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONContractValueXdr is MarshalJSONContractValueXdrWithOptions with the zero Options.
 func MarshalJSONContractValueXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONContractValueXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONContractValueXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrContractValue xdr.ScVal
 
 	err := xdrContractValue.UnmarshalBinary(inp)
@@ -166,7 +232,7 @@ func MarshalJSONContractValueXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(value)
+	bz, err := marshalJSON(&value, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +240,12 @@ func MarshalJSONContractValueXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONContractValueInfoXdr is MarshalJSONContractValueInfoXdrWithOptions with the zero Options.
 func MarshalJSONContractValueInfoXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONContractValueInfoXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONContractValueInfoXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrContractValue xdr.ScVal
 
 	err := xdrContractValue.UnmarshalBinary(inp)
@@ -187,7 +258,7 @@ func MarshalJSONContractValueInfoXdr(inp []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(value)
+	bz, err := marshalJSON(&value, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +266,12 @@ func MarshalJSONContractValueInfoXdr(inp []byte) ([]byte, error) {
 	return bz, nil
 }
 
+// MarshalJSONInvokeContractArgsXdr is MarshalJSONInvokeContractArgsXdrWithOptions with the zero Options.
 func MarshalJSONInvokeContractArgsXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONInvokeContractArgsXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONInvokeContractArgsXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
 	var xdrInvokeContractArgs xdr.InvokeContractArgs
 
 	err := xdrInvokeContractArgs.UnmarshalBinary(inp)
@@ -216,7 +292,137 @@ func MarshalJSONInvokeContractArgsXdr(inp []byte) ([]byte, error) {
 	var args InvokeContractArgsArg
 	args.Args = values
 
-	bz, err := json.Marshal(args)
+	bz, err := marshalJSON(&args, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// MarshalJSONLedgerKeyXdr is MarshalJSONLedgerKeyXdrWithOptions with the zero Options.
+func MarshalJSONLedgerKeyXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONLedgerKeyXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONLedgerKeyXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
+	var xdrLedgerKey xdr.LedgerKey
+
+	err := xdrLedgerKey.UnmarshalBinary(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ConvertLedgerKeyWithOptions(xdrLedgerKey, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := marshalJSON(&key, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// MarshalJSONLedgerEntryXdr is MarshalJSONLedgerEntryXdrWithOptions with the zero Options.
+func MarshalJSONLedgerEntryXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONLedgerEntryXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONLedgerEntryXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
+	var xdrLedgerEntry xdr.LedgerEntry
+
+	err := xdrLedgerEntry.UnmarshalBinary(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := ConvertLedgerEntryWithOptions(xdrLedgerEntry, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := marshalJSON(&entry, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// MarshalJSONLedgerHeaderXdr is MarshalJSONLedgerHeaderXdrWithOptions with the zero Options.
+func MarshalJSONLedgerHeaderXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONLedgerHeaderXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONLedgerHeaderXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
+	var xdrLedgerHeader xdr.LedgerHeader
+
+	err := xdrLedgerHeader.UnmarshalBinary(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerHeader, err := ConvertLedgerHeader(xdrLedgerHeader, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := marshalJSON(&ledgerHeader, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// MarshalJSONLedgerCloseMetaXdr is MarshalJSONLedgerCloseMetaXdrWithOptions with the zero Options.
+func MarshalJSONLedgerCloseMetaXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONLedgerCloseMetaXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONLedgerCloseMetaXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
+	var xdrLedgerCloseMeta xdr.LedgerCloseMeta
+
+	err := xdrLedgerCloseMeta.UnmarshalBinary(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerCloseMeta, err := ConvertLedgerCloseMeta(xdrLedgerCloseMeta, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := marshalJSON(&ledgerCloseMeta, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// MarshalJSONBucketEntryXdr is MarshalJSONBucketEntryXdrWithOptions with the zero Options.
+func MarshalJSONBucketEntryXdr(inp []byte) ([]byte, error) {
+	return MarshalJSONBucketEntryXdrWithOptions(inp, Options{})
+}
+
+func MarshalJSONBucketEntryXdrWithOptions(inp []byte, opts Options) ([]byte, error) {
+	var xdrBucketEntry xdr.BucketEntry
+
+	err := xdrBucketEntry.UnmarshalBinary(inp)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketEntry, err := ConvertBucketEntry(xdrBucketEntry, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := marshalJSON(&bucketEntry, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -5,12 +5,17 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
+// ConvertLedgerEntryChange is ConvertLedgerEntryChangeWithOptions with the zero Options.
 func ConvertLedgerEntryChange(c xdr.LedgerEntryChange) (LedgerEntryChange, error) {
+	return ConvertLedgerEntryChangeWithOptions(c, Options{})
+}
+
+func ConvertLedgerEntryChangeWithOptions(c xdr.LedgerEntryChange, opts Options) (LedgerEntryChange, error) {
 	var result LedgerEntryChange
 
 	switch c.Type {
 	case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
-		created, err := ConvertLedgerEntry(*c.Created)
+		created, err := ConvertLedgerEntryWithOptions(*c.Created, opts)
 		if err != nil {
 			return result, err
 		}
@@ -18,7 +23,7 @@ func ConvertLedgerEntryChange(c xdr.LedgerEntryChange) (LedgerEntryChange, error
 		result.Created = &created
 		return result, nil
 	case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
-		updated, err := ConvertLedgerEntry(*c.Updated)
+		updated, err := ConvertLedgerEntryWithOptions(*c.Updated, opts)
 		if err != nil {
 			return result, err
 		}
@@ -26,7 +31,7 @@ func ConvertLedgerEntryChange(c xdr.LedgerEntryChange) (LedgerEntryChange, error
 		result.Updated = &updated
 		return result, nil
 	case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
-		removed, err := ConvertLedgerKey(*c.Removed)
+		removed, err := ConvertLedgerKeyWithOptions(*c.Removed, opts)
 		if err != nil {
 			return result, err
 		}
@@ -34,21 +39,47 @@ func ConvertLedgerEntryChange(c xdr.LedgerEntryChange) (LedgerEntryChange, error
 		result.Removed = &removed
 		return result, nil
 	case xdr.LedgerEntryChangeTypeLedgerEntryState:
-		state, err := ConvertLedgerEntry(*c.State)
+		state, err := ConvertLedgerEntryWithOptions(*c.State, opts)
 		if err != nil {
 			return result, err
 		}
 
 		result.State = &state
 		return result, nil
+	case xdr.LedgerEntryChangeTypeLedgerEntryRestored:
+		restored, err := ConvertLedgerEntryWithOptions(*c.Restored, opts)
+		if err != nil {
+			return result, err
+		}
+
+		result.Restored = &restored
+		return result, nil
 	}
 	return result, errors.Errorf("error invalid LedgerEntryChange type %v", c.Type)
 }
 
+func convertLedgerEntryChanges(changes xdr.LedgerEntryChanges, opts Options) (LedgerEntryChanges, error) {
+	var result LedgerEntryChanges
+	for _, xdrChange := range changes {
+		change, err := ConvertLedgerEntryChangeWithOptions(xdrChange, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, change)
+	}
+
+	return result, nil
+}
+
+// ConvertLedgerEntry is ConvertLedgerEntryWithOptions with the zero Options.
 func ConvertLedgerEntry(e xdr.LedgerEntry) (LedgerEntry, error) {
+	return ConvertLedgerEntryWithOptions(e, Options{})
+}
+
+func ConvertLedgerEntryWithOptions(e xdr.LedgerEntry, opts Options) (LedgerEntry, error) {
 	var result LedgerEntry
 
-	data, err := ConvertLedgerEntryData(e.Data)
+	data, err := ConvertLedgerEntryDataWithOptions(e.Data, opts)
 	if err != nil {
 		return result, err
 	}
@@ -62,11 +93,16 @@ func ConvertLedgerEntry(e xdr.LedgerEntry) (LedgerEntry, error) {
 	return result, nil
 }
 
+// ConvertLedgerEntryData is ConvertLedgerEntryDataWithOptions with the zero Options.
 func ConvertLedgerEntryData(d xdr.LedgerEntryData) (LedgerEntryData, error) {
+	return ConvertLedgerEntryDataWithOptions(d, Options{})
+}
+
+func ConvertLedgerEntryDataWithOptions(d xdr.LedgerEntryData, opts Options) (LedgerEntryData, error) {
 	var result LedgerEntryData
 	switch d.Type {
 	case xdr.LedgerEntryTypeAccount:
-		account, err := ConvertAccountEntry(*d.Account)
+		account, err := ConvertAccountEntryWithOptions(*d.Account, opts)
 		if err != nil {
 			return result, err
 		}
@@ -74,7 +110,7 @@ func ConvertLedgerEntryData(d xdr.LedgerEntryData) (LedgerEntryData, error) {
 
 		return result, nil
 	case xdr.LedgerEntryTypeTrustline:
-		trustLine, err := ConvertTrustLineEntry(*d.TrustLine)
+		trustLine, err := ConvertTrustLineEntryWithOptions(*d.TrustLine, opts)
 		if err != nil {
 			return result, err
 		}
@@ -82,7 +118,7 @@ func ConvertLedgerEntryData(d xdr.LedgerEntryData) (LedgerEntryData, error) {
 
 		return result, nil
 	case xdr.LedgerEntryTypeOffer:
-		offer, err := ConvertOfferEntry(*d.Offer)
+		offer, err := ConvertOfferEntryWithOptions(*d.Offer, opts)
 		if err != nil {
 			return result, err
 		}
@@ -94,7 +130,7 @@ func ConvertLedgerEntryData(d xdr.LedgerEntryData) (LedgerEntryData, error) {
 		result.Data = &data
 		return result, nil
 	case xdr.LedgerEntryTypeClaimableBalance:
-		balance, err := ConvertConvertClaimableBalanceEntry(*d.ClaimableBalance)
+		balance, err := ConvertConvertClaimableBalanceEntryWithOptions(*d.ClaimableBalance, opts)
 		if err != nil {
 			return result, err
 		}
@@ -110,7 +146,7 @@ func ConvertLedgerEntryData(d xdr.LedgerEntryData) (LedgerEntryData, error) {
 
 		return result, nil
 	case xdr.LedgerEntryTypeContractData:
-		contractData, err := ConvertContractDataEntry(*d.ContractData)
+		contractData, err := ConvertContractDataEntryWithOptions(*d.ContractData, opts)
 		if err != nil {
 			return result, err
 		}
@@ -123,7 +159,7 @@ func ConvertLedgerEntryData(d xdr.LedgerEntryData) (LedgerEntryData, error) {
 
 		return result, nil
 	case xdr.LedgerEntryTypeConfigSetting:
-		cfgSettings, err := ConvertConfigSettingEntry(*d.ConfigSetting)
+		cfgSettings, err := ConvertConfigSettingEntryWithOptions(*d.ConfigSetting, opts)
 		if err != nil {
 			return result, err
 		}
@@ -233,7 +269,12 @@ func ConvertLedgerKeyLiquidityPool(k xdr.LedgerKeyLiquidityPool) (LedgerKeyLiqui
 	return result, nil
 }
 
+// ConvertLedgerKeyContractData is ConvertLedgerKeyContractDataWithOptions with the zero Options.
 func ConvertLedgerKeyContractData(k xdr.LedgerKeyContractData) (LedgerKeyContractData, error) {
+	return ConvertLedgerKeyContractDataWithOptions(k, Options{})
+}
+
+func ConvertLedgerKeyContractDataWithOptions(k xdr.LedgerKeyContractData, opts Options) (LedgerKeyContractData, error) {
 	var result LedgerKeyContractData
 
 	contract, err := ConvertScAddress(k.Contract)
@@ -249,6 +290,13 @@ func ConvertLedgerKeyContractData(k xdr.LedgerKeyContractData) (LedgerKeyContrac
 	result.Key = key
 
 	result.Durability = int32(k.Durability)
+	result.DurabilityName = opts.enumName(k.Durability)
+
+	ttlKeyHash, err := TtlKeyHash(xdr.LedgerKey{Type: xdr.LedgerEntryTypeContractData, ContractData: &k})
+	if err != nil {
+		return result, err
+	}
+	result.TtlKeyHash = ttlKeyHash.HexString()
 
 	return result, nil
 }
@@ -257,12 +305,24 @@ func ConvertLedgerKeyContractCode(k xdr.LedgerKeyContractCode) (LedgerKeyContrac
 	var result LedgerKeyContractCode
 	result.Hash = k.Hash.HexString()
 
+	ttlKeyHash, err := TtlKeyHash(xdr.LedgerKey{Type: xdr.LedgerEntryTypeContractCode, ContractCode: &k})
+	if err != nil {
+		return result, err
+	}
+	result.TtlKeyHash = ttlKeyHash.HexString()
+
 	return result, nil
 }
 
+// ConvertLedgerKeyConfigSetting is ConvertLedgerKeyConfigSettingWithOptions with the zero Options.
 func ConvertLedgerKeyConfigSetting(k xdr.LedgerKeyConfigSetting) (LedgerKeyConfigSetting, error) {
+	return ConvertLedgerKeyConfigSettingWithOptions(k, Options{})
+}
+
+func ConvertLedgerKeyConfigSettingWithOptions(k xdr.LedgerKeyConfigSetting, opts Options) (LedgerKeyConfigSetting, error) {
 	var result LedgerKeyConfigSetting
 	result.ConfigSettingId = int32(k.ConfigSettingId)
+	result.ConfigSettingIdName = opts.enumName(k.ConfigSettingId)
 
 	return result, nil
 }
@@ -274,12 +334,17 @@ func ConvertLedgerKeyTtl(k xdr.LedgerKeyTtl) (LedgerKeyTtl, error) {
 	return result, nil
 }
 
+// ConvertLedgerFootprint is ConvertLedgerFootprintWithOptions with the zero Options.
 func ConvertLedgerFootprint(f xdr.LedgerFootprint) (LedgerFootprint, error) {
+	return ConvertLedgerFootprintWithOptions(f, Options{})
+}
+
+func ConvertLedgerFootprintWithOptions(f xdr.LedgerFootprint, opts Options) (LedgerFootprint, error) {
 	var result LedgerFootprint
 
 	var readOnlys []LedgerKey
 	for _, ledgerKey := range f.ReadOnly {
-		readOnly, err := ConvertLedgerKey(ledgerKey)
+		readOnly, err := ConvertLedgerKeyWithOptions(ledgerKey, opts)
 		if err != nil {
 			return result, err
 		}
@@ -289,7 +354,7 @@ func ConvertLedgerFootprint(f xdr.LedgerFootprint) (LedgerFootprint, error) {
 
 	var readWrites []LedgerKey
 	for _, ledgerKey := range f.ReadWrite {
-		readWrite, err := ConvertLedgerKey(ledgerKey)
+		readWrite, err := ConvertLedgerKeyWithOptions(ledgerKey, opts)
 		if err != nil {
 			return result, err
 		}
@@ -303,8 +368,13 @@ func ConvertLedgerFootprint(f xdr.LedgerFootprint) (LedgerFootprint, error) {
 	return result, nil
 }
 
-// TODO: testing
+// ConvertLedgerKey is ConvertLedgerKeyWithOptions with the zero Options.
 func ConvertLedgerKey(k xdr.LedgerKey) (LedgerKey, error) {
+	return ConvertLedgerKeyWithOptions(k, Options{})
+}
+
+// TODO: testing
+func ConvertLedgerKeyWithOptions(k xdr.LedgerKey, opts Options) (LedgerKey, error) {
 	var result LedgerKey
 	switch k.Type {
 	case xdr.LedgerEntryTypeAccount:
@@ -347,7 +417,7 @@ func ConvertLedgerKey(k xdr.LedgerKey) (LedgerKey, error) {
 		result.LiquidityPool = &liquidityPool
 		return result, nil
 	case xdr.LedgerEntryTypeContractData:
-		contractData, err := ConvertLedgerKeyContractData(*k.ContractData)
+		contractData, err := ConvertLedgerKeyContractDataWithOptions(*k.ContractData, opts)
 		if err != nil {
 			return result, err
 		}
@@ -361,7 +431,7 @@ func ConvertLedgerKey(k xdr.LedgerKey) (LedgerKey, error) {
 		result.ContractCode = &contractCode
 		return result, nil
 	case xdr.LedgerEntryTypeConfigSetting:
-		cfgSetting, err := ConvertLedgerKeyConfigSetting(*k.ConfigSetting)
+		cfgSetting, err := ConvertLedgerKeyConfigSettingWithOptions(*k.ConfigSetting, opts)
 		if err != nil {
 			return result, err
 		}
@@ -0,0 +1,213 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ConvertLedgerCloseMeta converts the meta stellar-core emits for a closed
+// ledger. TxSet is in transaction set order, TxProcessing in apply order;
+// they match up by transaction hash. SCP info is not converted.
+func ConvertLedgerCloseMeta(m xdr.LedgerCloseMeta, opts Options) (LedgerCloseMeta, error) {
+	var result LedgerCloseMeta
+	result.V = m.V
+
+	var (
+		envelopes      []xdr.TransactionEnvelope
+		txProcessing   []xdr.TransactionResultMeta
+		txProcessingV1 []xdr.TransactionResultMetaV1
+		upgrades       []xdr.UpgradeEntryMeta
+		header         xdr.LedgerHeaderHistoryEntry
+		evictedKeys    []xdr.LedgerKey
+		err            error
+	)
+
+	switch m.V {
+	case 0:
+		header = m.V0.LedgerHeader
+		envelopes = m.V0.TxSet.Txs
+		txProcessing = m.V0.TxProcessing
+		upgrades = m.V0.UpgradesProcessing
+	case 1:
+		header = m.V1.LedgerHeader
+		envelopes, err = GeneralizedTransactionSetEnvelopes(m.V1.TxSet)
+		if err != nil {
+			return result, err
+		}
+		txProcessing = m.V1.TxProcessing
+		upgrades = m.V1.UpgradesProcessing
+		evictedKeys = m.V1.EvictedKeys
+
+		result.TotalByteSizeOfBucketList = uint64(m.V1.TotalByteSizeOfLiveSorobanState)
+		result.Ext = convertLedgerCloseMetaExt(m.V1.Ext)
+	case 2:
+		header = m.V2.LedgerHeader
+		envelopes, err = GeneralizedTransactionSetEnvelopes(m.V2.TxSet)
+		if err != nil {
+			return result, err
+		}
+		txProcessingV1 = m.V2.TxProcessing
+		upgrades = m.V2.UpgradesProcessing
+		evictedKeys = m.V2.EvictedKeys
+
+		result.TotalByteSizeOfBucketList = uint64(m.V2.TotalByteSizeOfLiveSorobanState)
+		result.Ext = convertLedgerCloseMetaExt(m.V2.Ext)
+	default:
+		return result, errors.Errorf("error invalid LedgerCloseMeta version %v", m.V)
+	}
+
+	ledgerHeader, err := ConvertLedgerHeaderHistoryEntry(header, opts)
+	if err != nil {
+		return result, err
+	}
+	result.LedgerHeader = ledgerHeader
+
+	for _, xdrEnv := range envelopes {
+		env, err := ConvertTransactionEnvelopeWithOptions(xdrEnv, opts)
+		if err != nil {
+			return result, err
+		}
+		result.TxSet = append(result.TxSet, env)
+	}
+
+	for _, xdrMeta := range txProcessing {
+		meta, err := ConvertTransactionResultMetaWithOptions(xdrMeta, opts)
+		if err != nil {
+			return result, err
+		}
+		result.TxProcessing = append(result.TxProcessing, meta)
+	}
+
+	for _, xdrMeta := range txProcessingV1 {
+		meta, err := ConvertTransactionResultMetaV1(xdrMeta, opts)
+		if err != nil {
+			return result, err
+		}
+		result.TxProcessing = append(result.TxProcessing, meta)
+	}
+
+	for _, xdrUpgrade := range upgrades {
+		upgrade, err := ConvertUpgradeEntryMeta(xdrUpgrade, opts)
+		if err != nil {
+			return result, err
+		}
+		result.UpgradesProcessing = append(result.UpgradesProcessing, upgrade)
+	}
+
+	for _, xdrKey := range evictedKeys {
+		key, err := ConvertLedgerKeyWithOptions(xdrKey, opts)
+		if err != nil {
+			return result, err
+		}
+		result.EvictedKeys = append(result.EvictedKeys, key)
+	}
+
+	return result, nil
+}
+
+func convertLedgerCloseMetaExt(e xdr.LedgerCloseMetaExt) *LedgerCloseMetaExt {
+	result := &LedgerCloseMetaExt{V: e.V}
+	if e.V1 != nil {
+		fee := int64(e.V1.SorobanFeeWrite1Kb)
+		result.SorobanFeeWrite1Kb = &fee
+	}
+
+	return result
+}
+
+func ConvertUpgradeEntryMeta(m xdr.UpgradeEntryMeta, opts Options) (UpgradeEntryMeta, error) {
+	var result UpgradeEntryMeta
+
+	upgrade, err := ConvertLedgerUpgrade(m.Upgrade, opts)
+	if err != nil {
+		return result, err
+	}
+
+	var changes LedgerEntryChanges
+	for _, xdrChange := range m.Changes {
+		change, err := ConvertLedgerEntryChangeWithOptions(xdrChange, opts)
+		if err != nil {
+			return result, err
+		}
+		changes = append(changes, change)
+	}
+
+	result.Upgrade = upgrade
+	result.Changes = changes
+
+	return result, nil
+}
+
+// GeneralizedTransactionSetEnvelopes returns the envelopes of every phase and
+// component of a generalized transaction set, including the clusters of the
+// parallel Soroban phase of protocol 23.
+func GeneralizedTransactionSetEnvelopes(s xdr.GeneralizedTransactionSet) ([]xdr.TransactionEnvelope, error) {
+	if s.V != 1 {
+		return nil, errors.Errorf("error invalid GeneralizedTransactionSet version %v", s.V)
+	}
+
+	var envelopes []xdr.TransactionEnvelope
+	for _, phase := range s.V1TxSet.Phases {
+		switch phase.V {
+		case 0:
+			for _, component := range *phase.V0Components {
+				if component.Type != xdr.TxSetComponentTypeTxsetCompTxsMaybeDiscountedFee {
+					return nil, errors.Errorf("error invalid TxSetComponent type %v", component.Type)
+				}
+				envelopes = append(envelopes, component.TxsMaybeDiscountedFee.Txs...)
+			}
+		case 1:
+			for _, stage := range phase.ParallelTxsComponent.ExecutionStages {
+				for _, cluster := range stage {
+					envelopes = append(envelopes, cluster...)
+				}
+			}
+		default:
+			return nil, errors.Errorf("error invalid TransactionPhase version %v", phase.V)
+		}
+	}
+
+	return envelopes, nil
+}
+
+// TransactionMetaChanges returns the ledger entry changes of a transaction's
+// meta in the order they were applied.
+func TransactionMetaChanges(m xdr.TransactionMeta) (xdr.LedgerEntryChanges, error) {
+	var before, after xdr.LedgerEntryChanges
+	var operations []xdr.LedgerEntryChanges
+	switch m.V {
+	case 0:
+		for _, op := range *m.Operations {
+			operations = append(operations, op.Changes)
+		}
+	case 1:
+		before = m.V1.TxChanges
+		for _, op := range m.V1.Operations {
+			operations = append(operations, op.Changes)
+		}
+	case 2:
+		before, after = m.V2.TxChangesBefore, m.V2.TxChangesAfter
+		for _, op := range m.V2.Operations {
+			operations = append(operations, op.Changes)
+		}
+	case 3:
+		before, after = m.V3.TxChangesBefore, m.V3.TxChangesAfter
+		for _, op := range m.V3.Operations {
+			operations = append(operations, op.Changes)
+		}
+	case 4:
+		before, after = m.V4.TxChangesBefore, m.V4.TxChangesAfter
+		for _, op := range m.V4.Operations {
+			operations = append(operations, op.Changes)
+		}
+	default:
+		return nil, errors.Errorf("error invalid TransactionMeta type %v", m.V)
+	}
+
+	result := append(xdr.LedgerEntryChanges{}, before...)
+	for _, changes := range operations {
+		result = append(result, changes...)
+	}
+
+	return append(result, after...), nil
+}
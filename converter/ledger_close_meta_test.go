@@ -0,0 +1,189 @@
+package converter
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/stellar/go/xdr"
+)
+
+// protocol23Ledger is ledger 96 of the stellar/go load test ledgers, cut down
+// to its first two transactions: a LedgerCloseMeta V2 whose Soroban phase is
+// parallel and whose transactions have V4 meta, each with ten SAC transfers.
+var protocol23Ledger = filepath.Join("..", "testdata", "protocol23-ledger.xdr.gz")
+
+const loadTestPassphrase = "load test network"
+
+func readProtocol23Ledger(t *testing.T) xdr.LedgerCloseMeta {
+	t.Helper()
+
+	r, err := xdrstream.OpenFile(protocol23Ledger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var meta xdr.LedgerCloseMeta
+	if err := r.Read(&meta); err != nil {
+		t.Fatal(err)
+	}
+
+	return meta
+}
+
+func TestConvertLedgerCloseMetaV2(t *testing.T) {
+	meta := readProtocol23Ledger(t)
+
+	converted, err := ConvertLedgerCloseMeta(meta, Options{NetworkPassphrase: loadTestPassphrase})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if converted.V != 2 || converted.LedgerHeader.Header.LedgerSeq != 96 || converted.Ext == nil {
+		t.Errorf("got v%d ledger %d ext %v", converted.V, converted.LedgerHeader.Header.LedgerSeq, converted.Ext)
+	}
+	if converted.TotalByteSizeOfBucketList != uint64(meta.V2.TotalByteSizeOfLiveSorobanState) {
+		t.Errorf("got live Soroban state size %d", converted.TotalByteSizeOfBucketList)
+	}
+	if len(converted.TxSet) != 2 || len(converted.TxProcessing) != 2 {
+		t.Fatalf("got %d envelopes and %d metas, want 2 of each", len(converted.TxSet), len(converted.TxProcessing))
+	}
+
+	for i, processing := range converted.TxProcessing {
+		v4 := processing.TxApplyProcessing.V4
+		if v4 == nil || len(v4.Operations) != 1 {
+			t.Fatalf("transaction %d: got meta %+v, want V4 with one operation", i, processing.TxApplyProcessing)
+		}
+		if len(v4.Operations[0].Events) != 10 || len(v4.Operations[0].Changes) == 0 {
+			t.Errorf("transaction %d: got %d events and %d changes", i, len(v4.Operations[0].Events), len(v4.Operations[0].Changes))
+		}
+		for _, e := range v4.Operations[0].Events {
+			if e.EventType != EventTypeTransfer || e.Transfer == nil {
+				t.Errorf("transaction %d: got event %+v, want a transfer", i, e)
+			}
+		}
+		if v4.SorobanMeta == nil || len(v4.DiagnosticEvents) == 0 {
+			t.Errorf("transaction %d: got Soroban meta %v and %d diagnostic events", i, v4.SorobanMeta, len(v4.DiagnosticEvents))
+		}
+		if len(processing.PostTxApplyFeeProcessing) != len(meta.V2.TxProcessing[i].PostTxApplyFeeProcessing) || len(processing.PostTxApplyFeeProcessing) == 0 {
+			t.Errorf("transaction %d: got %d fee refund changes", i, len(processing.PostTxApplyFeeProcessing))
+		}
+	}
+
+	if _, err := json.Marshal(converted); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testEnvelope(seq int64) xdr.TransactionEnvelope {
+	return xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1: &xdr.TransactionV1Envelope{Tx: xdr.Transaction{
+			SourceAccount: xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &xdr.Uint256{1}},
+			SeqNum:        xdr.SequenceNumber(seq),
+		}},
+	}
+}
+
+func TestGeneralizedTransactionSetEnvelopes(t *testing.T) {
+	classic := []xdr.TxSetComponent{{
+		Type:                  xdr.TxSetComponentTypeTxsetCompTxsMaybeDiscountedFee,
+		TxsMaybeDiscountedFee: &xdr.TxSetComponentTxsMaybeDiscountedFee{Txs: []xdr.TransactionEnvelope{testEnvelope(1)}},
+	}}
+	parallel := xdr.ParallelTxsComponent{ExecutionStages: []xdr.ParallelTxExecutionStage{
+		{{testEnvelope(2), testEnvelope(3)}, {testEnvelope(4)}},
+		{{testEnvelope(5)}},
+	}}
+	set := xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{Phases: []xdr.TransactionPhase{
+		{V: 0, V0Components: &classic},
+		{V: 1, ParallelTxsComponent: &parallel},
+	}}}
+
+	envelopes, err := GeneralizedTransactionSetEnvelopes(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seqs []int64
+	for _, env := range envelopes {
+		seqs = append(seqs, env.SeqNum())
+	}
+	if len(seqs) != 5 || seqs[0] != 1 || seqs[1] != 2 || seqs[2] != 3 || seqs[3] != 4 || seqs[4] != 5 {
+		t.Errorf("got sequence numbers %v, want 1 to 5", seqs)
+	}
+
+	set.V1TxSet.Phases[1].V = 2
+	if _, err := GeneralizedTransactionSetEnvelopes(set); err == nil {
+		t.Error("converted a phase of unknown version")
+	}
+}
+
+func testChange(b byte) xdr.LedgerEntryChange {
+	key := xdr.LedgerKey{Type: xdr.LedgerEntryTypeTtl, Ttl: &xdr.LedgerKeyTtl{KeyHash: xdr.Hash{b}}}
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved, Removed: &key}
+}
+
+func TestTransactionMetaChangesV4(t *testing.T) {
+	meta := xdr.TransactionMeta{V: 4, V4: &xdr.TransactionMetaV4{
+		TxChangesBefore: xdr.LedgerEntryChanges{testChange(1)},
+		Operations: []xdr.OperationMetaV2{
+			{Changes: xdr.LedgerEntryChanges{testChange(2), testChange(3)}},
+			{Changes: xdr.LedgerEntryChanges{testChange(4)}},
+		},
+		TxChangesAfter: xdr.LedgerEntryChanges{testChange(5)},
+	}}
+
+	changes, err := TransactionMetaChanges(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 5 {
+		t.Fatalf("got %d changes, want 5", len(changes))
+	}
+	for i, change := range changes {
+		if change.Removed.Ttl.KeyHash[0] != byte(i+1) {
+			t.Errorf("change %d is %x, want it in apply order", i, change.Removed.Ttl.KeyHash[0])
+		}
+	}
+}
+
+func TestConvertLedgerCloseMetaV1EvictedKeys(t *testing.T) {
+	key := testChange(1).Removed
+	unused := xdr.LedgerEntry{Data: xdr.LedgerEntryData{Type: xdr.LedgerEntryTypeTtl, Ttl: &xdr.TtlEntry{KeyHash: xdr.Hash{2}}}}
+	meta := xdr.LedgerCloseMeta{V: 1, V1: &xdr.LedgerCloseMetaV1{
+		TxSet:       xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{}},
+		EvictedKeys: []xdr.LedgerKey{*key},
+		Unused:      []xdr.LedgerEntry{unused},
+	}}
+
+	converted, err := ConvertLedgerCloseMeta(meta, Options{NetworkPassphrase: loadTestPassphrase})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(converted.EvictedKeys) != 1 || converted.EvictedKeys[0].Ttl == nil {
+		t.Errorf("got evicted keys %+v, want the TTL key", converted.EvictedKeys)
+	}
+}
+
+// Versions newer than the converter knows must fail rather than convert to
+// an empty value.
+func TestUnknownMetaVersions(t *testing.T) {
+	if _, err := ConvertLedgerCloseMeta(xdr.LedgerCloseMeta{V: 3}, Options{NetworkPassphrase: loadTestPassphrase}); err == nil {
+		t.Error("converted LedgerCloseMeta V3")
+	}
+
+	meta := xdr.TransactionMeta{V: 5}
+	if _, err := ConvertTransactionMeta(meta); err == nil {
+		t.Error("converted TransactionMeta V5")
+	}
+	if _, err := TransactionMetaChanges(meta); err == nil {
+		t.Error("took the changes of TransactionMeta V5")
+	}
+
+	ledger := readProtocol23Ledger(t)
+	ledger.V2.TxProcessing[1].TxApplyProcessing = meta
+	if _, err := ConvertLedgerCloseMeta(ledger, Options{NetworkPassphrase: loadTestPassphrase}); err == nil {
+		t.Error("converted a ledger with TransactionMeta V5")
+	}
+}
@@ -0,0 +1,151 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+func ConvertLedgerHeaderHistoryEntry(e xdr.LedgerHeaderHistoryEntry, opts Options) (LedgerHeaderHistoryEntry, error) {
+	var result LedgerHeaderHistoryEntry
+
+	header, err := ConvertLedgerHeader(e.Header, opts)
+	if err != nil {
+		return result, err
+	}
+
+	result.Hash = e.Hash.HexString()
+	result.Header = header
+	result.Ext = LedgerHeaderHistoryEntryExt{V: e.Ext.V}
+
+	return result, nil
+}
+
+func ConvertLedgerHeader(h xdr.LedgerHeader, opts Options) (LedgerHeader, error) {
+	var result LedgerHeader
+
+	scpValue, err := ConvertStellarValue(h.ScpValue, opts)
+	if err != nil {
+		return result, err
+	}
+
+	var skipList []string
+	for _, hash := range h.SkipList {
+		skipList = append(skipList, hash.HexString())
+	}
+
+	result.LedgerVersion = uint32(h.LedgerVersion)
+	result.PreviousLedgerHash = h.PreviousLedgerHash.HexString()
+	result.ScpValue = scpValue
+	result.TxSetResultHash = h.TxSetResultHash.HexString()
+	result.BucketListHash = h.BucketListHash.HexString()
+	result.LedgerSeq = uint32(h.LedgerSeq)
+	result.TotalCoins = int64(h.TotalCoins)
+	result.FeePool = int64(h.FeePool)
+	result.InflationSeq = uint32(h.InflationSeq)
+	result.IdPool = uint64(h.IdPool)
+	result.BaseFee = uint32(h.BaseFee)
+	result.BaseReserve = uint32(h.BaseReserve)
+	result.MaxTxSetSize = uint32(h.MaxTxSetSize)
+	result.SkipList = skipList
+	result.Ext = ConvertLedgerHeaderExt(h.Ext, opts)
+
+	return result, nil
+}
+
+func ConvertStellarValue(v xdr.StellarValue, opts Options) (StellarValue, error) {
+	var result StellarValue
+
+	// Upgrades are opaque in the header, each holding an encoded LedgerUpgrade.
+	for _, upgradeType := range v.Upgrades {
+		var xdrUpgrade xdr.LedgerUpgrade
+		err := xdrUpgrade.UnmarshalBinary(upgradeType)
+		if err != nil {
+			return result, err
+		}
+
+		upgrade, err := ConvertLedgerUpgrade(xdrUpgrade, opts)
+		if err != nil {
+			return result, err
+		}
+		result.Upgrades = append(result.Upgrades, upgrade)
+	}
+
+	result.TxSetHash = v.TxSetHash.HexString()
+	result.CloseTime = uint64(v.CloseTime)
+	result.Ext.V = int32(v.Ext.V)
+
+	if v.Ext.LcValueSignature != nil {
+		nodeId, err := ConvertAccountId(xdr.AccountId(v.Ext.LcValueSignature.NodeId))
+		if err != nil {
+			return result, err
+		}
+
+		result.Ext.LcValueSignature = &LedgerCloseValueSignature{
+			NodeId:    nodeId,
+			Signature: v.Ext.LcValueSignature.Signature,
+		}
+	}
+
+	return result, nil
+}
+
+func ConvertLedgerUpgrade(u xdr.LedgerUpgrade, opts Options) (LedgerUpgrade, error) {
+	var result LedgerUpgrade
+	result.Type = int32(u.Type)
+	result.TypeName = opts.enumName(u.Type)
+
+	switch u.Type {
+	case xdr.LedgerUpgradeTypeLedgerUpgradeVersion:
+		v := uint32(*u.NewLedgerVersion)
+		result.NewLedgerVersion = &v
+		return result, nil
+	case xdr.LedgerUpgradeTypeLedgerUpgradeBaseFee:
+		v := uint32(*u.NewBaseFee)
+		result.NewBaseFee = &v
+		return result, nil
+	case xdr.LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize:
+		v := uint32(*u.NewMaxTxSetSize)
+		result.NewMaxTxSetSize = &v
+		return result, nil
+	case xdr.LedgerUpgradeTypeLedgerUpgradeBaseReserve:
+		v := uint32(*u.NewBaseReserve)
+		result.NewBaseReserve = &v
+		return result, nil
+	case xdr.LedgerUpgradeTypeLedgerUpgradeFlags:
+		v := uint32(*u.NewFlags)
+		result.NewFlags = &v
+		return result, nil
+	case xdr.LedgerUpgradeTypeLedgerUpgradeConfig:
+		contractId, err := strkey.Encode(strkey.VersionByteContract, u.NewConfig.ContractId[:])
+		if err != nil {
+			return result, err
+		}
+
+		result.NewConfig = &ConfigUpgradeSetKey{
+			ContractId:  contractId,
+			ContentHash: u.NewConfig.ContentHash.HexString(),
+		}
+		return result, nil
+	case xdr.LedgerUpgradeTypeLedgerUpgradeMaxSorobanTxSetSize:
+		v := uint32(*u.NewMaxSorobanTxSetSize)
+		result.NewMaxSorobanTxSetSize = &v
+		return result, nil
+	}
+
+	return result, errors.Errorf("error invalid LedgerUpgrade type %v", u.Type)
+}
+
+func ConvertLedgerHeaderExt(e xdr.LedgerHeaderExt, opts Options) LedgerHeaderExt {
+	result := LedgerHeaderExt{V: e.V}
+
+	if e.V1 != nil {
+		result.V1 = &LedgerHeaderExtensionV1{
+			Flags:     uint32(e.V1.Flags),
+			FlagNames: opts.ledgerHeaderFlagNames(uint32(e.V1.Flags)),
+			Ext:       LedgerHeaderExtensionV1Ext{V: e.V1.Ext.V},
+		}
+	}
+
+	return result
+}
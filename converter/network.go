@@ -0,0 +1,43 @@
+package converter
+
+import "github.com/stellar/go/network"
+
+// DefaultNetworkPassphrase is the network conversions derive network bound
+// identifiers on when the caller does not name one.
+const DefaultNetworkPassphrase = network.PublicNetworkPassphrase
+
+// PassphraseOrDefault returns passphrase, or DefaultNetworkPassphrase when it is
+// empty. Every network passphrase taken by this module follows this rule.
+func PassphraseOrDefault(passphrase string) string {
+	if passphrase == "" {
+		return DefaultNetworkPassphrase
+	}
+
+	return passphrase
+}
+
+// Options are the settings of a conversion that differ between callers. The
+// zero value converts for the public network.
+//
+// Converters that predate Options keep their signature and call
+// ConvertXWithOptions with the zero Options; newer converters take Options as
+// their last argument. Each MarshalJSONXXdr calls MarshalJSONXXdrWithOptions
+// with the zero Options.
+type Options struct {
+	// NetworkPassphrase identifies the network that network bound
+	// identifiers, such as contract IDs, are derived on. Empty means
+	// DefaultNetworkPassphrase.
+	NetworkPassphrase string
+	// ErrorSpec names the contract errors of the converted value after the
+	// variants of the error enums it declares, see
+	// ContractSpec.NameContractErrors.
+	ErrorSpec ContractSpec
+	// EnumNames adds the XDR names of result codes, operation types, flags
+	// and other enums next to their numeric values.
+	EnumNames bool
+}
+
+// Passphrase returns the network passphrase to convert with.
+func (o Options) Passphrase() string {
+	return PassphraseOrDefault(o.NetworkPassphrase)
+}
@@ -5,11 +5,16 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
+// ConvertOperationMeta is ConvertOperationMetaWithOptions with the zero Options.
 func ConvertOperationMeta(m xdr.OperationMeta) (OperationMeta, error) {
+	return ConvertOperationMetaWithOptions(m, Options{})
+}
+
+func ConvertOperationMetaWithOptions(m xdr.OperationMeta, opts Options) (OperationMeta, error) {
 	var result OperationMeta
 	var changes LedgerEntryChanges
 	for _, xdrChange := range m.Changes {
-		change, err := ConvertLedgerEntryChange(xdrChange)
+		change, err := ConvertLedgerEntryChangeWithOptions(xdrChange, opts)
 		if err != nil {
 			return result, err
 		}
@@ -20,12 +25,42 @@ func ConvertOperationMeta(m xdr.OperationMeta) (OperationMeta, error) {
 	return result, nil
 }
 
+func ConvertOperationMetaV2(m xdr.OperationMetaV2, opts Options) (OperationMetaV2, error) {
+	var result OperationMetaV2
+
+	changes, err := convertLedgerEntryChanges(m.Changes, opts)
+	if err != nil {
+		return result, err
+	}
+
+	var events []ContractEvent
+	for _, xdrEvent := range m.Events {
+		event, err := ConvertContractEventWithOptions(xdrEvent, opts)
+		if err != nil {
+			return result, err
+		}
+		events = append(events, event)
+	}
+
+	result.Ext = ConvertExtensionPoint(m.Ext)
+	result.Changes = changes
+	result.Events = events
+
+	return result, nil
+}
+
+// ConvertOperationResult is ConvertOperationResultWithOptions with the zero Options.
 func ConvertOperationResult(op xdr.OperationResult) (OperationResult, error) {
+	return ConvertOperationResultWithOptions(op, Options{})
+}
+
+func ConvertOperationResultWithOptions(op xdr.OperationResult, opts Options) (OperationResult, error) {
 	var result OperationResult
 	result.Code = int32(op.Code)
+	result.CodeName = opts.enumName(op.Code)
 
 	if op.Code == xdr.OperationResultCodeOpInner {
-		tr, err := ConvertOperationResultTr(*op.Tr)
+		tr, err := ConvertOperationResultTrWithOptions(*op.Tr, opts)
 		if err != nil {
 			return result, err
 		}
@@ -36,15 +71,22 @@ func ConvertOperationResult(op xdr.OperationResult) (OperationResult, error) {
 	return result, nil
 }
 
+// ConvertOperationResultTr is ConvertOperationResultTrWithOptions with the zero Options.
 func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error) {
+	return ConvertOperationResultTrWithOptions(r, Options{})
+}
+
+func ConvertOperationResultTrWithOptions(r xdr.OperationResultTr, opts Options) (OperationResultTr, error) {
 	var result OperationResultTr
+	result.Type = opts.enumName(r.Type)
 
 	switch r.Type {
 	case xdr.OperationTypeCreateAccount:
 		xdrCreateAccountResult := r.CreateAccountResult
 
 		createAccountResult := CreateAccountResult{
-			Code: int32(xdrCreateAccountResult.Code),
+			Code:     int32(xdrCreateAccountResult.Code),
+			CodeName: opts.enumName(xdrCreateAccountResult.Code),
 		}
 		result.CreateAccountResult = &createAccountResult
 
@@ -53,7 +95,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrPaymentResult := r.PaymentResult
 
 		paymentResult := PaymentResult{
-			Code: int32(xdrPaymentResult.Code),
+			Code:     int32(xdrPaymentResult.Code),
+			CodeName: opts.enumName(xdrPaymentResult.Code),
 		}
 		result.PaymentResult = &paymentResult
 
@@ -62,7 +105,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrPathPaymentStrictReceiveResult := r.PathPaymentStrictReceiveResult
 
 		pathPaymentStrictReceiveResult := PathPaymentStrictReceiveResult{
-			Code: int32(xdrPathPaymentStrictReceiveResult.Code),
+			Code:     int32(xdrPathPaymentStrictReceiveResult.Code),
+			CodeName: opts.enumName(xdrPathPaymentStrictReceiveResult.Code),
 		}
 
 		if xdrPathPaymentStrictReceiveResult.Code == xdr.PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess {
@@ -87,11 +131,12 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrManageSellOfferResult := r.ManageSellOfferResult
 
 		manageSellOfferResult := ManageSellOfferResult{
-			Code: int32(xdrManageSellOfferResult.Code),
+			Code:     int32(xdrManageSellOfferResult.Code),
+			CodeName: opts.enumName(xdrManageSellOfferResult.Code),
 		}
 
 		if xdrManageSellOfferResult.Code == xdr.ManageSellOfferResultCodeManageSellOfferSuccess {
-			success, err := ConvertManageOfferSuccessResult(*xdrManageSellOfferResult.Success)
+			success, err := ConvertManageOfferSuccessResultWithOptions(*xdrManageSellOfferResult.Success, opts)
 			if err != nil {
 				return result, err
 			}
@@ -105,11 +150,12 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrCreatePassiveSellOfferResult := r.CreatePassiveSellOfferResult
 
 		createPassiveSellOfferResult := ManageSellOfferResult{
-			Code: int32(xdrCreatePassiveSellOfferResult.Code),
+			Code:     int32(xdrCreatePassiveSellOfferResult.Code),
+			CodeName: opts.enumName(xdrCreatePassiveSellOfferResult.Code),
 		}
 
 		if xdrCreatePassiveSellOfferResult.Code == xdr.ManageSellOfferResultCodeManageSellOfferSuccess {
-			success, err := ConvertManageOfferSuccessResult(*xdrCreatePassiveSellOfferResult.Success)
+			success, err := ConvertManageOfferSuccessResultWithOptions(*xdrCreatePassiveSellOfferResult.Success, opts)
 			if err != nil {
 				return result, err
 			}
@@ -121,7 +167,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrSetOptionsResult := r.SetOptionsResult
 
 		setOptionsResult := SetOptionsResult{
-			Code: int32(xdrSetOptionsResult.Code),
+			Code:     int32(xdrSetOptionsResult.Code),
+			CodeName: opts.enumName(xdrSetOptionsResult.Code),
 		}
 		result.SetOptionsResult = &setOptionsResult
 
@@ -130,7 +177,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrChangeTrustResult := r.ChangeTrustResult
 
 		changeTrustResult := ChangeTrustResult{
-			Code: int32(xdrChangeTrustResult.Code),
+			Code:     int32(xdrChangeTrustResult.Code),
+			CodeName: opts.enumName(xdrChangeTrustResult.Code),
 		}
 		result.ChangeTrustResult = &changeTrustResult
 
@@ -139,7 +187,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrAllowTrustResult := r.AllowTrustResult
 
 		allowTrustResult := AllowTrustResult{
-			Code: int32(xdrAllowTrustResult.Code),
+			Code:     int32(xdrAllowTrustResult.Code),
+			CodeName: opts.enumName(xdrAllowTrustResult.Code),
 		}
 		result.AllowTrustResult = &allowTrustResult
 
@@ -148,7 +197,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrAccountMergeResult := r.AccountMergeResult
 
 		accountMergeResult := AccountMergeResult{
-			Code: int32(xdrAccountMergeResult.Code),
+			Code:     int32(xdrAccountMergeResult.Code),
+			CodeName: opts.enumName(xdrAccountMergeResult.Code),
 		}
 
 		if xdrAccountMergeResult.Code == xdr.AccountMergeResultCodeAccountMergeSuccess {
@@ -162,7 +212,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrInflationResult := r.InflationResult
 
 		inflationResult := InflationResult{
-			Code: int32(xdrInflationResult.Code),
+			Code:     int32(xdrInflationResult.Code),
+			CodeName: opts.enumName(xdrInflationResult.Code),
 		}
 
 		if xdrInflationResult.Code == xdr.InflationResultCodeInflationSuccess {
@@ -180,7 +231,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrManageDataResult := r.ManageDataResult
 
 		manageDataResult := ManageDataResult{
-			Code: int32(xdrManageDataResult.Code),
+			Code:     int32(xdrManageDataResult.Code),
+			CodeName: opts.enumName(xdrManageDataResult.Code),
 		}
 		result.ManageDataResult = &manageDataResult
 
@@ -189,7 +241,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrBumpSeqResult := r.BumpSeqResult
 
 		bumpSequenceResult := BumpSequenceResult{
-			Code: int32(xdrBumpSeqResult.Code),
+			Code:     int32(xdrBumpSeqResult.Code),
+			CodeName: opts.enumName(xdrBumpSeqResult.Code),
 		}
 		result.BumpSeqResult = &bumpSequenceResult
 
@@ -198,11 +251,12 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrManageBuyOfferResult := r.ManageBuyOfferResult
 
 		manageBuyOfferResult := ManageBuyOfferResult{
-			Code: int32(xdrManageBuyOfferResult.Code),
+			Code:     int32(xdrManageBuyOfferResult.Code),
+			CodeName: opts.enumName(xdrManageBuyOfferResult.Code),
 		}
 
 		if xdrManageBuyOfferResult.Code == xdr.ManageBuyOfferResultCodeManageBuyOfferSuccess {
-			success, err := ConvertManageOfferSuccessResult(*xdrManageBuyOfferResult.Success)
+			success, err := ConvertManageOfferSuccessResultWithOptions(*xdrManageBuyOfferResult.Success, opts)
 			if err != nil {
 				return result, err
 			}
@@ -216,7 +270,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrPathPaymentStrictSendResult := r.PathPaymentStrictSendResult
 
 		pathPaymentStrictSendResult := PathPaymentStrictSendResult{
-			Code: int32(xdrPathPaymentStrictSendResult.Code),
+			Code:     int32(xdrPathPaymentStrictSendResult.Code),
+			CodeName: opts.enumName(xdrPathPaymentStrictSendResult.Code),
 		}
 
 		if xdrPathPaymentStrictSendResult.Code == xdr.PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess {
@@ -240,7 +295,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrCreateClaimableBalanceResult := r.CreateClaimableBalanceResult
 
 		createClaimableBalanceResult := CreateClaimableBalanceResult{
-			Code: int32(xdrCreateClaimableBalanceResult.Code),
+			Code:     int32(xdrCreateClaimableBalanceResult.Code),
+			CodeName: opts.enumName(xdrCreateClaimableBalanceResult.Code),
 		}
 
 		if xdrCreateClaimableBalanceResult.Code == xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess {
@@ -257,7 +313,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrClaimClaimableBalanceResult := r.ClaimClaimableBalanceResult
 
 		claimClaimableBalanceResult := ClaimClaimableBalanceResult{
-			Code: int32(xdrClaimClaimableBalanceResult.Code),
+			Code:     int32(xdrClaimClaimableBalanceResult.Code),
+			CodeName: opts.enumName(xdrClaimClaimableBalanceResult.Code),
 		}
 		result.ClaimClaimableBalanceResult = &claimClaimableBalanceResult
 
@@ -266,7 +323,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrBeginSponsoringFutureReservesResult := r.BeginSponsoringFutureReservesResult
 
 		beginSponsoringFutureReservesResult := BeginSponsoringFutureReservesResult{
-			Code: int32(xdrBeginSponsoringFutureReservesResult.Code),
+			Code:     int32(xdrBeginSponsoringFutureReservesResult.Code),
+			CodeName: opts.enumName(xdrBeginSponsoringFutureReservesResult.Code),
 		}
 		result.BeginSponsoringFutureReservesResult = &beginSponsoringFutureReservesResult
 
@@ -275,7 +333,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrEndSponsoringFutureReservesResult := r.EndSponsoringFutureReservesResult
 
 		endSponsoringFutureReservesResult := EndSponsoringFutureReservesResult{
-			Code: int32(xdrEndSponsoringFutureReservesResult.Code),
+			Code:     int32(xdrEndSponsoringFutureReservesResult.Code),
+			CodeName: opts.enumName(xdrEndSponsoringFutureReservesResult.Code),
 		}
 		result.EndSponsoringFutureReservesResult = &endSponsoringFutureReservesResult
 
@@ -284,7 +343,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrRevokeSponsorshipResult := r.RevokeSponsorshipResult
 
 		revokeSponsorshipResult := RevokeSponsorshipResult{
-			Code: int32(xdrRevokeSponsorshipResult.Code),
+			Code:     int32(xdrRevokeSponsorshipResult.Code),
+			CodeName: opts.enumName(xdrRevokeSponsorshipResult.Code),
 		}
 		result.RevokeSponsorshipResult = &revokeSponsorshipResult
 
@@ -293,7 +353,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrClawbackResult := r.ClawbackResult
 
 		clawbackResult := ClawbackResult{
-			Code: int32(xdrClawbackResult.Code),
+			Code:     int32(xdrClawbackResult.Code),
+			CodeName: opts.enumName(xdrClawbackResult.Code),
 		}
 		result.ClawbackResult = &clawbackResult
 
@@ -302,7 +363,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrClawbackClaimableBalanceResult := r.ClawbackClaimableBalanceResult
 
 		clawbackClaimableBalanceResult := ClawbackClaimableBalanceResult{
-			Code: int32(xdrClawbackClaimableBalanceResult.Code),
+			Code:     int32(xdrClawbackClaimableBalanceResult.Code),
+			CodeName: opts.enumName(xdrClawbackClaimableBalanceResult.Code),
 		}
 		result.ClawbackClaimableBalanceResult = &clawbackClaimableBalanceResult
 
@@ -311,7 +373,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrSetTrustLineFlagsResult := r.SetTrustLineFlagsResult
 
 		setTrustLineFlagsResult := SetTrustLineFlagsResult{
-			Code: int32(xdrSetTrustLineFlagsResult.Code),
+			Code:     int32(xdrSetTrustLineFlagsResult.Code),
+			CodeName: opts.enumName(xdrSetTrustLineFlagsResult.Code),
 		}
 		result.SetTrustLineFlagsResult = &setTrustLineFlagsResult
 
@@ -320,7 +383,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrLiquidityPoolDepositResult := r.LiquidityPoolDepositResult
 
 		liquidityPoolDepositResult := LiquidityPoolDepositResult{
-			Code: int32(xdrLiquidityPoolDepositResult.Code),
+			Code:     int32(xdrLiquidityPoolDepositResult.Code),
+			CodeName: opts.enumName(xdrLiquidityPoolDepositResult.Code),
 		}
 		result.LiquidityPoolDepositResult = &liquidityPoolDepositResult
 
@@ -329,7 +393,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrLiquidityPoolWithdrawResult := r.LiquidityPoolWithdrawResult
 
 		liquidityPoolWithdrawResult := LiquidityPoolWithdrawResult{
-			Code: int32(xdrLiquidityPoolWithdrawResult.Code),
+			Code:     int32(xdrLiquidityPoolWithdrawResult.Code),
+			CodeName: opts.enumName(xdrLiquidityPoolWithdrawResult.Code),
 		}
 		result.LiquidityPoolWithdrawResult = &liquidityPoolWithdrawResult
 
@@ -338,7 +403,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrInvokeHostFunctionResult := r.InvokeHostFunctionResult
 
 		invokeHostFunctionResult := InvokeHostFunctionResult{
-			Code: int32(xdrInvokeHostFunctionResult.Code),
+			Code:     int32(xdrInvokeHostFunctionResult.Code),
+			CodeName: opts.enumName(xdrInvokeHostFunctionResult.Code),
 		}
 
 		if xdrInvokeHostFunctionResult.Code == xdr.InvokeHostFunctionResultCodeInvokeHostFunctionSuccess {
@@ -352,7 +418,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrExtendFootprintTtlResult := r.ExtendFootprintTtlResult
 
 		extendFootprintTtlResult := ExtendFootprintTtlResult{
-			Code: int32(xdrExtendFootprintTtlResult.Code),
+			Code:     int32(xdrExtendFootprintTtlResult.Code),
+			CodeName: opts.enumName(xdrExtendFootprintTtlResult.Code),
 		}
 		result.ExtendFootprintTtlResult = &extendFootprintTtlResult
 
@@ -361,7 +428,8 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 		xdrRestoreFootprintResult := r.RestoreFootprintResult
 
 		restoreFootprintResult := RestoreFootprintResult{
-			Code: int32(xdrRestoreFootprintResult.Code),
+			Code:     int32(xdrRestoreFootprintResult.Code),
+			CodeName: opts.enumName(xdrRestoreFootprintResult.Code),
 		}
 		result.RestoreFootprintResult = &restoreFootprintResult
 
@@ -371,8 +439,13 @@ func ConvertOperationResultTr(r xdr.OperationResultTr) (OperationResultTr, error
 	return result, errors.Errorf("error invalid operationBody key type %v", r.Type)
 }
 
-// TODO: testing
+// ConvertOperation is ConvertOperationWithOptions with the zero Options.
 func ConvertOperation(op xdr.Operation) (Operation, error) {
+	return ConvertOperationWithOptions(op, Options{})
+}
+
+// TODO: testing
+func ConvertOperationWithOptions(op xdr.Operation, opts Options) (Operation, error) {
 	var result Operation
 	var sourceAccount MuxedAccount
 	var err error
@@ -384,7 +457,7 @@ func ConvertOperation(op xdr.Operation) (Operation, error) {
 	}
 	result.SourceAccount = &sourceAccount
 
-	body, err := ConvertOperationBody(op.Body)
+	body, err := ConvertOperationBodyWithOptions(op.Body, opts)
 	if err != nil {
 		return result, err
 	}
@@ -393,9 +466,15 @@ func ConvertOperation(op xdr.Operation) (Operation, error) {
 	return result, nil
 }
 
-// TODO: testing
+// ConvertOperationBody is ConvertOperationBodyWithOptions with the zero Options.
 func ConvertOperationBody(bd xdr.OperationBody) (OperationBody, error) {
+	return ConvertOperationBodyWithOptions(bd, Options{})
+}
+
+// TODO: testing
+func ConvertOperationBodyWithOptions(bd xdr.OperationBody, opts Options) (OperationBody, error) {
 	var result OperationBody
+	result.Type = opts.enumName(bd.Type)
 
 	switch bd.Type {
 	case xdr.OperationTypeCreateAccount:
@@ -565,15 +644,17 @@ func ConvertOperationBody(bd xdr.OperationBody) (OperationBody, error) {
 		}
 
 		setOptions := &SetOptionsOp{
-			InflationDest: &inflationDest,
-			ClearFlags:    &clearFlags,
-			SetFlags:      &setFlags,
-			MasterWeight:  &masterWeight,
-			LowThreshold:  &lowThreshold,
-			MedThreshold:  &medThreshold,
-			HighThreshold: &highThreshold,
-			HomeDomain:    &homeDomain,
-			Signer:        &signer,
+			InflationDest:  &inflationDest,
+			ClearFlags:     &clearFlags,
+			ClearFlagNames: opts.accountFlagNames(clearFlags),
+			SetFlags:       &setFlags,
+			SetFlagNames:   opts.accountFlagNames(setFlags),
+			MasterWeight:   &masterWeight,
+			LowThreshold:   &lowThreshold,
+			MedThreshold:   &medThreshold,
+			HighThreshold:  &highThreshold,
+			HomeDomain:     &homeDomain,
+			Signer:         &signer,
 		}
 		result.SetOptionsOp = setOptions
 
@@ -773,7 +854,7 @@ func ConvertOperationBody(bd xdr.OperationBody) (OperationBody, error) {
 		var err error
 
 		if xdrRevokeSponsorshipOp.LedgerKey != nil {
-			ledgerKey, err = ConvertLedgerKey(*xdrRevokeSponsorshipOp.LedgerKey)
+			ledgerKey, err = ConvertLedgerKeyWithOptions(*xdrRevokeSponsorshipOp.LedgerKey, opts)
 			if err != nil {
 				return result, err
 			}
@@ -842,10 +923,12 @@ func ConvertOperationBody(bd xdr.OperationBody) (OperationBody, error) {
 		}
 
 		setTrustLineFlagsOp := &SetTrustLineFlagsOp{
-			Trustor:    trustor,
-			Asset:      asset,
-			ClearFlags: uint32(xdrSetTrustLineFlagsOp.ClearFlags),
-			SetFlags:   uint32(xdrSetTrustLineFlagsOp.SetFlags),
+			Trustor:        trustor,
+			Asset:          asset,
+			ClearFlags:     uint32(xdrSetTrustLineFlagsOp.ClearFlags),
+			ClearFlagNames: opts.trustLineFlagNames(uint32(xdrSetTrustLineFlagsOp.ClearFlags)),
+			SetFlags:       uint32(xdrSetTrustLineFlagsOp.SetFlags),
+			SetFlagNames:   opts.trustLineFlagNames(uint32(xdrSetTrustLineFlagsOp.SetFlags)),
 		}
 		result.SetTrustLineFlagsOp = setTrustLineFlagsOp
 
@@ -887,14 +970,14 @@ func ConvertOperationBody(bd xdr.OperationBody) (OperationBody, error) {
 	case xdr.OperationTypeInvokeHostFunction:
 		xdrInvokeHostFunctionOp := bd.InvokeHostFunctionOp
 
-		hostFunc, err := ConvertHostFunction(xdrInvokeHostFunctionOp.HostFunction)
+		hostFunc, err := ConvertHostFunctionWithOptions(xdrInvokeHostFunctionOp.HostFunction, opts)
 		if err != nil {
 			return result, err
 		}
 
 		var auths []SorobanAuthorizationEntry
 		for _, xdrEntry := range xdrInvokeHostFunctionOp.Auth {
-			auth, err := ConvertSorobanAuthorizationEntry(xdrEntry)
+			auth, err := ConvertSorobanAuthorizationEntryWithOptions(xdrEntry, opts)
 			if err != nil {
 				return result, err
 			}
@@ -940,8 +1023,8 @@ func ConvertStateArchivalSettings(s xdr.StateArchivalSettings) StateArchivalSett
 		PersistentRentRateDenominator:  int64(s.PersistentRentRateDenominator),
 		TempRentRateDenominator:        int64(s.TempRentRateDenominator),
 		MaxEntriesToArchive:            uint32(s.MaxEntriesToArchive),
-		BucketListSizeWindowSampleSize: uint32(s.BucketListSizeWindowSampleSize),
-		BucketListWindowSamplePeriod:   uint32(s.BucketListWindowSamplePeriod),
+		BucketListSizeWindowSampleSize: uint32(s.LiveSorobanStateSizeWindowSampleSize),
+		BucketListWindowSamplePeriod:   uint32(s.LiveSorobanStateSizeWindowSamplePeriod),
 		EvictionScanSize:               uint32(s.EvictionScanSize),
 		StartingEvictionScanLevel:      uint32(s.StartingEvictionScanLevel),
 	}
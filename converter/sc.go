@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 	"github.com/stellar/go/xdr"
 )
@@ -26,8 +28,14 @@ func ConvertScAddress(a xdr.ScAddress) (ScAddress, error) {
 	return result, errors.Errorf("error invalid ScAddress type %v", a.Type)
 }
 
+// ConvertScError converts a host error. Type is the name the host gives the
+// error type, e.g. WasmVm or Budget. Contract errors carry the code the
+// contract defined, which ContractSpec.NameContractErrors names after its
+// variant; other errors carry an ScErrorCode and, like Type, its name, e.g.
+// SCEC_ARITH_DOMAIN.
 func ConvertScError(e xdr.ScError) (ScError, error) {
 	var result ScError
+	result.Type = strings.TrimPrefix(e.Type.String(), "ScErrorTypeSce")
 	switch e.Type {
 	case xdr.ScErrorTypeSceContract:
 		contractCode := uint32(*e.ContractCode)
@@ -44,6 +52,7 @@ func ConvertScError(e xdr.ScError) (ScError, error) {
 		xdr.ScErrorTypeSceAuth:
 		code := int32(*e.Code)
 		result.Code = &code
+		result.CodeName = XdrEnumName(*e.Code)
 		return result, nil
 	}
 	return result, errors.Errorf("error invalid ScError type %v", e.Type)
@@ -142,6 +151,10 @@ func ConvertScVal(v xdr.ScVal) (ScVal, error) {
 		result.Sym = &sym
 		return result, nil
 	case xdr.ScValTypeScvVec:
+		// The host rejects a vec without a body, which XDR allows.
+		if v.Vec == nil || *v.Vec == nil {
+			return result, errors.New("error ScVal vec is absent")
+		}
 		xdrScVec := *v.Vec
 		var ScVec []ScVal
 		for _, xdrScVal := range *xdrScVec {
@@ -154,6 +167,10 @@ func ConvertScVal(v xdr.ScVal) (ScVal, error) {
 		result.Vec = &ScVec
 		return result, nil
 	case xdr.ScValTypeScvMap:
+		// The host rejects a map without a body, which XDR allows.
+		if v.Map == nil || *v.Map == nil {
+			return result, errors.New("error ScVal map is absent")
+		}
 		xdrScMap := *v.Map
 		var scMapEntrys []ScMapEntry
 		for _, xdrScMapEntry := range *xdrScMap {
@@ -290,6 +307,10 @@ func ConvertScValInfo(v xdr.ScVal) (ScValInfo, error) {
 		result.Value = &sym
 		return result, nil
 	case xdr.ScValTypeScvVec:
+		// The host rejects a vec without a body, which XDR allows.
+		if v.Vec == nil || *v.Vec == nil {
+			return result, errors.New("error ScVal vec is absent")
+		}
 		xdrScVec := *v.Vec
 		var ScVec []ScValInfo
 		for _, xdrScVal := range *xdrScVec {
@@ -302,6 +323,10 @@ func ConvertScValInfo(v xdr.ScVal) (ScValInfo, error) {
 		result.Value = &ScVec
 		return result, nil
 	case xdr.ScValTypeScvMap:
+		// The host rejects a map without a body, which XDR allows.
+		if v.Map == nil || *v.Map == nil {
+			return result, errors.New("error ScVal map is absent")
+		}
 		xdrScMap := *v.Map
 		var scMapEntrys []ScMapEntryInfo
 		for _, xdrScMapEntry := range *xdrScMap {
@@ -0,0 +1,180 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// contractSpecSection is the name of the Wasm custom section holding a
+// contract's spec.
+const contractSpecSection = "contractspecv0"
+
+var wasmMagic = []byte{0x00, 'a', 's', 'm'}
+
+// ContractSpec is the interface a contract declares: the spec entries of the
+// contractspecv0 custom section of its Wasm.
+type ContractSpec []xdr.ScSpecEntry
+
+// ParseContractSpec parses a contract spec from a Wasm module, or from the
+// spec entries XDR as found in its contractspecv0 section.
+func ParseContractSpec(bz []byte) (ContractSpec, error) {
+	if !bytes.HasPrefix(bz, wasmMagic) {
+		return decodeSpecEntries(bz)
+	}
+
+	section, err := wasmCustomSection(bz, contractSpecSection)
+	if err != nil {
+		return nil, err
+	}
+	if section == nil {
+		return nil, errors.Errorf("error wasm has no %s section", contractSpecSection)
+	}
+
+	return decodeSpecEntries(section)
+}
+
+// ErrorName returns the name of the variant with value code of the first
+// error enum of the spec that has one.
+func (s ContractSpec) ErrorName(code uint32) (string, bool) {
+	for _, entry := range s {
+		if entry.Kind != xdr.ScSpecEntryKindScSpecEntryUdtErrorEnumV0 {
+			continue
+		}
+
+		for _, c := range entry.UdtErrorEnumV0.Cases {
+			if uint32(c.Value) == code {
+				return c.Name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+var scErrorType = reflect.TypeOf(ScError{})
+
+// NameContractErrors sets the ContractErrorName of every contract error
+// reachable from v, a pointer to a converted value, to the variant of the
+// spec's error enums with its code.
+func (s ContractSpec) NameContractErrors(v interface{}) {
+	if len(s) == 0 {
+		return
+	}
+
+	s.nameContractErrors(reflect.ValueOf(v))
+}
+
+func (s ContractSpec) nameContractErrors(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			s.nameContractErrors(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() || !v.CanSet() {
+			return
+		}
+		// The value held by an interface is not addressable: name the errors
+		// of a copy and store it back.
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		s.nameContractErrors(elem)
+		v.Set(elem)
+	case reflect.Struct:
+		if v.Type() == scErrorType {
+			e := v.Addr().Interface().(*ScError)
+			if e.ContractCode != nil {
+				e.ContractErrorName, _ = s.ErrorName(*e.ContractCode)
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				s.nameContractErrors(v.Field(i))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		// Skip byte strings and other slices of scalars.
+		if k := v.Type().Elem().Kind(); k <= reflect.Complex128 || k == reflect.String {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			s.nameContractErrors(v.Index(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			s.nameContractErrors(elem)
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	}
+}
+
+func decodeSpecEntries(bz []byte) (ContractSpec, error) {
+	var result ContractSpec
+
+	decoder := xdr.NewBytesDecoder()
+	for len(bz) > 0 {
+		var entry xdr.ScSpecEntry
+		n, err := decoder.DecodeBytes(&entry, bz)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding spec entry %d", len(result))
+		}
+
+		result = append(result, entry)
+		bz = bz[n:]
+	}
+
+	return result, nil
+}
+
+// wasmCustomSection returns the content of the first custom section of a Wasm
+// module with the given name, or nil if it has none.
+func wasmCustomSection(wasm []byte, name string) ([]byte, error) {
+	if len(wasm) < 8 || !bytes.Equal(wasm[:4], wasmMagic) {
+		return nil, errors.New("error invalid wasm header")
+	}
+
+	r := bytes.NewReader(wasm[8:])
+	for r.Len() > 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading wasm section size")
+		}
+		if size > uint64(r.Len()) {
+			return nil, errors.Errorf("error wasm section %d overflows the module", id)
+		}
+
+		section := make([]byte, size)
+		if _, err := io.ReadFull(r, section); err != nil {
+			return nil, err
+		}
+		if id != 0 {
+			continue
+		}
+
+		// A custom section starts with its name.
+		sr := bytes.NewReader(section)
+		nameLen, err := binary.ReadUvarint(sr)
+		if err != nil || nameLen > uint64(sr.Len()) {
+			return nil, errors.New("error invalid wasm custom section name")
+		}
+		offset := len(section) - sr.Len()
+		if string(section[offset:offset+int(nameLen)]) == name {
+			return section[offset+int(nameLen):], nil
+		}
+	}
+
+	return nil, nil
+}
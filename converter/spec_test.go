@@ -0,0 +1,107 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+)
+
+func testErrorSpec() ContractSpec {
+	return ContractSpec{{
+		Kind: xdr.ScSpecEntryKindScSpecEntryUdtErrorEnumV0,
+		UdtErrorEnumV0: &xdr.ScSpecUdtErrorEnumV0{
+			Name: "Error",
+			Cases: []xdr.ScSpecUdtErrorEnumCaseV0{
+				{Name: "NotInitialized", Value: 1},
+				{Name: "InsufficientBalance", Value: 7},
+			},
+		},
+	}}
+}
+
+func contractErrorVal(code uint32) xdr.ScVal {
+	contractCode := xdr.Uint32(code)
+	return xdr.ScVal{
+		Type:  xdr.ScValTypeScvError,
+		Error: &xdr.ScError{Type: xdr.ScErrorTypeSceContract, ContractCode: &contractCode},
+	}
+}
+
+func TestMarshalJSONNamesContractErrors(t *testing.T) {
+	vec := &xdr.ScVec{contractErrorVal(7), contractErrorVal(9)}
+	inp, err := xdr.ScVal{Type: xdr.ScValTypeScvVec, Vec: &vec}.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bz, err := MarshalJSONContractValueXdrWithOptions(inp, Options{ErrorSpec: testErrorSpec()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Vec []struct {
+			Error ScError `json:"error"`
+		} `json:"vec"`
+	}
+	if err := json.Unmarshal(bz, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Vec) != 2 {
+		t.Fatalf("got %s", bz)
+	}
+	if name := got.Vec[0].Error.ContractErrorName; name != "InsufficientBalance" {
+		t.Errorf("got name %q for code 7, want InsufficientBalance", name)
+	}
+	if name := got.Vec[1].Error.ContractErrorName; name != "" {
+		t.Errorf("got name %q for code 9, which the spec does not declare", name)
+	}
+
+	bz, err = MarshalJSONContractValueXdr(inp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Vec = nil
+	if err := json.Unmarshal(bz, &got); err != nil {
+		t.Fatal(err)
+	}
+	if name := got.Vec[0].Error.ContractErrorName; name != "" {
+		t.Errorf("got name %q without a spec", name)
+	}
+}
+
+func TestNameContractErrorsThroughInterfaces(t *testing.T) {
+	inp, err := contractErrorVal(1).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matches, err := DetectAndConvert(inp, Options{ErrorSpec: testErrorSpec()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range matches {
+		if m.Type != XdrTypeScVal {
+			continue
+		}
+		value := m.Value.(ScVal)
+		if value.Error == nil || value.Error.ContractErrorName != "NotInitialized" {
+			t.Errorf("got %+v, want the NotInitialized contract error", value.Error)
+		}
+		return
+	}
+	t.Fatalf("no ScVal reading in %+v", matches)
+}
+
+func TestConvertScErrorCodeName(t *testing.T) {
+	code := xdr.ScErrorCodeScecArithDomain
+	e := xdr.ScError{Type: xdr.ScErrorTypeSceBudget, Code: &code}
+
+	result, err := ConvertScError(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Type != "Budget" || result.CodeName != "SCEC_ARITH_DOMAIN" {
+		t.Errorf("got type %q and code name %q, want Budget and SCEC_ARITH_DOMAIN", result.Type, result.CodeName)
+	}
+}
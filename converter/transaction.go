@@ -9,24 +9,29 @@ import (
 // 	return models.TransactionJSON{}
 // }
 
+// ConvertTransactionResultMeta is ConvertTransactionResultMetaWithOptions with the zero Options.
 func ConvertTransactionResultMeta(r xdr.TransactionResultMeta) (TransactionResultMeta, error) {
+	return ConvertTransactionResultMetaWithOptions(r, Options{})
+}
+
+func ConvertTransactionResultMetaWithOptions(r xdr.TransactionResultMeta, opts Options) (TransactionResultMeta, error) {
 	var result TransactionResultMeta
 
-	rs, err := ConvertTransactionResultPair(r.Result)
+	rs, err := ConvertTransactionResultPairWithOptions(r.Result, opts)
 	if err != nil {
 		return result, err
 	}
 
 	var fees LedgerEntryChanges
 	for _, xdrFee := range r.FeeProcessing {
-		fee, err := ConvertLedgerEntryChange(xdrFee)
+		fee, err := ConvertLedgerEntryChangeWithOptions(xdrFee, opts)
 		if err != nil {
 			return result, err
 		}
 		fees = append(fees, fee)
 	}
 
-	txMeta, err := ConvertTransactionMeta(r.TxApplyProcessing)
+	txMeta, err := ConvertTransactionMetaWithOptions(r.TxApplyProcessing, opts)
 	if err != nil {
 		return result, err
 	}
@@ -38,14 +43,39 @@ func ConvertTransactionResultMeta(r xdr.TransactionResultMeta) (TransactionResul
 	return result, nil
 }
 
+// ConvertTransactionResultMetaV1 converts the result meta of LedgerCloseMeta
+// V2 into a TransactionResultMeta with its post apply fee processing.
+func ConvertTransactionResultMetaV1(r xdr.TransactionResultMetaV1, opts Options) (TransactionResultMeta, error) {
+	result, err := ConvertTransactionResultMetaWithOptions(xdr.TransactionResultMeta{
+		Result:            r.Result,
+		FeeProcessing:     r.FeeProcessing,
+		TxApplyProcessing: r.TxApplyProcessing,
+	}, opts)
+	if err != nil {
+		return result, err
+	}
+
+	result.PostTxApplyFeeProcessing, err = convertLedgerEntryChanges(r.PostTxApplyFeeProcessing, opts)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ConvertTransactionMeta is ConvertTransactionMetaWithOptions with the zero Options.
 func ConvertTransactionMeta(m xdr.TransactionMeta) (TransactionMeta, error) {
+	return ConvertTransactionMetaWithOptions(m, Options{})
+}
+
+func ConvertTransactionMetaWithOptions(m xdr.TransactionMeta, opts Options) (TransactionMeta, error) {
 	var result TransactionMeta
 
 	switch m.V {
 	case 0:
 		var ops []OperationMeta
 		for _, xdrOp := range *m.Operations {
-			op, err := ConvertOperationMeta(xdrOp)
+			op, err := ConvertOperationMetaWithOptions(xdrOp, opts)
 			if err != nil {
 				return result, err
 			}
@@ -56,36 +86,48 @@ func ConvertTransactionMeta(m xdr.TransactionMeta) (TransactionMeta, error) {
 
 		return result, nil
 	case 1:
-		v1, err := ConvertTransactionMetaV1(*m.V1)
+		v1, err := ConvertTransactionMetaV1WithOptions(*m.V1, opts)
 		if err != nil {
 			return result, err
 		}
 		result.V1 = &v1
 		return result, nil
 	case 2:
-		v2, err := ConvertTransactionMetaV2(*m.V2)
+		v2, err := ConvertTransactionMetaV2WithOptions(*m.V2, opts)
 		if err != nil {
 			return result, err
 		}
 		result.V2 = &v2
 		return result, nil
 	case 3:
-		v3, err := ConvertTransactionMetaV3(*m.V3)
+		v3, err := ConvertTransactionMetaV3WithOptions(*m.V3, opts)
 		if err != nil {
 			return result, err
 		}
 		result.V3 = &v3
 		return result, nil
+	case 4:
+		v4, err := ConvertTransactionMetaV4(*m.V4, opts)
+		if err != nil {
+			return result, err
+		}
+		result.V4 = &v4
+		return result, nil
 	}
 	return result, errors.Errorf("error invalid TransactionMeta type %v", m.V)
 }
 
+// ConvertTransactionMetaV1 is ConvertTransactionMetaV1WithOptions with the zero Options.
 func ConvertTransactionMetaV1(m xdr.TransactionMetaV1) (TransactionMetaV1, error) {
+	return ConvertTransactionMetaV1WithOptions(m, Options{})
+}
+
+func ConvertTransactionMetaV1WithOptions(m xdr.TransactionMetaV1, opts Options) (TransactionMetaV1, error) {
 	var result TransactionMetaV1
 
 	var txChanges LedgerEntryChanges
 	for _, xdrTxChange := range m.TxChanges {
-		txChange, err := ConvertLedgerEntryChange(xdrTxChange)
+		txChange, err := ConvertLedgerEntryChangeWithOptions(xdrTxChange, opts)
 		if err != nil {
 			return result, err
 		}
@@ -95,7 +137,7 @@ func ConvertTransactionMetaV1(m xdr.TransactionMetaV1) (TransactionMetaV1, error
 
 	var operations []OperationMeta
 	for _, xdrOp := range m.Operations {
-		op, err := ConvertOperationMeta(xdrOp)
+		op, err := ConvertOperationMetaWithOptions(xdrOp, opts)
 		if err != nil {
 			return result, err
 		}
@@ -109,12 +151,17 @@ func ConvertTransactionMetaV1(m xdr.TransactionMetaV1) (TransactionMetaV1, error
 	return result, nil
 }
 
+// ConvertTransactionMetaV2 is ConvertTransactionMetaV2WithOptions with the zero Options.
 func ConvertTransactionMetaV2(m xdr.TransactionMetaV2) (TransactionMetaV2, error) {
+	return ConvertTransactionMetaV2WithOptions(m, Options{})
+}
+
+func ConvertTransactionMetaV2WithOptions(m xdr.TransactionMetaV2, opts Options) (TransactionMetaV2, error) {
 	var result TransactionMetaV2
 
 	var txChangesBefore LedgerEntryChanges
 	for _, xdrTxChange := range m.TxChangesBefore {
-		txChange, err := ConvertLedgerEntryChange(xdrTxChange)
+		txChange, err := ConvertLedgerEntryChangeWithOptions(xdrTxChange, opts)
 		if err != nil {
 			return result, err
 		}
@@ -124,7 +171,7 @@ func ConvertTransactionMetaV2(m xdr.TransactionMetaV2) (TransactionMetaV2, error
 
 	var operations []OperationMeta
 	for _, xdrOp := range m.Operations {
-		op, err := ConvertOperationMeta(xdrOp)
+		op, err := ConvertOperationMetaWithOptions(xdrOp, opts)
 		if err != nil {
 			return result, err
 		}
@@ -134,7 +181,7 @@ func ConvertTransactionMetaV2(m xdr.TransactionMetaV2) (TransactionMetaV2, error
 
 	var txChangesAfter LedgerEntryChanges
 	for _, xdrTxChange := range m.TxChangesAfter {
-		txChange, err := ConvertLedgerEntryChange(xdrTxChange)
+		txChange, err := ConvertLedgerEntryChangeWithOptions(xdrTxChange, opts)
 		if err != nil {
 			return result, err
 		}
@@ -149,14 +196,19 @@ func ConvertTransactionMetaV2(m xdr.TransactionMetaV2) (TransactionMetaV2, error
 	return result, nil
 }
 
+// ConvertTransactionMetaV3 is ConvertTransactionMetaV3WithOptions with the zero Options.
 func ConvertTransactionMetaV3(m xdr.TransactionMetaV3) (TransactionMetaV3, error) {
+	return ConvertTransactionMetaV3WithOptions(m, Options{})
+}
+
+func ConvertTransactionMetaV3WithOptions(m xdr.TransactionMetaV3, opts Options) (TransactionMetaV3, error) {
 	var result TransactionMetaV3
 
 	ext := ConvertExtensionPoint(m.Ext)
 
 	var txChangesBefore LedgerEntryChanges
 	for _, xdrTxChange := range m.TxChangesBefore {
-		txChange, err := ConvertLedgerEntryChange(xdrTxChange)
+		txChange, err := ConvertLedgerEntryChangeWithOptions(xdrTxChange, opts)
 		if err != nil {
 			return result, err
 		}
@@ -166,7 +218,7 @@ func ConvertTransactionMetaV3(m xdr.TransactionMetaV3) (TransactionMetaV3, error
 
 	var operations []OperationMeta
 	for _, xdrOp := range m.Operations {
-		op, err := ConvertOperationMeta(xdrOp)
+		op, err := ConvertOperationMetaWithOptions(xdrOp, opts)
 		if err != nil {
 			return result, err
 		}
@@ -176,7 +228,7 @@ func ConvertTransactionMetaV3(m xdr.TransactionMetaV3) (TransactionMetaV3, error
 
 	var txChangesAfter LedgerEntryChanges
 	for _, xdrTxChange := range m.TxChangesAfter {
-		txChange, err := ConvertLedgerEntryChange(xdrTxChange)
+		txChange, err := ConvertLedgerEntryChangeWithOptions(xdrTxChange, opts)
 		if err != nil {
 			return result, err
 		}
@@ -187,7 +239,7 @@ func ConvertTransactionMetaV3(m xdr.TransactionMetaV3) (TransactionMetaV3, error
 	var sorobanMeta SorobanTransactionMeta
 	if m.SorobanMeta != nil {
 		var err error
-		sorobanMeta, err = ConvertSorobanTransactionMeta(*m.SorobanMeta)
+		sorobanMeta, err = ConvertSorobanTransactionMetaWithOptions(*m.SorobanMeta, opts)
 		if err != nil {
 			return result, err
 		}
@@ -202,13 +254,94 @@ func ConvertTransactionMetaV3(m xdr.TransactionMetaV3) (TransactionMetaV3, error
 	return result, nil
 }
 
+// ConvertTransactionMetaV4 converts the protocol 23 meta, which moves contract
+// events to their operations and adds transaction level events.
+func ConvertTransactionMetaV4(m xdr.TransactionMetaV4, opts Options) (TransactionMetaV4, error) {
+	var result TransactionMetaV4
+
+	txChangesBefore, err := convertLedgerEntryChanges(m.TxChangesBefore, opts)
+	if err != nil {
+		return result, err
+	}
+
+	var operations []OperationMetaV2
+	for _, xdrOp := range m.Operations {
+		op, err := ConvertOperationMetaV2(xdrOp, opts)
+		if err != nil {
+			return result, err
+		}
+
+		operations = append(operations, op)
+	}
+
+	txChangesAfter, err := convertLedgerEntryChanges(m.TxChangesAfter, opts)
+	if err != nil {
+		return result, err
+	}
+
+	if m.SorobanMeta != nil {
+		sorobanMeta, err := ConvertSorobanTransactionMetaV2(*m.SorobanMeta)
+		if err != nil {
+			return result, err
+		}
+		result.SorobanMeta = &sorobanMeta
+	}
+
+	var events []TransactionEvent
+	for _, xdrEvent := range m.Events {
+		event, err := ConvertTransactionEvent(xdrEvent, opts)
+		if err != nil {
+			return result, err
+		}
+		events = append(events, event)
+	}
+
+	var diagnosticEvents []DiagnosticEvent
+	for _, xdrEvent := range m.DiagnosticEvents {
+		event, err := ConvertDiagnosticEventWithOptions(xdrEvent, opts)
+		if err != nil {
+			return result, err
+		}
+		diagnosticEvents = append(diagnosticEvents, event)
+	}
+
+	result.Ext = ConvertExtensionPoint(m.Ext)
+	result.TxChangesBefore = txChangesBefore
+	result.Operations = operations
+	result.TxChangesAfter = txChangesAfter
+	result.Events = events
+	result.DiagnosticEvents = diagnosticEvents
+
+	return result, nil
+}
+
+func ConvertSorobanTransactionMetaV2(m xdr.SorobanTransactionMetaV2) (SorobanTransactionMetaV2, error) {
+	var result SorobanTransactionMetaV2
+	result.Ext = ConvertSorobanTransactionMetaExt(m.Ext)
+
+	if m.ReturnValue != nil {
+		returnValue, err := ConvertScVal(*m.ReturnValue)
+		if err != nil {
+			return result, err
+		}
+		result.ReturnValue = &returnValue
+	}
+
+	return result, nil
+}
+
+// ConvertSorobanTransactionMeta is ConvertSorobanTransactionMetaWithOptions with the zero Options.
 func ConvertSorobanTransactionMeta(m xdr.SorobanTransactionMeta) (SorobanTransactionMeta, error) {
+	return ConvertSorobanTransactionMetaWithOptions(m, Options{})
+}
+
+func ConvertSorobanTransactionMetaWithOptions(m xdr.SorobanTransactionMeta, opts Options) (SorobanTransactionMeta, error) {
 	var result SorobanTransactionMeta
 	ext := ConvertSorobanTransactionMetaExt(m.Ext)
 
 	var events []ContractEvent
 	for _, xdrEvent := range m.Events {
-		event, err := ConvertContractEvent(xdrEvent)
+		event, err := ConvertContractEventWithOptions(xdrEvent, opts)
 		if err != nil {
 			return result, err
 		}
@@ -222,7 +355,7 @@ func ConvertSorobanTransactionMeta(m xdr.SorobanTransactionMeta) (SorobanTransac
 
 	var diagnosticEvents []DiagnosticEvent
 	for _, xdrEvent := range m.DiagnosticEvents {
-		event, err := ConvertDiagnosticEvent(xdrEvent)
+		event, err := ConvertDiagnosticEventWithOptions(xdrEvent, opts)
 		if err != nil {
 			return result, err
 		}
@@ -261,11 +394,16 @@ func ConvertSorobanTransactionMetaExtV1(e xdr.SorobanTransactionMetaExtV1) Sorob
 	}
 }
 
+// ConvertTransactionResultPair is ConvertTransactionResultPairWithOptions with the zero Options.
 func ConvertTransactionResultPair(r xdr.TransactionResultPair) (TransactionResultPair, error) {
+	return ConvertTransactionResultPairWithOptions(r, Options{})
+}
+
+func ConvertTransactionResultPairWithOptions(r xdr.TransactionResultPair, opts Options) (TransactionResultPair, error) {
 	var result TransactionResultPair
 	result.TransactionHash = r.TransactionHash.HexString()
 
-	rs, err := ConvertTransactionResult(r.Result)
+	rs, err := ConvertTransactionResultWithOptions(r.Result, opts)
 	if err != nil {
 		return result, err
 	}
@@ -274,11 +412,16 @@ func ConvertTransactionResultPair(r xdr.TransactionResultPair) (TransactionResul
 	return result, nil
 }
 
+// ConvertTransactionResult is ConvertTransactionResultWithOptions with the zero Options.
 func ConvertTransactionResult(r xdr.TransactionResult) (TransactionResult, error) {
+	return ConvertTransactionResultWithOptions(r, Options{})
+}
+
+func ConvertTransactionResultWithOptions(r xdr.TransactionResult, opts Options) (TransactionResult, error) {
 	var result TransactionResult
 	result.FeeCharged = int64(r.FeeCharged)
 
-	rs, err := ConvertTransactionResultResult(r.Result)
+	rs, err := ConvertTransactionResultResultWithOptions(r.Result, opts)
 	if err != nil {
 		return result, err
 	}
@@ -290,12 +433,18 @@ func ConvertTransactionResult(r xdr.TransactionResult) (TransactionResult, error
 	return result, nil
 }
 
+// ConvertTransactionResultResult is ConvertTransactionResultResultWithOptions with the zero Options.
 func ConvertTransactionResultResult(r xdr.TransactionResultResult) (TransactionResultResult, error) {
+	return ConvertTransactionResultResultWithOptions(r, Options{})
+}
+
+func ConvertTransactionResultResultWithOptions(r xdr.TransactionResultResult, opts Options) (TransactionResultResult, error) {
 	var result TransactionResultResult
 	result.Code = int32(r.Code)
+	result.CodeName = opts.enumName(r.Code)
 
 	if r.Code == xdr.TransactionResultCodeTxFeeBumpInnerSuccess || r.Code == xdr.TransactionResultCodeTxFeeBumpInnerFailed {
-		innerResult, err := ConvertInnerTransactionResultPair(*r.InnerResultPair)
+		innerResult, err := ConvertInnerTransactionResultPairWithOptions(*r.InnerResultPair, opts)
 		if err != nil {
 			return result, err
 		}
@@ -304,7 +453,7 @@ func ConvertTransactionResultResult(r xdr.TransactionResultResult) (TransactionR
 		var opResult []OperationResult
 		if r.Results != nil {
 			for _, xdrResult := range *r.Results {
-				op, err := ConvertOperationResult(xdrResult)
+				op, err := ConvertOperationResultWithOptions(xdrResult, opts)
 				if err != nil {
 					return result, err
 				}
@@ -317,11 +466,16 @@ func ConvertTransactionResultResult(r xdr.TransactionResultResult) (TransactionR
 	return result, nil
 }
 
+// ConvertInnerTransactionResultPair is ConvertInnerTransactionResultPairWithOptions with the zero Options.
 func ConvertInnerTransactionResultPair(r xdr.InnerTransactionResultPair) (InnerTransactionResultPair, error) {
+	return ConvertInnerTransactionResultPairWithOptions(r, Options{})
+}
+
+func ConvertInnerTransactionResultPairWithOptions(r xdr.InnerTransactionResultPair, opts Options) (InnerTransactionResultPair, error) {
 	var result InnerTransactionResultPair
 	result.TransactionHash = r.TransactionHash.HexString()
 
-	rs, err := ConvertInnerTransactionResult(r.Result)
+	rs, err := ConvertInnerTransactionResultWithOptions(r.Result, opts)
 	if err != nil {
 		return result, err
 	}
@@ -330,11 +484,16 @@ func ConvertInnerTransactionResultPair(r xdr.InnerTransactionResultPair) (InnerT
 	return result, nil
 }
 
+// ConvertInnerTransactionResult is ConvertInnerTransactionResultWithOptions with the zero Options.
 func ConvertInnerTransactionResult(r xdr.InnerTransactionResult) (InnerTransactionResult, error) {
+	return ConvertInnerTransactionResultWithOptions(r, Options{})
+}
+
+func ConvertInnerTransactionResultWithOptions(r xdr.InnerTransactionResult, opts Options) (InnerTransactionResult, error) {
 	var result InnerTransactionResult
 	result.FeeCharged = int64(r.FeeCharged)
 
-	rs, err := ConvertInnerTransactionResultResult(r.Result)
+	rs, err := ConvertInnerTransactionResultResultWithOptions(r.Result, opts)
 	if err != nil {
 		return result, err
 	}
@@ -346,14 +505,20 @@ func ConvertInnerTransactionResult(r xdr.InnerTransactionResult) (InnerTransacti
 	return result, nil
 }
 
+// ConvertInnerTransactionResultResult is ConvertInnerTransactionResultResultWithOptions with the zero Options.
 func ConvertInnerTransactionResultResult(r xdr.InnerTransactionResultResult) (InnerTransactionResultResult, error) {
+	return ConvertInnerTransactionResultResultWithOptions(r, Options{})
+}
+
+func ConvertInnerTransactionResultResultWithOptions(r xdr.InnerTransactionResultResult, opts Options) (InnerTransactionResultResult, error) {
 	var result InnerTransactionResultResult
 	result.Code = int32(r.Code)
+	result.CodeName = opts.enumName(r.Code)
 
 	if r.Code == xdr.TransactionResultCodeTxSuccess || r.Code == xdr.TransactionResultCodeTxFailed {
 		var opResults []OperationResult
 		for _, xdrResult := range *r.Results {
-			r, err := ConvertOperationResult(xdrResult)
+			r, err := ConvertOperationResultWithOptions(xdrResult, opts)
 			if err != nil {
 				return result, err
 			}
@@ -374,7 +539,12 @@ func ConvertTransactionResultExt(e xdr.TransactionResultExt) TransactionResultEx
 	return TransactionResultExt{V: e.V}
 }
 
+// ConvertFeeBumpTransaction is ConvertFeeBumpTransactionWithOptions with the zero Options.
 func ConvertFeeBumpTransaction(tx xdr.FeeBumpTransaction) (FeeBumpTransaction, error) {
+	return ConvertFeeBumpTransactionWithOptions(tx, Options{})
+}
+
+func ConvertFeeBumpTransactionWithOptions(tx xdr.FeeBumpTransaction, opts Options) (FeeBumpTransaction, error) {
 	var result FeeBumpTransaction
 
 	feeSource, err := ConvertMuxedAccount(tx.FeeSource)
@@ -382,7 +552,7 @@ func ConvertFeeBumpTransaction(tx xdr.FeeBumpTransaction) (FeeBumpTransaction, e
 		return result, err
 	}
 
-	innerTx, err := ConvertFeeBumpTransactionInnerTx(tx.InnerTx)
+	innerTx, err := ConvertFeeBumpTransactionInnerTxWithOptions(tx.InnerTx, opts)
 	if err != nil {
 		return result, err
 	}
@@ -397,7 +567,12 @@ func ConvertFeeBumpTransaction(tx xdr.FeeBumpTransaction) (FeeBumpTransaction, e
 	return result, nil
 }
 
+// ConvertTransaction is ConvertTransactionWithOptions with the zero Options.
 func ConvertTransaction(tx xdr.Transaction) (Transaction, error) {
+	return ConvertTransactionWithOptions(tx, Options{})
+}
+
+func ConvertTransactionWithOptions(tx xdr.Transaction, opts Options) (Transaction, error) {
 	var result Transaction
 
 	sourceAccount, err := ConvertMuxedAccount(tx.SourceAccount)
@@ -417,14 +592,19 @@ func ConvertTransaction(tx xdr.Transaction) (Transaction, error) {
 
 	var ops []Operation
 	for _, xdrOp := range tx.Operations {
-		op, err := ConvertOperation(xdrOp)
+		op, err := ConvertOperationWithOptions(xdrOp, opts)
 		if err != nil {
 			return result, err
 		}
 		ops = append(ops, op)
 	}
 
-	ext, err := ConvertTxExt(tx.Ext)
+	err = setClaimableBalanceIds(ops, tx.SourceAccount.ToAccountId(), int64(tx.SeqNum))
+	if err != nil {
+		return result, err
+	}
+
+	ext, err := ConvertTxExtWithOptions(tx.Ext, opts)
 	if err != nil {
 		return result, err
 	}
@@ -441,8 +621,13 @@ func ConvertTransaction(tx xdr.Transaction) (Transaction, error) {
 
 }
 
-// TODO: testing
+// ConvertTransactionV0 is ConvertTransactionV0WithOptions with the zero Options.
 func ConvertTransactionV0(tx xdr.TransactionV0) (TransactionV0, error) {
+	return ConvertTransactionV0WithOptions(tx, Options{})
+}
+
+// TODO: testing
+func ConvertTransactionV0WithOptions(tx xdr.TransactionV0, opts Options) (TransactionV0, error) {
 	var txV0 TransactionV0
 
 	txV0.Fee = uint32(tx.Fee)
@@ -468,13 +653,20 @@ func ConvertTransactionV0(tx xdr.TransactionV0) (TransactionV0, error) {
 
 	var ops []Operation
 	for _, opXdr := range tx.Operations {
-		op, err := ConvertOperation(opXdr)
+		op, err := ConvertOperationWithOptions(opXdr, opts)
 		if err != nil {
 			return txV0, err
 		}
 
 		ops = append(ops, op)
 	}
+
+	sourceKey := tx.SourceAccountEd25519
+	source := xdr.AccountId{Type: xdr.PublicKeyTypePublicKeyTypeEd25519, Ed25519: &sourceKey}
+	err = setClaimableBalanceIds(ops, source, int64(tx.SeqNum))
+	if err != nil {
+		return txV0, err
+	}
 	txV0.Operations = ops
 
 	ext, err := ConvertTxV0Ext(tx.Ext)
@@ -486,6 +678,24 @@ func ConvertTransactionV0(tx xdr.TransactionV0) (TransactionV0, error) {
 	return txV0, nil
 }
 
+// setClaimableBalanceIds fills in the IDs of the balances created by the
+// CreateClaimableBalance operations of a transaction.
+func setClaimableBalanceIds(ops []Operation, source xdr.AccountId, seqNum int64) error {
+	for i, op := range ops {
+		if op.Body.CreateClaimableBalanceOp == nil {
+			continue
+		}
+
+		balanceId, err := ClaimableBalanceIdFromOperation(source, seqNum, uint32(i))
+		if err != nil {
+			return err
+		}
+		op.Body.CreateClaimableBalanceOp.BalanceId = &balanceId
+	}
+
+	return nil
+}
+
 // TODO: testing
 func ConvertTimeBounds(tb *xdr.TimeBounds) (*TimeBounds, error) {
 	if tb == nil {
@@ -552,13 +762,18 @@ func ConvertTxV0Ext(e xdr.TransactionV0Ext) (TransactionV0Ext, error) {
 	}, nil
 }
 
+// ConvertTxExt is ConvertTxExtWithOptions with the zero Options.
 func ConvertTxExt(e xdr.TransactionExt) (TransactionExt, error) {
+	return ConvertTxExtWithOptions(e, Options{})
+}
+
+func ConvertTxExtWithOptions(e xdr.TransactionExt, opts Options) (TransactionExt, error) {
 	var result TransactionExt
 
 	var data SorobanTransactionData
 	var err error
 	if e.SorobanData != nil {
-		data, err = ConvertSorobanTransactionData(*e.SorobanData)
+		data, err = ConvertSorobanTransactionDataWithOptions(*e.SorobanData, opts)
 		if err != nil {
 			return result, err
 		}
@@ -570,11 +785,16 @@ func ConvertTxExt(e xdr.TransactionExt) (TransactionExt, error) {
 	return result, nil
 }
 
+// ConvertFeeBumpTransactionInnerTx is ConvertFeeBumpTransactionInnerTxWithOptions with the zero Options.
 func ConvertFeeBumpTransactionInnerTx(f xdr.FeeBumpTransactionInnerTx) (FeeBumpTransactionInnerTx, error) {
+	return ConvertFeeBumpTransactionInnerTxWithOptions(f, Options{})
+}
+
+func ConvertFeeBumpTransactionInnerTxWithOptions(f xdr.FeeBumpTransactionInnerTx, opts Options) (FeeBumpTransactionInnerTx, error) {
 	var result FeeBumpTransactionInnerTx
 	switch f.Type {
 	case xdr.EnvelopeTypeEnvelopeTypeTx:
-		v1, err := ConvertTransactionV1Envelope(f.V1)
+		v1, err := ConvertTransactionV1EnvelopeWithOptions(f.V1, opts)
 		if err != nil {
 			return result, err
 		}
@@ -611,10 +831,16 @@ func ConvertTtlEntry(e xdr.TtlEntry) TtlEntry {
 	}
 }
 
+// ConvertConfigSettingEntry is ConvertConfigSettingEntryWithOptions with the zero Options.
 func ConvertConfigSettingEntry(e xdr.ConfigSettingEntry) (ConfigSettingEntry, error) {
+	return ConvertConfigSettingEntryWithOptions(e, Options{})
+}
+
+func ConvertConfigSettingEntryWithOptions(e xdr.ConfigSettingEntry, opts Options) (ConfigSettingEntry, error) {
 	var result ConfigSettingEntry
 
 	result.ConfigSettingId = int32(e.ConfigSettingId)
+	result.ConfigSettingIdName = opts.enumName(e.ConfigSettingId)
 
 	switch e.ConfigSettingId {
 	case xdr.ConfigSettingIdConfigSettingContractMaxSizeBytes:
@@ -665,13 +891,13 @@ func ConvertConfigSettingEntry(e xdr.ConfigSettingEntry) (ConfigSettingEntry, er
 		value := ConvertConfigSettingContractExecutionLanesV0(*e.ContractExecutionLanes)
 		result.ContractExecutionLanes = &value
 		return result, nil
-	case xdr.ConfigSettingIdConfigSettingBucketlistSizeWindow:
+	case xdr.ConfigSettingIdConfigSettingLiveSorobanStateSizeWindow:
 		var value []uint64
-		for _, s := range *e.BucketListSizeWindow {
+		for _, s := range *e.LiveSorobanStateSizeWindow {
 			value = append(value, uint64(s))
 		}
 
-		result.BucketListSizeWindow = &value
+		result.LiveSorobanStateSizeWindow = &value
 		return result, nil
 	case xdr.ConfigSettingIdConfigSettingEvictionIterator:
 		value := ConvertEvictionIterator(*e.EvictionIterator)
@@ -52,10 +52,14 @@ type Operation struct {
 }
 
 type MuxedAccount struct {
-	Address string `json:"address,omitempty"`
+	Address   string  `json:"address,omitempty"`
+	AccountId string  `json:"account_id,omitempty"`
+	MuxedId   *uint64 `json:"muxed_id,omitempty"`
+	IsMuxed   bool    `json:"is_muxed,omitempty"`
 }
 
 type OperationBody struct {
+	Type                            string                           `json:"type,omitempty"`
 	CreateAccountOp                 *CreateAccountOp                 `json:"create_account_op,omitempty"`
 	PaymentOp                       *PaymentOp                       `json:"payment_op,omitempty"`
 	PathPaymentStrictReceiveOp      *PathPaymentStrictReceiveOp      `json:"path_payment_strict_receive_op,omitempty"`
@@ -88,9 +92,13 @@ type AccountId struct {
 }
 
 type Asset struct {
-	AssetType string    `json:"asset_type,omitempty"`
-	AssetCode []byte    `json:"asset_code,omitempty"`
-	Issuer    AccountId `json:"issuer,omitempty"`
+	AssetType       string    `json:"asset_type,omitempty"`
+	AssetCode       []byte    `json:"asset_code,omitempty"`
+	Issuer          AccountId `json:"issuer,omitempty"`
+	Code            string    `json:"code,omitempty"`
+	Canonical       string    `json:"canonical,omitempty"`
+	Sep11           string    `json:"sep11,omitempty"`
+	LiquidityPoolId string    `json:"liquidity_pool_id,omitempty"`
 }
 
 type CreateAccountOp struct {
@@ -134,15 +142,17 @@ type CreatePassiveSellOfferOp struct {
 }
 
 type SetOptionsOp struct {
-	InflationDest *AccountId `json:"inflation_dest,omitempty"`
-	ClearFlags    *uint32    `json:"clear_flags,omitempty"`
-	SetFlags      *uint32    `json:"set_flags,omitempty"`
-	MasterWeight  *uint32    `json:"master_weight,omitempty"`
-	LowThreshold  *uint32    `json:"low_threshold,omitempty"`
-	MedThreshold  *uint32    `json:"med_threshold,omitempty"`
-	HighThreshold *uint32    `json:"high_threshold,omitempty"`
-	HomeDomain    *string    `json:"home_domain,omitempty"`
-	Signer        *Signer    `json:"signer,omitempty"`
+	InflationDest  *AccountId `json:"inflation_dest,omitempty"`
+	ClearFlags     *uint32    `json:"clear_flags,omitempty"`
+	ClearFlagNames []string   `json:"clear_flag_names,omitempty"`
+	SetFlags       *uint32    `json:"set_flags,omitempty"`
+	SetFlagNames   []string   `json:"set_flag_names,omitempty"`
+	MasterWeight   *uint32    `json:"master_weight,omitempty"`
+	LowThreshold   *uint32    `json:"low_threshold,omitempty"`
+	MedThreshold   *uint32    `json:"med_threshold,omitempty"`
+	HighThreshold  *uint32    `json:"high_threshold,omitempty"`
+	HomeDomain     *string    `json:"home_domain,omitempty"`
+	Signer         *Signer    `json:"signer,omitempty"`
 }
 
 type Signer struct {
@@ -165,7 +175,9 @@ type ChangeTrustAsset struct {
 }
 
 type LiquidityPoolParameters struct {
-	ConstantProduct *LiquidityPoolConstantProductParameters `json:"constant_product,omitempty"`
+	ConstantProduct     *LiquidityPoolConstantProductParameters `json:"constant_product,omitempty"`
+	LiquidityPoolId     string                                  `json:"liquidity_pool_id,omitempty"`
+	LiquidityPoolStrKey string                                  `json:"liquidity_pool_strkey,omitempty"`
 }
 
 type LiquidityPoolConstantProductParameters struct {
@@ -207,9 +219,10 @@ type PathPaymentStrictSendOp struct {
 }
 
 type CreateClaimableBalanceOp struct {
-	Asset     Asset      `json:"asset,omitempty"`
-	Amount    int64      `json:"amount,omitempty"`
-	Claimants []Claimant `json:"claimants,omitempty"`
+	Asset     Asset               `json:"asset,omitempty"`
+	Amount    int64               `json:"amount,omitempty"`
+	Claimants []Claimant          `json:"claimants,omitempty"`
+	BalanceId *ClaimableBalanceId `json:"balance_id,omitempty"`
 }
 
 type Claimant struct {
@@ -235,7 +248,9 @@ type ClaimClaimableBalanceOp struct {
 }
 
 type ClaimableBalanceId struct {
-	V0 *string `json:"v0,omitempty"`
+	V0     *string `json:"v0,omitempty"`
+	Hex    string  `json:"hex,omitempty"`
+	StrKey string  `json:"strkey,omitempty"`
 }
 
 type BeginSponsoringFutureReservesOp struct {
@@ -298,9 +313,11 @@ type LedgerKeyLiquidityPool struct {
 }
 
 type LedgerKeyContractData struct {
-	Contract   ScAddress `json:"contract,omitempty"`
-	Key        ScVal     `json:"key,omitempty"`
-	Durability int32     `json:"durability,omitempty"`
+	Contract       ScAddress `json:"contract,omitempty"`
+	Key            ScVal     `json:"key,omitempty"`
+	Durability     int32     `json:"durability,omitempty"`
+	DurabilityName string    `json:"durability_name,omitempty"`
+	TtlKeyHash     string    `json:"ttl_key_hash,omitempty"`
 }
 
 type ScAddress struct {
@@ -413,16 +430,21 @@ type Int256Parts struct {
 type ScBytes []byte
 
 type ScError struct {
-	ContractCode *uint32 `json:"contract_code,omitempty"`
-	Code         *int32  `json:"code,omitempty"`
+	Type              string  `json:"type"`
+	ContractCode      *uint32 `json:"contract_code,omitempty"`
+	ContractErrorName string  `json:"contract_error_name,omitempty"`
+	Code              *int32  `json:"code,omitempty"`
+	CodeName          string  `json:"code_name,omitempty"`
 }
 
 type LedgerKeyContractCode struct {
-	Hash string `json:"hash,omitempty"`
+	Hash       string `json:"hash,omitempty"`
+	TtlKeyHash string `json:"ttl_key_hash,omitempty"`
 }
 
 type LedgerKeyConfigSetting struct {
-	ConfigSettingId int32 `json:"config_setting_id,omitempty"`
+	ConfigSettingId     int32  `json:"config_setting_id,omitempty"`
+	ConfigSettingIdName string `json:"config_setting_id_name,omitempty"`
 }
 
 type LedgerKeyTtl struct {
@@ -440,10 +462,12 @@ type ClawbackClaimableBalanceOp struct {
 }
 
 type SetTrustLineFlagsOp struct {
-	Trustor    AccountId `json:"trustor,omitempty"`
-	Asset      Asset     `json:"asset,omitempty"`
-	ClearFlags uint32    `json:"clear_flags,omitempty"`
-	SetFlags   uint32    `json:"set_flags,omitempty"`
+	Trustor        AccountId `json:"trustor,omitempty"`
+	Asset          Asset     `json:"asset,omitempty"`
+	ClearFlags     uint32    `json:"clear_flags,omitempty"`
+	ClearFlagNames []string  `json:"clear_flag_names,omitempty"`
+	SetFlags       uint32    `json:"set_flags,omitempty"`
+	SetFlagNames   []string  `json:"set_flag_names,omitempty"`
 }
 
 type LiquidityPoolDepositOp struct {
@@ -511,6 +535,7 @@ type InvokeContractArgsArg struct {
 type CreateContractArgs struct {
 	ContractIdPreimage ContractIdPreimage `json:"contract_id_preimage,omitempty"`
 	Executable         ContractExecutable `json:"executable,omitempty"`
+	ContractId         string             `json:"contract_id,omitempty"`
 }
 
 type ContractIdPreimage struct {
@@ -630,6 +655,7 @@ type TransactionResult struct {
 
 type TransactionResultResult struct {
 	Code            int32                       `json:"code,omitempty"`
+	CodeName        string                      `json:"code_name,omitempty"`
 	InnerResultPair *InnerTransactionResultPair `json:"inner_result_pair,omitempty"`
 	Results         *[]OperationResult          `json:"results,omitempty"`
 }
@@ -646,8 +672,9 @@ type InnerTransactionResult struct {
 }
 
 type InnerTransactionResultResult struct {
-	Code    int32              `json:"code,omitempty"`
-	Results *[]OperationResult `json:"results,omitempty"`
+	Code     int32              `json:"code,omitempty"`
+	CodeName string             `json:"code_name,omitempty"`
+	Results  *[]OperationResult `json:"results,omitempty"`
 }
 
 type InnerTransactionResultExt struct {
@@ -655,11 +682,13 @@ type InnerTransactionResultExt struct {
 }
 
 type OperationResult struct {
-	Code int32              `json:"code,omitempty"`
-	Tr   *OperationResultTr `json:"tr,omitempty"`
+	Code     int32              `json:"code,omitempty"`
+	CodeName string             `json:"code_name,omitempty"`
+	Tr       *OperationResultTr `json:"tr,omitempty"`
 }
 
 type OperationResultTr struct {
+	Type                                string                               `json:"type,omitempty"`
 	CreateAccountResult                 *CreateAccountResult                 `json:"create_account_result,omitempty"`
 	PaymentResult                       *PaymentResult                       `json:"payment_result,omitempty"`
 	PathPaymentStrictReceiveResult      *PathPaymentStrictReceiveResult      `json:"path_payment_strict_receive_result,omitempty"`
@@ -690,61 +719,75 @@ type OperationResultTr struct {
 }
 
 type RestoreFootprintResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ExtendFootprintTtlResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type InvokeHostFunctionResult struct {
-	Code    int32   `json:"code,omitempty"`
-	Success *string `json:"success,omitempty"`
+	Code     int32   `json:"code,omitempty"`
+	CodeName string  `json:"code_name,omitempty"`
+	Success  *string `json:"success,omitempty"`
 }
 
 type LiquidityPoolWithdrawResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type LiquidityPoolDepositResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type SetTrustLineFlagsResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ClawbackClaimableBalanceResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ClawbackResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type RevokeSponsorshipResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type EndSponsoringFutureReservesResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type BeginSponsoringFutureReservesResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ClaimClaimableBalanceResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type CreateClaimableBalanceResult struct {
 	Code      int32               `json:"code,omitempty"`
+	CodeName  string              `json:"code_name,omitempty"`
 	BalanceId *ClaimableBalanceId `json:"balance_id,omitempty"`
 }
 
 type PathPaymentStrictSendResult struct {
 	Code     int32                               `json:"code,omitempty"`
+	CodeName string                              `json:"code_name,omitempty"`
 	Success  *PathPaymentStrictSendResultSuccess `json:"success,omitempty"`
 	NoIssuer *Asset                              `json:"no_issuer,omitempty"`
 }
@@ -755,16 +798,19 @@ type PathPaymentStrictSendResultSuccess struct {
 }
 
 type ManageBuyOfferResult struct {
-	Code    int32                     `json:"code,omitempty"`
-	Success *ManageOfferSuccessResult `json:"success,omitempty"`
+	Code     int32                     `json:"code,omitempty"`
+	CodeName string                    `json:"code_name,omitempty"`
+	Success  *ManageOfferSuccessResult `json:"success,omitempty"`
 }
 
 type BumpSequenceResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ManageDataResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type InflationPayout struct {
@@ -773,30 +819,36 @@ type InflationPayout struct {
 }
 
 type InflationResult struct {
-	Code    int32              `json:"code,omitempty"`
-	Payouts *[]InflationPayout `json:"payouts,omitempty"`
+	Code     int32              `json:"code,omitempty"`
+	CodeName string             `json:"code_name,omitempty"`
+	Payouts  *[]InflationPayout `json:"payouts,omitempty"`
 }
 
 type AccountMergeResult struct {
 	Code                 int32  `json:"code,omitempty"`
+	CodeName             string `json:"code_name,omitempty"`
 	SourceAccountBalance *int64 `json:"source_account_balance,omitempty"`
 }
 
 type AllowTrustResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ChangeTrustResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type SetOptionsResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type ManageSellOfferResult struct {
-	Code    int32                     `json:"code,omitempty"`
-	Success *ManageOfferSuccessResult `json:"success,omitempty"`
+	Code     int32                     `json:"code,omitempty"`
+	CodeName string                    `json:"code_name,omitempty"`
+	Success  *ManageOfferSuccessResult `json:"success,omitempty"`
 }
 
 type ManageOfferSuccessResult struct {
@@ -805,14 +857,15 @@ type ManageOfferSuccessResult struct {
 }
 
 type OfferEntry struct {
-	SellerId AccountId     `json:"seller_id,omitempty"`
-	OfferId  int64         `json:"offer_id,omitempty"`
-	Selling  Asset         `json:"selling,omitempty"`
-	Buying   Asset         `json:"buying,omitempty"`
-	Amount   int64         `json:"amount,omitempty"`
-	Price    Price         `json:"price,omitempty"`
-	Flags    uint32        `json:"flags,omitempty"`
-	Ext      OfferEntryExt `json:"ext,omitempty"`
+	SellerId  AccountId     `json:"seller_id,omitempty"`
+	OfferId   int64         `json:"offer_id,omitempty"`
+	Selling   Asset         `json:"selling,omitempty"`
+	Buying    Asset         `json:"buying,omitempty"`
+	Amount    int64         `json:"amount,omitempty"`
+	Price     Price         `json:"price,omitempty"`
+	Flags     uint32        `json:"flags,omitempty"`
+	FlagNames []string      `json:"flag_names,omitempty"`
+	Ext       OfferEntryExt `json:"ext,omitempty"`
 }
 
 type OfferEntryExt struct {
@@ -820,20 +873,24 @@ type OfferEntryExt struct {
 }
 
 type ManageOfferSuccessResultOffer struct {
-	Effect int32       `json:"effect,omitempty"`
-	Offer  *OfferEntry `json:"offer,omitempty"`
+	Effect     int32       `json:"effect,omitempty"`
+	EffectName string      `json:"effect_name,omitempty"`
+	Offer      *OfferEntry `json:"offer,omitempty"`
 }
 
 type CreateAccountResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type PaymentResult struct {
-	Code int32 `json:"code,omitempty"`
+	Code     int32  `json:"code,omitempty"`
+	CodeName string `json:"code_name,omitempty"`
 }
 
 type PathPaymentStrictReceiveResult struct {
 	Code     int32                                  `json:"code,omitempty"`
+	CodeName string                                 `json:"code_name,omitempty"`
 	Success  *PathPaymentStrictReceiveResultSuccess `json:"success,omitempty"`
 	NoIssuer *Asset                                 `json:"no_issuer,omitempty"`
 }
@@ -888,10 +945,11 @@ type TransactionResultExt struct {
 type LedgerEntryChanges []LedgerEntryChange
 
 type LedgerEntryChange struct {
-	Created *LedgerEntry `json:"created,omitempty"`
-	Updated *LedgerEntry `json:"updated,omitempty"`
-	Removed *LedgerKey   `json:"removed,omitempty"`
-	State   *LedgerEntry `json:"state,omitempty"`
+	Created  *LedgerEntry `json:"created,omitempty"`
+	Updated  *LedgerEntry `json:"updated,omitempty"`
+	Removed  *LedgerKey   `json:"removed,omitempty"`
+	State    *LedgerEntry `json:"state,omitempty"`
+	Restored *LedgerEntry `json:"restored,omitempty"`
 }
 
 type LedgerEntry struct {
@@ -920,6 +978,7 @@ type TtlEntry struct {
 
 type ConfigSettingEntry struct {
 	ConfigSettingId            int32                                  `json:"config_setting_id,omitempty"`
+	ConfigSettingIdName        string                                 `json:"config_setting_id_name,omitempty"`
 	ContractMaxSizeBytes       *uint32                                `json:"contract_max_size_bytes,omitempty"`
 	ContractCompute            *ConfigSettingContractComputeV0        `json:"contract_compute,omitempty"`
 	ContractLedgerCost         *ConfigSettingContractLedgerCostV0     `json:"contract_ledger_cost,omitempty"`
@@ -932,7 +991,7 @@ type ConfigSettingEntry struct {
 	ContractDataEntrySizeBytes *uint32                                `json:"contract_data_entry_size_bytes,omitempty"`
 	StateArchivalSettings      *StateArchivalSettings                 `json:"state_archival_settings,omitempty"`
 	ContractExecutionLanes     *ConfigSettingContractExecutionLanesV0 `json:"contract_execute_lanes,omitempty"`
-	BucketListSizeWindow       *[]uint64                              `json:"bucket_list_size_window,omitempty"`
+	LiveSorobanStateSizeWindow *[]uint64                              `json:"bucket_list_size_window,omitempty"`
 	EvictionIterator           *EvictionIterator                      `json:"eviction_iterator,omitempty"`
 }
 
@@ -1014,11 +1073,12 @@ type ContractCodeEntry struct {
 }
 
 type ContractDataEntry struct {
-	Ext        ExtensionPoint `json:"ext,omitempty"`
-	Contract   ScAddress      `json:"contract,omitempty"`
-	Key        ScVal          `json:"key,omitempty"`
-	Durability int32          `json:"durability,omitempty"` //ContractDataDurability
-	Val        ScVal          `json:"val,omitempty"`
+	Ext            ExtensionPoint `json:"ext,omitempty"`
+	Contract       ScAddress      `json:"contract,omitempty"`
+	Key            ScVal          `json:"key,omitempty"`
+	Durability     int32          `json:"durability,omitempty"` //ContractDataDurability
+	DurabilityName string         `json:"durability_name,omitempty"`
+	Val            ScVal          `json:"val,omitempty"`
 }
 
 type LiquidityPoolEntry struct {
@@ -1052,8 +1112,9 @@ type ClaimableBalanceEntryExt struct {
 }
 
 type ClaimableBalanceEntryExtensionV1 struct {
-	Flags uint32                              `json:"flags,omitempty"`
-	Ext   ClaimableBalanceEntryExtensionV1Ext `json:"ext,omitempty"`
+	Flags     uint32                              `json:"flags,omitempty"`
+	FlagNames []string                            `json:"flag_names,omitempty"`
+	Ext       ClaimableBalanceEntryExtensionV1Ext `json:"ext,omitempty"`
 }
 
 type ClaimableBalanceEntryExtensionV1Ext struct {
@@ -1077,6 +1138,7 @@ type TrustLineEntry struct {
 	Balance   int64             `json:"balance,omitempty"`
 	Limit     int64             `json:"limit,omitempty"`
 	Flags     uint32            `json:"flags,omitempty"`
+	FlagNames []string          `json:"flag_names,omitempty"`
 	Ext       TrustLineEntryExt `json:"ext,omitempty"`
 }
 
@@ -1111,6 +1173,7 @@ type AccountEntry struct {
 	NumSubEntries uint32          `json:"num_sub_entries,omitempty"`
 	InflationDest *AccountId      `json:"inflation_dest,omitempty"`
 	Flags         uint32          `json:"flags,omitempty"`
+	FlagNames     []string        `json:"flag_names,omitempty"`
 	HomeDomain    string          `json:"home_domain,omitempty"`
 	Thresholds    []byte          `json:"thresholds,omitempty"`
 	Signers       []Signer        `json:"signers,omitempty"`
@@ -1175,6 +1238,7 @@ type TransactionMeta struct {
 	V1         *TransactionMetaV1 `json:"v1,omitempty"`
 	V2         *TransactionMetaV2 `json:"v2,omitempty"`
 	V3         *TransactionMetaV3 `json:"v3,omitempty"`
+	V4         *TransactionMetaV4 `json:"v4,omitempty"`
 }
 
 type OperationMeta struct {
@@ -1200,6 +1264,33 @@ type TransactionMetaV3 struct {
 	SorobanMeta     *SorobanTransactionMeta `json:"soroban_meta,omitempty"`
 }
 
+type TransactionMetaV4 struct {
+	Ext              ExtensionPoint            `json:"ext,omitempty"`
+	TxChangesBefore  LedgerEntryChanges        `json:"tx_changes_before,omitempty"`
+	Operations       []OperationMetaV2         `json:"operations,omitempty"`
+	TxChangesAfter   LedgerEntryChanges        `json:"tx_changes_after,omitempty"`
+	SorobanMeta      *SorobanTransactionMetaV2 `json:"soroban_meta,omitempty"`
+	Events           []TransactionEvent        `json:"events,omitempty"`
+	DiagnosticEvents []DiagnosticEvent         `json:"diagnostic_events,omitempty"`
+}
+
+type OperationMetaV2 struct {
+	Ext     ExtensionPoint     `json:"ext,omitempty"`
+	Changes LedgerEntryChanges `json:"changes,omitempty"`
+	Events  []ContractEvent    `json:"events,omitempty"`
+}
+
+type SorobanTransactionMetaV2 struct {
+	Ext         SorobanTransactionMetaExt `json:"ext,omitempty"`
+	ReturnValue *ScVal                    `json:"return_value,omitempty"`
+}
+
+type TransactionEvent struct {
+	Stage     int32         `json:"stage,omitempty"`
+	StageName string        `json:"stage_name,omitempty"`
+	Event     ContractEvent `json:"event,omitempty"`
+}
+
 type SorobanTransactionMeta struct {
 	Ext              SorobanTransactionMetaExt `json:"ext,omitempty"`
 	Events           []ContractEvent           `json:"events,omitempty"`
@@ -1213,14 +1304,15 @@ type DiagnosticEvent struct {
 }
 
 type ContractEvent struct {
-	Ext               ExtensionPoint `json:"ext,omitempty"`
-	ContractId        *string        `json:"contract_id,omitempty"`
-	ContractEventType int32          `json:"contract_event_type,omitempty"`
-	EventType         string         `json:"event_type,omitempty"`
-	Transfer          *TransferEvent `json:"transfer,omitempty"`
-	Mint              *MintEvent     `json:"mint,omitempty"`
-	Clawback          *ClawbackEvent `json:"claw_back,omitempty"`
-	Burn              *BurnEvent     `json:"burn,omitempty"`
+	Ext                   ExtensionPoint `json:"ext,omitempty"`
+	ContractId            *string        `json:"contract_id,omitempty"`
+	ContractEventType     int32          `json:"contract_event_type,omitempty"`
+	ContractEventTypeName string         `json:"contract_event_type_name,omitempty"`
+	EventType             string         `json:"event_type,omitempty"`
+	Transfer              *TransferEvent `json:"transfer,omitempty"`
+	Mint                  *MintEvent     `json:"mint,omitempty"`
+	Clawback              *ClawbackEvent `json:"claw_back,omitempty"`
+	Burn                  *BurnEvent     `json:"burn,omitempty"`
 }
 
 type ContractEventBody struct {
@@ -1256,10 +1348,14 @@ type BurnEvent struct {
 	Amount Int128Parts `json:"amount,omitempty"`
 }
 
+// TransactionResultMeta also holds the TransactionResultMetaV1 of
+// LedgerCloseMeta V2, which adds the fee refunds applied after all
+// transactions.
 type TransactionResultMeta struct {
-	Result            TransactionResultPair `json:"result,omitempty"`
-	FeeProcessing     LedgerEntryChanges    `json:"fee_processing,omitempty"`
-	TxApplyProcessing TransactionMeta       `json:"tx_apply_processing,omitempty"`
+	Result                   TransactionResultPair `json:"result,omitempty"`
+	FeeProcessing            LedgerEntryChanges    `json:"fee_processing,omitempty"`
+	TxApplyProcessing        TransactionMeta       `json:"tx_apply_processing,omitempty"`
+	PostTxApplyFeeProcessing LedgerEntryChanges    `json:"post_tx_apply_fee_processing,omitempty"`
 }
 
 type ContractCodeEntryExt struct {
@@ -1297,3 +1393,121 @@ type SorobanTransactionMetaExtV1 struct {
 	TotalRefundableResourceFeeCharged    int64          `json:"total_refundable_resource_fee_charged,omitempty"`
 	RentFeeCharged                       int64          `json:"rent_fee_charged,omitempty"`
 }
+
+type LedgerHeaderHistoryEntry struct {
+	Hash   string                      `json:"hash,omitempty"`
+	Header LedgerHeader                `json:"header,omitempty"`
+	Ext    LedgerHeaderHistoryEntryExt `json:"ext,omitempty"`
+}
+
+type LedgerHeaderHistoryEntryExt struct {
+	V int32 `json:"v,omitempty"`
+}
+
+type LedgerHeader struct {
+	LedgerVersion      uint32          `json:"ledger_version,omitempty"`
+	PreviousLedgerHash string          `json:"previous_ledger_hash,omitempty"`
+	ScpValue           StellarValue    `json:"scp_value,omitempty"`
+	TxSetResultHash    string          `json:"tx_set_result_hash,omitempty"`
+	BucketListHash     string          `json:"bucket_list_hash,omitempty"`
+	LedgerSeq          uint32          `json:"ledger_seq,omitempty"`
+	TotalCoins         int64           `json:"total_coins,omitempty"`
+	FeePool            int64           `json:"fee_pool,omitempty"`
+	InflationSeq       uint32          `json:"inflation_seq,omitempty"`
+	IdPool             uint64          `json:"id_pool,omitempty"`
+	BaseFee            uint32          `json:"base_fee,omitempty"`
+	BaseReserve        uint32          `json:"base_reserve,omitempty"`
+	MaxTxSetSize       uint32          `json:"max_tx_set_size,omitempty"`
+	SkipList           []string        `json:"skip_list,omitempty"`
+	Ext                LedgerHeaderExt `json:"ext,omitempty"`
+}
+
+type StellarValue struct {
+	TxSetHash string          `json:"tx_set_hash,omitempty"`
+	CloseTime uint64          `json:"close_time,omitempty"`
+	Upgrades  []LedgerUpgrade `json:"upgrades,omitempty"`
+	Ext       StellarValueExt `json:"ext,omitempty"`
+}
+
+type StellarValueExt struct {
+	V                int32                      `json:"v,omitempty"`
+	LcValueSignature *LedgerCloseValueSignature `json:"lc_value_signature,omitempty"`
+}
+
+type LedgerCloseValueSignature struct {
+	NodeId    AccountId `json:"node_id,omitempty"`
+	Signature []byte    `json:"signature,omitempty"`
+}
+
+type LedgerUpgrade struct {
+	Type                   int32                `json:"type,omitempty"`
+	TypeName               string               `json:"type_name,omitempty"`
+	NewLedgerVersion       *uint32              `json:"new_ledger_version,omitempty"`
+	NewBaseFee             *uint32              `json:"new_base_fee,omitempty"`
+	NewMaxTxSetSize        *uint32              `json:"new_max_tx_set_size,omitempty"`
+	NewBaseReserve         *uint32              `json:"new_base_reserve,omitempty"`
+	NewFlags               *uint32              `json:"new_flags,omitempty"`
+	NewConfig              *ConfigUpgradeSetKey `json:"new_config,omitempty"`
+	NewMaxSorobanTxSetSize *uint32              `json:"new_max_soroban_tx_set_size,omitempty"`
+}
+
+type ConfigUpgradeSetKey struct {
+	ContractId  string `json:"contract_id,omitempty"`
+	ContentHash string `json:"content_hash,omitempty"`
+}
+
+type LedgerHeaderExt struct {
+	V  int32                    `json:"v,omitempty"`
+	V1 *LedgerHeaderExtensionV1 `json:"v1,omitempty"`
+}
+
+type LedgerHeaderExtensionV1 struct {
+	Flags     uint32                     `json:"flags,omitempty"`
+	FlagNames []string                   `json:"flag_names,omitempty"`
+	Ext       LedgerHeaderExtensionV1Ext `json:"ext,omitempty"`
+}
+
+type LedgerHeaderExtensionV1Ext struct {
+	V int32 `json:"v,omitempty"`
+}
+
+// LedgerCloseMeta holds the meta of every version. EvictedKeys are the keys
+// of the temporary and, since protocol 23, persistent entries evicted in the
+// ledger.
+type LedgerCloseMeta struct {
+	V                         int32                    `json:"v,omitempty"`
+	Ext                       *LedgerCloseMetaExt      `json:"ext,omitempty"`
+	LedgerHeader              LedgerHeaderHistoryEntry `json:"ledger_header,omitempty"`
+	TxSet                     []TransactionEnvelope    `json:"tx_set,omitempty"`
+	TxProcessing              []TransactionResultMeta  `json:"tx_processing,omitempty"`
+	UpgradesProcessing        []UpgradeEntryMeta       `json:"upgrades_processing,omitempty"`
+	TotalByteSizeOfBucketList uint64                   `json:"total_byte_size_of_bucket_list,omitempty"`
+	EvictedKeys               []LedgerKey              `json:"evicted_keys,omitempty"`
+}
+
+type LedgerCloseMetaExt struct {
+	V                  int32  `json:"v,omitempty"`
+	SorobanFeeWrite1Kb *int64 `json:"soroban_fee_write_1kb,omitempty"`
+}
+
+type UpgradeEntryMeta struct {
+	Upgrade LedgerUpgrade      `json:"upgrade,omitempty"`
+	Changes LedgerEntryChanges `json:"changes,omitempty"`
+}
+
+type BucketEntry struct {
+	Type      int32           `json:"type"`
+	TypeName  string          `json:"type_name,omitempty"`
+	LiveEntry *LedgerEntry    `json:"live_entry,omitempty"`
+	DeadEntry *LedgerKey      `json:"dead_entry,omitempty"`
+	MetaEntry *BucketMetadata `json:"meta_entry,omitempty"`
+}
+
+type BucketMetadata struct {
+	LedgerVersion uint32            `json:"ledger_version,omitempty"`
+	Ext           BucketMetadataExt `json:"ext,omitempty"`
+}
+
+type BucketMetadataExt struct {
+	V int32 `json:"v,omitempty"`
+}
@@ -0,0 +1,118 @@
+package flatten
+
+import (
+	"encoding/csv"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+// CSVWriter writes a <table>.csv file with a header row per table.
+type CSVWriter struct {
+	files  map[string]*os.File
+	tables map[string]*csv.Writer
+}
+
+func NewCSVWriter(dir string) (*CSVWriter, error) {
+	files, err := createTableFiles(dir, ".csv")
+	if err != nil {
+		return nil, err
+	}
+
+	w := &CSVWriter{files: files, tables: make(map[string]*csv.Writer, len(files))}
+	headers := map[string][]string{
+		TableTransactions:        Columns(TransactionRow{}),
+		TableOperations:          Columns(OperationRow{}),
+		TableOperationResults:    Columns(OperationResultRow{}),
+		TableEffects:             Columns(EffectRow{}),
+		TableLedgerEntryChanges:  Columns(LedgerEntryChangeRow{}),
+		TableContractEvents:      Columns(ContractEventRow{}),
+		TableContractDataChanges: Columns(ContractDataChangeRow{}),
+	}
+	for table, f := range files {
+		cw := csv.NewWriter(f)
+		if err := cw.Write(headers[table]); err != nil {
+			closeFiles(files)
+			return nil, err
+		}
+		w.tables[table] = cw
+	}
+
+	return w, nil
+}
+
+func (w *CSVWriter) Write(rows *Rows) error {
+	if err := writeCSV(w.tables[TableTransactions], rows.Transactions); err != nil {
+		return err
+	}
+	if err := writeCSV(w.tables[TableOperations], rows.Operations); err != nil {
+		return err
+	}
+	if err := writeCSV(w.tables[TableOperationResults], rows.OperationResults); err != nil {
+		return err
+	}
+	if err := writeCSV(w.tables[TableEffects], rows.Effects); err != nil {
+		return err
+	}
+	if err := writeCSV(w.tables[TableLedgerEntryChanges], rows.LedgerEntryChanges); err != nil {
+		return err
+	}
+	if err := writeCSV(w.tables[TableContractEvents], rows.ContractEvents); err != nil {
+		return err
+	}
+	return writeCSV(w.tables[TableContractDataChanges], rows.ContractDataChanges)
+}
+
+func (w *CSVWriter) Close() error {
+	var first error
+	for _, cw := range w.tables {
+		cw.Flush()
+		if err := cw.Error(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	if err := closeFiles(w.files); err != nil && first == nil {
+		first = err
+	}
+
+	return first
+}
+
+func writeCSV[T any](w *csv.Writer, rows []T) error {
+	var record []string
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		record = record[:0]
+		for i := 0; i < v.NumField(); i++ {
+			record = append(record, csvField(v.Field(i)))
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// csvField formats a column value. NULL is written as an empty field.
+func csvField(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	}
+
+	return ""
+}
@@ -0,0 +1,134 @@
+package flatten
+
+import (
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+// Effect types. Balance effects carry the absolute change in Amount.
+const (
+	EffectAccountCreated   = "account_created"
+	EffectAccountRemoved   = "account_removed"
+	EffectAccountCredited  = "account_credited"
+	EffectAccountDebited   = "account_debited"
+	EffectTrustlineCreated = "trustline_created"
+	EffectTrustlineRemoved = "trustline_removed"
+)
+
+// addEffects derives the effects of an operation from its ledger entry
+// changes: accounts and trustlines created or removed, and balance changes
+// between an entry's STATE and UPDATED forms. Fees are not effects.
+func (r *Rows) addEffects(tx Transaction, hash string, opIndex int, opId int64, changes xdr.LedgerEntryChanges) error {
+	var index int32
+	add := func(typ string, account xdr.AccountId, asset *string, amount *int64) error {
+		address, err := converter.ConvertAccountId(account)
+		if err != nil {
+			return err
+		}
+
+		r.Effects = append(r.Effects, EffectRow{
+			OperationId:     opId,
+			LedgerSeq:       int64(tx.LedgerSeq),
+			TransactionHash: hash,
+			OpIndex:         int32(opIndex),
+			EffectIndex:     index,
+			Type:            typ,
+			Account:         address.Address,
+			Asset:           asset,
+			Amount:          amount,
+		})
+		index++
+
+		return nil
+	}
+
+	native := str("native")
+	states := make(map[string]xdr.LedgerEntry)
+	for _, change := range changes {
+		key, err := change.LedgerKey()
+		if err != nil {
+			return err
+		}
+		keyBytes, err := key.MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			states[string(keyBytes)] = *change.State
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			data := change.Created.Data
+			switch data.Type {
+			case xdr.LedgerEntryTypeAccount:
+				balance := int64(data.Account.Balance)
+				err = add(EffectAccountCreated, data.Account.AccountId, native, &balance)
+			case xdr.LedgerEntryTypeTrustline:
+				var asset *string
+				if asset, err = trustLineAssetColumn(data.TrustLine.Asset); err == nil {
+					err = add(EffectTrustlineCreated, data.TrustLine.AccountId, asset, nil)
+				}
+			}
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			before, ok := states[string(keyBytes)]
+			if !ok {
+				continue
+			}
+
+			data := change.Updated.Data
+			switch data.Type {
+			case xdr.LedgerEntryTypeAccount:
+				delta := int64(data.Account.Balance - before.Data.Account.Balance)
+				err = addBalanceEffect(add, data.Account.AccountId, native, delta)
+			case xdr.LedgerEntryTypeTrustline:
+				var asset *string
+				if asset, err = trustLineAssetColumn(data.TrustLine.Asset); err == nil {
+					delta := int64(data.TrustLine.Balance - before.Data.TrustLine.Balance)
+					err = addBalanceEffect(add, data.TrustLine.AccountId, asset, delta)
+				}
+			}
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			switch key.Type {
+			case xdr.LedgerEntryTypeAccount:
+				err = add(EffectAccountRemoved, key.Account.AccountId, nil, nil)
+			case xdr.LedgerEntryTypeTrustline:
+				var asset *string
+				if asset, err = trustLineAssetColumn(key.TrustLine.Asset); err == nil {
+					err = add(EffectTrustlineRemoved, key.TrustLine.AccountId, asset, nil)
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addBalanceEffect(add func(string, xdr.AccountId, *string, *int64) error, account xdr.AccountId, asset *string, delta int64) error {
+	switch {
+	case delta > 0:
+		return add(EffectAccountCredited, account, asset, &delta)
+	case delta < 0:
+		amount := -delta
+		return add(EffectAccountDebited, account, asset, &amount)
+	}
+
+	return nil
+}
+
+// trustLineAssetColumn returns the canonical form of a trustline's asset,
+// the pool id for pool shares.
+func trustLineAssetColumn(a xdr.TrustLineAsset) (*string, error) {
+	if a.Type == xdr.AssetTypeAssetTypePoolShare {
+		return str(converter.ConvertPoolShareAsset(*a.LiquidityPoolId).Canonical), nil
+	}
+
+	asset, err := converter.ConvertAsset(a.ToAsset())
+	if err != nil {
+		return nil, err
+	}
+
+	return str(asset.Canonical), nil
+}
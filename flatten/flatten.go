@@ -0,0 +1,767 @@
+// Package flatten turns transactions with their results and meta into rows of
+// fixed-schema tables, for loading into column stores that cannot query the
+// nested JSON of the converter package.
+//
+// Values are taken from the converter's output without enum names; the type
+// and code columns name their enums straight from the XDR.
+package flatten
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/archive"
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+)
+
+// Transaction is a transaction with everything its rows are built from.
+// FeeChanges and Meta are empty for sources without meta, such as history
+// archives, which then produce no change or event rows. FeeRefundChanges are
+// the fee refunds LedgerCloseMeta V2 applies after all transactions.
+type Transaction struct {
+	LedgerSeq uint32
+	CloseTime uint64
+	// Index is the 1-based application order in the ledger.
+	Index            uint32
+	Envelope         xdr.TransactionEnvelope
+	Result           xdr.TransactionResultPair
+	FeeChanges       xdr.LedgerEntryChanges
+	Meta             *xdr.TransactionMeta
+	FeeRefundChanges xdr.LedgerEntryChanges
+	// NetworkPassphrase identifies the network of the transaction, which
+	// contract IDs are derived on.
+	NetworkPassphrase string
+}
+
+// LedgerCloseMetaTransactions returns the transactions of a ledger in
+// application order, matching envelopes to results by their hash on the
+// network identified by passphrase. An empty passphrase means
+// converter.DefaultNetworkPassphrase.
+func LedgerCloseMetaTransactions(m xdr.LedgerCloseMeta, passphrase string) ([]Transaction, error) {
+	passphrase = converter.PassphraseOrDefault(passphrase)
+
+	var (
+		header       xdr.LedgerHeaderHistoryEntry
+		envelopes    []xdr.TransactionEnvelope
+		txProcessing []xdr.TransactionResultMetaV1
+		err          error
+	)
+
+	switch m.V {
+	case 0:
+		header = m.V0.LedgerHeader
+		envelopes = m.V0.TxSet.Txs
+		txProcessing = resultMetaV1(m.V0.TxProcessing)
+	case 1:
+		header = m.V1.LedgerHeader
+		envelopes, err = converter.GeneralizedTransactionSetEnvelopes(m.V1.TxSet)
+		txProcessing = resultMetaV1(m.V1.TxProcessing)
+	case 2:
+		header = m.V2.LedgerHeader
+		envelopes, err = converter.GeneralizedTransactionSetEnvelopes(m.V2.TxSet)
+		txProcessing = m.V2.TxProcessing
+	default:
+		return nil, errors.Errorf("error invalid LedgerCloseMeta version %v", m.V)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seq := uint32(header.Header.LedgerSeq)
+	byHash := make(map[xdr.Hash]xdr.TransactionEnvelope, len(envelopes))
+	for _, env := range envelopes {
+		hash, err := network.HashTransactionInEnvelope(env, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		byHash[hash] = env
+	}
+
+	var result []Transaction
+	for i, processing := range txProcessing {
+		env, ok := byHash[processing.Result.TransactionHash]
+		if !ok {
+			return nil, errors.Errorf("error ledger %d has a result for unknown transaction %s, check the network passphrase", seq, processing.Result.TransactionHash.HexString())
+		}
+
+		meta := processing.TxApplyProcessing
+		result = append(result, Transaction{
+			LedgerSeq:         seq,
+			CloseTime:         uint64(header.Header.ScpValue.CloseTime),
+			Index:             uint32(i + 1),
+			Envelope:          env,
+			Result:            processing.Result,
+			FeeChanges:        processing.FeeProcessing,
+			Meta:              &meta,
+			FeeRefundChanges:  processing.PostTxApplyFeeProcessing,
+			NetworkPassphrase: passphrase,
+		})
+	}
+
+	return result, nil
+}
+
+// resultMetaV1 lifts the result meta of LedgerCloseMeta V0 and V1 to the V2
+// form, which only adds the fee refunds.
+func resultMetaV1(metas []xdr.TransactionResultMeta) []xdr.TransactionResultMetaV1 {
+	result := make([]xdr.TransactionResultMetaV1, 0, len(metas))
+	for _, m := range metas {
+		result = append(result, xdr.TransactionResultMetaV1{
+			Result:            m.Result,
+			FeeProcessing:     m.FeeProcessing,
+			TxApplyProcessing: m.TxApplyProcessing,
+		})
+	}
+
+	return result
+}
+
+// ArchiveTransactions returns the transactions of a history archive ledger of
+// the network identified by passphrase, or converter.DefaultNetworkPassphrase
+// when it is empty.
+func ArchiveTransactions(l archive.LedgerXdr, passphrase string) []Transaction {
+	passphrase = converter.PassphraseOrDefault(passphrase)
+
+	var result []Transaction
+	for i, tx := range l.Transactions {
+		result = append(result, Transaction{
+			LedgerSeq:         uint32(l.Header.Header.LedgerSeq),
+			CloseTime:         uint64(l.Header.Header.ScpValue.CloseTime),
+			Index:             uint32(i + 1),
+			Envelope:          tx.Envelope,
+			Result:            tx.Result,
+			NetworkPassphrase: passphrase,
+		})
+	}
+
+	return result
+}
+
+// Add appends the rows of tx to every table.
+func (r *Rows) Add(tx Transaction) error {
+	hash := tx.Result.TransactionHash.HexString()
+
+	txId, err := converter.TransactionToid(tx.LedgerSeq, tx.Index)
+	if err != nil {
+		return err
+	}
+
+	txRow, err := transactionRow(tx, txId, hash)
+	if err != nil {
+		return errors.Wrapf(err, "error flattening transaction %s", hash)
+	}
+	r.Transactions = append(r.Transactions, txRow)
+
+	opResults, _ := tx.Result.OperationResults()
+	for i, op := range tx.Envelope.Operations() {
+		opId, err := converter.OperationToid(tx.LedgerSeq, tx.Index, uint32(i+1))
+		if err != nil {
+			return err
+		}
+
+		opRow, err := operationRow(tx, op, i, opId, txId, hash)
+		if err != nil {
+			return errors.Wrapf(err, "error flattening operation %d of transaction %s", i, hash)
+		}
+		r.Operations = append(r.Operations, opRow)
+
+		if i < len(opResults) {
+			resultRow, err := operationResultRow(tx, opResults[i], op.Body.Type, i, opId, hash)
+			if err != nil {
+				return errors.Wrapf(err, "error flattening result %d of transaction %s", i, hash)
+			}
+			r.OperationResults = append(r.OperationResults, resultRow)
+		}
+	}
+
+	if err := r.addChanges(tx, hash, stageFee, nil, tx.FeeChanges); err != nil {
+		return err
+	}
+
+	if tx.Meta == nil {
+		return nil
+	}
+
+	before, operations, after, events, err := metaParts(*tx.Meta)
+	if err != nil {
+		return errors.Wrapf(err, "error flattening meta of transaction %s", hash)
+	}
+	if err := r.addChanges(tx, hash, stageBefore, nil, before); err != nil {
+		return err
+	}
+	for i, changes := range operations {
+		opIndex := int32(i)
+		if err := r.addChanges(tx, hash, stageOperation, &opIndex, changes); err != nil {
+			return err
+		}
+
+		opId, err := converter.OperationToid(tx.LedgerSeq, tx.Index, uint32(i+1))
+		if err != nil {
+			return err
+		}
+		if err := r.addEffects(tx, hash, i, opId, changes); err != nil {
+			return errors.Wrapf(err, "error deriving effects of operation %d of transaction %s", i, hash)
+		}
+	}
+	if err := r.addChanges(tx, hash, stageAfter, nil, after); err != nil {
+		return err
+	}
+	if err := r.addChanges(tx, hash, stageFeeRefund, nil, tx.FeeRefundChanges); err != nil {
+		return err
+	}
+
+	for i, event := range events {
+		row, err := contractEventRow(tx, event, i, hash)
+		if err != nil {
+			return errors.Wrapf(err, "error flattening event %d of transaction %s", i, hash)
+		}
+		r.ContractEvents = append(r.ContractEvents, row)
+	}
+
+	return nil
+}
+
+const (
+	stageFee       = "fee"
+	stageBefore    = "before"
+	stageOperation = "operation"
+	stageAfter     = "after"
+	stageFeeRefund = "fee_refund"
+)
+
+// metaParts splits the meta of any version into the changes before and after
+// the operations, the per operation changes and the contract events. The
+// events of V4 are those of its operations, in operation order; transaction
+// level events such as fee events are not included.
+func metaParts(m xdr.TransactionMeta) (before xdr.LedgerEntryChanges, operations []xdr.LedgerEntryChanges, after xdr.LedgerEntryChanges, events []xdr.ContractEvent, err error) {
+	switch m.V {
+	case 0:
+		operations = operationChanges(*m.Operations)
+	case 1:
+		before, operations = m.V1.TxChanges, operationChanges(m.V1.Operations)
+	case 2:
+		before, operations, after = m.V2.TxChangesBefore, operationChanges(m.V2.Operations), m.V2.TxChangesAfter
+	case 3:
+		before, operations, after = m.V3.TxChangesBefore, operationChanges(m.V3.Operations), m.V3.TxChangesAfter
+		if m.V3.SorobanMeta != nil {
+			events = m.V3.SorobanMeta.Events
+		}
+	case 4:
+		before, after = m.V4.TxChangesBefore, m.V4.TxChangesAfter
+		for _, op := range m.V4.Operations {
+			operations = append(operations, op.Changes)
+			events = append(events, op.Events...)
+		}
+	default:
+		err = errors.Errorf("error invalid TransactionMeta type %v", m.V)
+	}
+
+	return before, operations, after, events, err
+}
+
+func operationChanges(ops []xdr.OperationMeta) []xdr.LedgerEntryChanges {
+	var result []xdr.LedgerEntryChanges
+	for _, op := range ops {
+		result = append(result, op.Changes)
+	}
+
+	return result
+}
+
+func transactionRow(tx Transaction, id int64, hash string) (TransactionRow, error) {
+	env := tx.Envelope
+	result := TransactionRow{
+		Id:               id,
+		LedgerSeq:        int64(tx.LedgerSeq),
+		CloseTime:        int64(tx.CloseTime),
+		ApplicationOrder: int32(tx.Index),
+		Hash:             hash,
+		EnvelopeType:     converter.XdrEnumName(env.Type),
+		MaxFee:           int64(env.Fee()),
+		FeeCharged:       int64(tx.Result.Result.FeeCharged),
+		SeqNum:           env.SeqNum(),
+		OperationCount:   int32(len(env.Operations())),
+		Successful:       tx.Result.Result.Successful(),
+		ResultCode:       int32(tx.Result.Result.Result.Code),
+		ResultCodeName:   converter.XdrEnumName(tx.Result.Result.Result.Code),
+	}
+
+	source, err := converter.ConvertMuxedAccount(env.SourceAccount())
+	if err != nil {
+		return result, err
+	}
+	result.SourceAccount, result.SourceAccountMuxed = muxedColumns(source)
+
+	if env.IsFeeBump() {
+		feeSource, err := converter.ConvertMuxedAccount(env.FeeBumpAccount())
+		if err != nil {
+			return result, err
+		}
+		result.FeeAccount = &feeSource.AccountId
+		result.MaxFee = env.FeeBumpFee()
+
+		if inner, ok := tx.Result.Result.Result.GetInnerResultPair(); ok {
+			innerHash := inner.TransactionHash.HexString()
+			result.InnerHash = &innerHash
+		}
+	}
+
+	memo := env.Memo()
+	result.MemoType = converter.XdrEnumName(memo.Type)
+	converted, err := converter.ConvertMemo(memo)
+	if err != nil {
+		return result, err
+	}
+	result.Memo = memoColumn(converted)
+
+	if data, ok := sorobanData(env); ok {
+		resourceFee := int64(data.ResourceFee)
+		instructions := int64(data.Resources.Instructions)
+		readBytes := int64(data.Resources.DiskReadBytes)
+		writeBytes := int64(data.Resources.WriteBytes)
+		result.ResourceFee = &resourceFee
+		result.Instructions = &instructions
+		result.ReadBytes = &readBytes
+		result.WriteBytes = &writeBytes
+	}
+
+	return result, nil
+}
+
+func sorobanData(env xdr.TransactionEnvelope) (xdr.SorobanTransactionData, bool) {
+	switch env.Type {
+	case xdr.EnvelopeTypeEnvelopeTypeTx:
+		return env.V1.Tx.Ext.GetSorobanData()
+	case xdr.EnvelopeTypeEnvelopeTypeTxFeeBump:
+		return env.FeeBump.Tx.InnerTx.V1.Tx.Ext.GetSorobanData()
+	}
+
+	return xdr.SorobanTransactionData{}, false
+}
+
+func muxedColumns(a converter.MuxedAccount) (string, *string) {
+	if !a.IsMuxed {
+		return a.AccountId, nil
+	}
+
+	address := a.Address
+	return a.AccountId, &address
+}
+
+// optionalMuxedColumns is muxedColumns for the columns an operation type may
+// leave empty.
+func optionalMuxedColumns(a converter.MuxedAccount) (*string, *string) {
+	account, muxed := muxedColumns(a)
+	return &account, muxed
+}
+
+func memoColumn(m converter.Memo) *string {
+	switch {
+	case m.Text != nil:
+		return m.Text
+	case m.Id != nil:
+		id := big.NewInt(0).SetUint64(*m.Id).String()
+		return &id
+	case m.Hash != nil:
+		return m.Hash
+	case m.RetHash != nil:
+		return m.RetHash
+	}
+
+	return nil
+}
+
+func operationRow(tx Transaction, op xdr.Operation, index int, id int64, txId int64, hash string) (OperationRow, error) {
+	result := OperationRow{
+		Id:              id,
+		TransactionId:   txId,
+		LedgerSeq:       int64(tx.LedgerSeq),
+		TransactionHash: hash,
+		OpIndex:         int32(index),
+		Type:            converter.XdrEnumName(op.Body.Type),
+	}
+
+	source := tx.Envelope.SourceAccount()
+	if op.SourceAccount != nil {
+		source = *op.SourceAccount
+	}
+	sourceAccount, err := converter.ConvertMuxedAccount(source)
+	if err != nil {
+		return result, err
+	}
+	result.SourceAccount, result.SourceAccountMuxed = muxedColumns(sourceAccount)
+
+	body, err := converter.ConvertOperationBodyWithOptions(op.Body, converter.Options{NetworkPassphrase: tx.NetworkPassphrase})
+	if err != nil {
+		return result, err
+	}
+
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		return result, err
+	}
+	result.BodyJson = string(bodyJson)
+
+	setOperationColumns(&result, body)
+
+	return result, nil
+}
+
+// setOperationColumns fills the columns shared by several operation types.
+func setOperationColumns(r *OperationRow, b converter.OperationBody) {
+	switch {
+	case b.CreateAccountOp != nil:
+		r.Destination = str(b.CreateAccountOp.Destination.Address)
+		r.Asset = str("native")
+		r.Amount = &b.CreateAccountOp.StartingBalance
+	case b.PaymentOp != nil:
+		r.Destination, r.DestinationMuxed = optionalMuxedColumns(b.PaymentOp.Destination)
+		r.Asset = str(b.PaymentOp.Asset.Canonical)
+		r.Amount = &b.PaymentOp.Amount
+	case b.PathPaymentStrictReceiveOp != nil:
+		op := b.PathPaymentStrictReceiveOp
+		r.Destination, r.DestinationMuxed = optionalMuxedColumns(op.Destination)
+		r.Asset = str(op.DestAsset.Canonical)
+		r.Amount = &op.DestAmount
+		r.SourceAsset = str(op.SendAsset.Canonical)
+		r.SourceAmount = &op.SendMax
+	case b.PathPaymentStrictSendOp != nil:
+		op := b.PathPaymentStrictSendOp
+		r.Destination, r.DestinationMuxed = optionalMuxedColumns(op.Destination)
+		r.Asset = str(op.DestAsset.Canonical)
+		r.Amount = &op.DestMin
+		r.SourceAsset = str(op.SendAsset.Canonical)
+		r.SourceAmount = &op.SendAmount
+	case b.ManageSellOfferOp != nil:
+		op := b.ManageSellOfferOp
+		r.SellingAsset = str(op.Selling.Canonical)
+		r.BuyingAsset = str(op.Buying.Canonical)
+		r.Amount = &op.BuyAmount
+		r.PriceN, r.PriceD = &op.Price.N, &op.Price.D
+		r.OfferId = &op.OfferId
+	case b.CreatePassiveSellOfferOp != nil:
+		op := b.CreatePassiveSellOfferOp
+		r.SellingAsset = str(op.Selling.Canonical)
+		r.BuyingAsset = str(op.Buying.Canonical)
+		r.Amount = &op.Amount
+		r.PriceN, r.PriceD = &op.Price.N, &op.Price.D
+	case b.ManageBuyOfferOp != nil:
+		op := b.ManageBuyOfferOp
+		r.SellingAsset = str(op.Selling.Canonical)
+		r.BuyingAsset = str(op.Buying.Canonical)
+		r.Amount = &op.BuyAmount
+		r.PriceN, r.PriceD = &op.Price.N, &op.Price.D
+		r.OfferId = &op.OfferId
+	case b.ChangeTrustOp != nil:
+		op := b.ChangeTrustOp
+		if op.Line.Asset != nil {
+			r.Asset = str(op.Line.Asset.Canonical)
+		}
+		if op.Line.LiquidityPool != nil {
+			r.LiquidityPoolId = str(op.Line.LiquidityPool.LiquidityPoolId)
+		}
+		r.Amount = &op.Limit
+	case b.AllowTrustOp != nil:
+		r.Destination = str(b.AllowTrustOp.Trustor.Address)
+	case b.Destination != nil:
+		r.Destination, r.DestinationMuxed = optionalMuxedColumns(*b.Destination)
+	case b.CreateClaimableBalanceOp != nil:
+		op := b.CreateClaimableBalanceOp
+		r.Asset = str(op.Asset.Canonical)
+		r.Amount = &op.Amount
+		if op.BalanceId != nil {
+			r.BalanceId = str(op.BalanceId.Hex)
+		}
+	case b.ClaimClaimableBalanceOp != nil:
+		r.BalanceId = str(b.ClaimClaimableBalanceOp.BalanceId.Hex)
+	case b.ClawbackOp != nil:
+		op := b.ClawbackOp
+		r.From, r.FromMuxed = optionalMuxedColumns(op.From)
+		r.Asset = str(op.Asset.Canonical)
+		r.Amount = &op.Amount
+	case b.LiquidityPoolDepositOp != nil:
+		r.LiquidityPoolId = str(hex.EncodeToString(b.LiquidityPoolDepositOp.LiquidityPoolId))
+	case b.LiquidityPoolWithdrawOp != nil:
+		op := b.LiquidityPoolWithdrawOp
+		r.LiquidityPoolId = str(hex.EncodeToString(op.LiquidityPoolId))
+		r.Amount = &op.Amount
+	case b.InvokeHostFunctionOp != nil:
+		fn := b.InvokeHostFunctionOp.HostFunction
+		if fn.InvokeContract != nil {
+			r.ContractId = fn.InvokeContract.ContractAddress.ContractId
+			r.FunctionName = str(string(fn.InvokeContract.FunctionName))
+		}
+		if fn.CreateContract != nil {
+			r.ContractId = str(fn.CreateContract.ContractId)
+		}
+	}
+}
+
+func operationResultRow(tx Transaction, res xdr.OperationResult, opType xdr.OperationType, index int, opId int64, hash string) (OperationResultRow, error) {
+	result := OperationResultRow{
+		OperationId:     opId,
+		LedgerSeq:       int64(tx.LedgerSeq),
+		TransactionHash: hash,
+		OpIndex:         int32(index),
+		Type:            converter.XdrEnumName(opType),
+		Code:            int32(res.Code),
+		CodeName:        converter.XdrEnumName(res.Code),
+	}
+
+	if res.Tr == nil {
+		return result, nil
+	}
+
+	code, name, successful := innerResultCode(*res.Tr)
+	result.ResultCode = &code
+	result.ResultCodeName = &name
+	result.Successful = res.Code == xdr.OperationResultCodeOpInner && successful
+
+	converted, err := converter.ConvertOperationResultTr(*res.Tr)
+	if err != nil {
+		return result, err
+	}
+
+	bz, err := json.Marshal(converted)
+	if err != nil {
+		return result, err
+	}
+	result.ResultJson = str(string(bz))
+
+	return result, nil
+}
+
+// innerResultCode returns the code of an operation type's result, taken from
+// the Code field every result union switches on. Success codes are the ones
+// whose XDR name ends in SUCCESS.
+func innerResultCode(tr xdr.OperationResultTr) (int32, string, bool) {
+	arm, ok := tr.ArmForSwitch(int32(tr.Type))
+	if !ok {
+		return 0, "", false
+	}
+
+	value := reflect.ValueOf(tr).FieldByName(arm)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return 0, "", false
+	}
+
+	code := value.Elem().FieldByName("Code")
+	if !code.IsValid() {
+		return 0, "", false
+	}
+
+	stringer, ok := code.Interface().(fmt.Stringer)
+	if !ok {
+		return 0, "", false
+	}
+
+	name := converter.XdrEnumName(stringer)
+	return int32(code.Int()), name, strings.HasSuffix(name, "SUCCESS")
+}
+
+func (r *Rows) addChanges(tx Transaction, hash string, stage string, opIndex *int32, changes xdr.LedgerEntryChanges) error {
+	for i, change := range changes {
+		row, err := ledgerEntryChangeRow(tx, change, stage, opIndex, i, hash)
+		if err != nil {
+			return errors.Wrapf(err, "error flattening %s change %d of transaction %s", stage, i, hash)
+		}
+		r.LedgerEntryChanges = append(r.LedgerEntryChanges, row)
+
+		key, err := change.LedgerKey()
+		if err != nil {
+			return err
+		}
+		if key.Type != xdr.LedgerEntryTypeContractData {
+			continue
+		}
+
+		dataRow, err := contractDataChangeRow(row, change, *key.ContractData)
+		if err != nil {
+			return errors.Wrapf(err, "error flattening %s change %d of transaction %s", stage, i, hash)
+		}
+		r.ContractDataChanges = append(r.ContractDataChanges, dataRow)
+	}
+
+	return nil
+}
+
+func ledgerEntryChangeRow(tx Transaction, change xdr.LedgerEntryChange, stage string, opIndex *int32, index int, hash string) (LedgerEntryChangeRow, error) {
+	result := LedgerEntryChangeRow{
+		LedgerSeq:       int64(tx.LedgerSeq),
+		TransactionHash: hash,
+		Stage:           stage,
+		OpIndex:         opIndex,
+		ChangeIndex:     int32(index),
+		ChangeType:      converter.XdrEnumName(change.Type),
+	}
+
+	key, err := change.LedgerKey()
+	if err != nil {
+		return result, err
+	}
+	result.EntryType = converter.XdrEnumName(key.Type)
+
+	convertedKey, err := converter.ConvertLedgerKey(key)
+	if err != nil {
+		return result, err
+	}
+	keyJson, err := json.Marshal(convertedKey)
+	if err != nil {
+		return result, err
+	}
+	result.KeyJson = str(string(keyJson))
+
+	if change.Type == xdr.LedgerEntryChangeTypeLedgerEntryRemoved {
+		return result, nil
+	}
+
+	entry, _ := change.GetLedgerEntry()
+	lastModified := int64(entry.LastModifiedLedgerSeq)
+	result.LastModifiedLedgerSeq = &lastModified
+
+	converted, err := converter.ConvertLedgerEntry(entry)
+	if err != nil {
+		return result, err
+	}
+	entryJson, err := json.Marshal(converted)
+	if err != nil {
+		return result, err
+	}
+	result.EntryJson = str(string(entryJson))
+
+	return result, nil
+}
+
+func contractDataChangeRow(row LedgerEntryChangeRow, change xdr.LedgerEntryChange, key xdr.LedgerKeyContractData) (ContractDataChangeRow, error) {
+	result := ContractDataChangeRow{
+		LedgerSeq:       row.LedgerSeq,
+		TransactionHash: row.TransactionHash,
+		Stage:           row.Stage,
+		OpIndex:         row.OpIndex,
+		ChangeIndex:     row.ChangeIndex,
+		ChangeType:      row.ChangeType,
+		Durability:      converter.XdrEnumName(key.Durability),
+	}
+
+	contractId, err := key.Contract.String()
+	if err != nil {
+		return result, err
+	}
+	result.ContractId = contractId
+
+	keyVal, err := converter.ConvertScVal(key.Key)
+	if err != nil {
+		return result, err
+	}
+	keyJson, err := json.Marshal(keyVal)
+	if err != nil {
+		return result, err
+	}
+	result.KeyJson = string(keyJson)
+	result.KeyXdr, err = xdr.MarshalBase64(key.Key)
+	if err != nil {
+		return result, err
+	}
+
+	if change.Type == xdr.LedgerEntryChangeTypeLedgerEntryRemoved {
+		return result, nil
+	}
+
+	entry, _ := change.GetLedgerEntry()
+	val, err := converter.ConvertScVal(entry.Data.MustContractData().Val)
+	if err != nil {
+		return result, err
+	}
+	valJson, err := json.Marshal(val)
+	if err != nil {
+		return result, err
+	}
+	result.ValueJson = str(string(valJson))
+
+	return result, nil
+}
+
+func contractEventRow(tx Transaction, e xdr.ContractEvent, index int, hash string) (ContractEventRow, error) {
+	result := ContractEventRow{
+		LedgerSeq:       int64(tx.LedgerSeq),
+		TransactionHash: hash,
+		EventIndex:      int32(index),
+		Type:            converter.XdrEnumName(e.Type),
+	}
+
+	body, err := converter.ConvertContractEventBody(e.Body)
+	if err != nil {
+		return result, err
+	}
+
+	topicsJson, err := json.Marshal(body.V0.Topics)
+	if err != nil {
+		return result, err
+	}
+	result.TopicsJson = string(topicsJson)
+
+	dataJson, err := json.Marshal(body.V0.Data)
+	if err != nil {
+		return result, err
+	}
+	result.DataJson = string(dataJson)
+
+	if len(e.Body.V0.Topics) > 0 {
+		if sym, ok := e.Body.V0.Topics[0].GetSym(); ok {
+			result.Topic = str(string(sym))
+		}
+	}
+
+	// System events have no contract.
+	if e.ContractId == nil {
+		return result, nil
+	}
+
+	event, err := converter.ConvertContractEvent(e)
+	if err != nil {
+		return result, err
+	}
+	result.ContractId = event.ContractId
+
+	switch {
+	case event.Transfer != nil:
+		result.From = str(event.Transfer.From)
+		result.To = str(event.Transfer.To)
+		result.Amount = str(int128String(event.Transfer.Amount))
+	case event.Mint != nil:
+		result.Admin = str(event.Mint.Admin)
+		result.To = str(event.Mint.To)
+		result.Amount = str(int128String(event.Mint.Amount))
+	case event.Clawback != nil:
+		result.Admin = str(event.Clawback.Admin)
+		result.From = str(event.Clawback.From)
+		result.Amount = str(int128String(event.Clawback.Amount))
+	case event.Burn != nil:
+		result.From = str(event.Burn.From)
+		result.Amount = str(int128String(event.Burn.Amount))
+	}
+
+	return result, nil
+}
+
+func int128String(p converter.Int128Parts) string {
+	v := big.NewInt(p.Hi)
+	v.Lsh(v, 64)
+	v.Add(v, new(big.Int).SetUint64(p.Lo))
+	return v.String()
+}
+
+func str(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
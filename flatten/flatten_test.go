@@ -0,0 +1,305 @@
+package flatten
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/stellar/go/xdr"
+)
+
+func testAccount(b byte) xdr.MuxedAccount {
+	return xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &xdr.Uint256{b}}
+}
+
+func testMuxedAccount(b byte, id uint64) xdr.MuxedAccount {
+	return xdr.MuxedAccount{
+		Type:     xdr.CryptoKeyTypeKeyTypeMuxedEd25519,
+		Med25519: &xdr.MuxedAccountMed25519{Id: xdr.Uint64(id), Ed25519: xdr.Uint256{b}},
+	}
+}
+
+func testTransaction(ops ...xdr.Operation) Transaction {
+	results := make([]xdr.OperationResult, len(ops))
+	for i, op := range ops {
+		results[i] = xdr.OperationResult{Code: xdr.OperationResultCodeOpInner, Tr: &xdr.OperationResultTr{Type: op.Body.Type}}
+		switch op.Body.Type {
+		case xdr.OperationTypePayment:
+			results[i].Tr.PaymentResult = &xdr.PaymentResult{Code: xdr.PaymentResultCodePaymentSuccess}
+		case xdr.OperationTypeClawback:
+			results[i].Tr.ClawbackResult = &xdr.ClawbackResult{Code: xdr.ClawbackResultCodeClawbackSuccess}
+		case xdr.OperationTypeAccountMerge:
+			balance := xdr.Int64(5)
+			results[i].Tr.AccountMergeResult = &xdr.AccountMergeResult{
+				Code:                 xdr.AccountMergeResultCodeAccountMergeSuccess,
+				SourceAccountBalance: &balance,
+			}
+		}
+	}
+
+	return Transaction{
+		LedgerSeq: 10,
+		Index:     1,
+		Envelope: xdr.TransactionEnvelope{
+			Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+			V1: &xdr.TransactionV1Envelope{Tx: xdr.Transaction{
+				SourceAccount: testAccount(1),
+				Fee:           100,
+				SeqNum:        1,
+				Operations:    ops,
+			}},
+		},
+		Result: xdr.TransactionResultPair{
+			TransactionHash: xdr.Hash{0xaa},
+			Result: xdr.TransactionResult{
+				FeeCharged: 100,
+				Result: xdr.TransactionResultResult{
+					Code:    xdr.TransactionResultCodeTxSuccess,
+					Results: &results,
+				},
+			},
+		},
+		NetworkPassphrase: "Test SDF Network ; September 2015",
+	}
+}
+
+func TestMuxedOperationColumns(t *testing.T) {
+	recipient := testMuxedAccount(2, 7)
+	recipientAddress := recipient.Address()
+	recipientAccount := recipient.ToAccountId()
+	issuer := testAccount(1)
+	asset := xdr.MustNewCreditAsset("USD", issuer.Address())
+	unmuxed := recipientAccount.ToMuxedAccount()
+
+	tx := testTransaction(
+		xdr.Operation{Body: xdr.OperationBody{
+			Type:      xdr.OperationTypePayment,
+			PaymentOp: &xdr.PaymentOp{Destination: recipient, Asset: xdr.MustNewNativeAsset(), Amount: 10},
+		}},
+		xdr.Operation{Body: xdr.OperationBody{
+			Type:       xdr.OperationTypeClawback,
+			ClawbackOp: &xdr.ClawbackOp{Asset: asset, From: recipient, Amount: 3},
+		}},
+		xdr.Operation{Body: xdr.OperationBody{
+			Type:        xdr.OperationTypeAccountMerge,
+			Destination: &unmuxed,
+		}},
+	)
+
+	var rows Rows
+	if err := rows.Add(tx); err != nil {
+		t.Fatal(err)
+	}
+	if len(rows.Operations) != 3 {
+		t.Fatalf("got %d operations, want 3", len(rows.Operations))
+	}
+
+	payment := rows.Operations[0]
+	if payment.Destination == nil || *payment.Destination != recipientAccount.Address() {
+		t.Errorf("payment destination = %v, want %s", payment.Destination, recipientAccount.Address())
+	}
+	if payment.DestinationMuxed == nil || *payment.DestinationMuxed != recipientAddress {
+		t.Errorf("payment destination_muxed = %v, want %s", payment.DestinationMuxed, recipientAddress)
+	}
+
+	clawback := rows.Operations[1]
+	if clawback.From == nil || *clawback.From != recipientAccount.Address() {
+		t.Errorf("clawback from = %v, want %s", clawback.From, recipientAccount.Address())
+	}
+	if clawback.FromMuxed == nil || *clawback.FromMuxed != recipientAddress {
+		t.Errorf("clawback from_muxed = %v, want %s", clawback.FromMuxed, recipientAddress)
+	}
+
+	merge := rows.Operations[2]
+	if merge.Destination == nil || *merge.Destination != recipientAccount.Address() {
+		t.Errorf("merge destination = %v, want %s", merge.Destination, recipientAccount.Address())
+	}
+	if merge.DestinationMuxed != nil {
+		t.Errorf("merge destination_muxed = %s, want NULL for an unmuxed account", *merge.DestinationMuxed)
+	}
+}
+
+// protocol23Ledger is a LedgerCloseMeta V2 of two Soroban transactions with V4
+// meta, see the converter tests.
+var protocol23Ledger = filepath.Join("..", "testdata", "protocol23-ledger.xdr.gz")
+
+func readProtocol23Ledger(t *testing.T) xdr.LedgerCloseMeta {
+	t.Helper()
+
+	r, err := xdrstream.OpenFile(protocol23Ledger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var meta xdr.LedgerCloseMeta
+	if err := r.Read(&meta); err != nil {
+		t.Fatal(err)
+	}
+
+	return meta
+}
+
+func TestLedgerCloseMetaV2Transactions(t *testing.T) {
+	txs, err := LedgerCloseMetaTransactions(readProtocol23Ledger(t), "load test network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("got %d transactions, want 2", len(txs))
+	}
+
+	var rows Rows
+	for _, tx := range txs {
+		if tx.Meta.V != 4 || len(tx.FeeRefundChanges) == 0 {
+			t.Errorf("transaction %d: got meta V%d and %d fee refund changes", tx.Index, tx.Meta.V, len(tx.FeeRefundChanges))
+		}
+		if err := rows.Add(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stages := make(map[string]int)
+	for _, row := range rows.LedgerEntryChanges {
+		stages[row.Stage]++
+	}
+	if stages[stageOperation] == 0 || stages[stageFeeRefund] != len(txs[0].FeeRefundChanges)+len(txs[1].FeeRefundChanges) {
+		t.Errorf("got changes by stage %v", stages)
+	}
+	if len(rows.ContractEvents) != 20 {
+		t.Errorf("got %d contract events, want the 10 of each operation", len(rows.ContractEvents))
+	}
+}
+
+// protocol23Rows flattens the transactions of the protocol 23 ledger.
+func protocol23Rows(t *testing.T) *Rows {
+	t.Helper()
+
+	txs, err := LedgerCloseMetaTransactions(readProtocol23Ledger(t), "load test network")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := new(Rows)
+	for _, tx := range txs {
+		if err := rows.Add(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return rows
+}
+
+func TestProtocol23Rows(t *testing.T) {
+	// Each transaction of the ledger moves 10 times 1 XLM out of its source
+	// account through the lumen SAC: the first to contracts, the second to
+	// accounts.
+	const (
+		sac    = "CAA6ET4T2RTBVZDDVJDRXTQBEZXLA3OAJUJ23STQCRJKL5D6U4UMMRTE"
+		amount = 10000000
+	)
+	rows := protocol23Rows(t)
+	if len(rows.Transactions) != 2 {
+		t.Fatalf("got %d transactions", len(rows.Transactions))
+	}
+	sources := map[string]string{}
+	for _, tx := range rows.Transactions {
+		sources[tx.Hash] = tx.SourceAccount
+	}
+
+	credited := map[string]bool{}
+	for _, e := range rows.Effects {
+		switch {
+		case e.Type == EffectAccountDebited && e.Account == sources[e.TransactionHash]:
+			if e.Amount == nil || *e.Amount != 10*amount {
+				t.Errorf("got debit %+v, want %d", e, 10*amount)
+			}
+		case e.Type == EffectAccountCredited && e.TransactionHash == rows.Transactions[1].Hash:
+			if e.Amount == nil || *e.Amount != amount {
+				t.Errorf("got credit %+v, want %d", e, amount)
+			}
+			credited[e.Account] = true
+		default:
+			t.Errorf("unexpected effect %+v", e)
+		}
+		if e.Asset == nil || *e.Asset != "native" {
+			t.Errorf("got effect asset %v, want native", e.Asset)
+		}
+	}
+	if len(rows.Effects) != 12 || len(credited) != 10 {
+		t.Errorf("got %d effects crediting %d accounts, want 12 crediting 10", len(rows.Effects), len(credited))
+	}
+
+	received := map[string]bool{}
+	for _, e := range rows.ContractEvents {
+		if e.Type != "CONTRACT" || e.ContractId == nil || *e.ContractId != sac || e.Topic == nil || *e.Topic != "transfer" {
+			t.Fatalf("got event %+v, want a transfer of %s", e, sac)
+		}
+		if e.From == nil || *e.From != sources[e.TransactionHash] || e.To == nil || e.Amount == nil || *e.Amount != "10000000" {
+			t.Errorf("got transfer %+v", e)
+		}
+		if e.TransactionHash == rows.Transactions[1].Hash && !credited[*e.To] {
+			t.Errorf("transfer to %s has no credit effect", *e.To)
+		}
+		received[*e.To] = true
+	}
+
+	// The balances of the contracts the first transaction pays are contract
+	// data, each read and updated once.
+	updated := 0
+	for _, c := range rows.ContractDataChanges {
+		if c.TransactionHash != rows.Transactions[0].Hash || c.ContractId != sac || c.Durability != "PERSISTENT" {
+			t.Errorf("got contract data change %+v", c)
+		}
+
+		keyXdr, err := base64.StdEncoding.DecodeString(c.KeyXdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var key xdr.ScVal
+		if err := xdr.SafeUnmarshal(keyXdr, &key); err != nil {
+			t.Fatal(err)
+		}
+		keyJson, err := converter.MarshalJSONContractValueXdr(keyXdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(keyJson) != c.KeyJson {
+			t.Errorf("key xdr %s reads as %s, want %s", c.KeyXdr, keyJson, c.KeyJson)
+		}
+
+		vec, ok := key.GetVec()
+		if !ok || len(*vec) != 2 || (*vec)[1].Address == nil {
+			t.Fatalf("got key %s, want a Balance key", c.KeyJson)
+		}
+		holder, err := (*vec)[1].Address.String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !received[holder] {
+			t.Errorf("balance of %s changed without a transfer", holder)
+		}
+		if c.ChangeType == "LEDGER_ENTRY_UPDATED" {
+			updated++
+		}
+	}
+	if len(rows.ContractDataChanges) != 20 || updated != 10 {
+		t.Errorf("got %d contract data changes with %d updates, want 20 with 10", len(rows.ContractDataChanges), updated)
+	}
+}
+
+func TestUnknownMetaVersion(t *testing.T) {
+	tx := testTransaction()
+	tx.Meta = &xdr.TransactionMeta{V: 5}
+
+	var rows Rows
+	if err := rows.Add(tx); err == nil {
+		t.Error("flattened TransactionMeta V5")
+	}
+
+	if _, err := LedgerCloseMetaTransactions(xdr.LedgerCloseMeta{V: 3}, "load test network"); err == nil {
+		t.Error("flattened LedgerCloseMeta V3")
+	}
+}
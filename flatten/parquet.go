@@ -0,0 +1,99 @@
+package flatten
+
+import (
+	"os"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// ParquetWriter writes a Snappy-compressed <table>.parquet file per table.
+// Each Write call ends a row group, so callers should batch rows, e.g. by
+// checkpoint.
+type ParquetWriter struct {
+	files               map[string]*os.File
+	transactions        *parquet.GenericWriter[TransactionRow]
+	operations          *parquet.GenericWriter[OperationRow]
+	operationResults    *parquet.GenericWriter[OperationResultRow]
+	effects             *parquet.GenericWriter[EffectRow]
+	ledgerEntryChanges  *parquet.GenericWriter[LedgerEntryChangeRow]
+	contractEvents      *parquet.GenericWriter[ContractEventRow]
+	contractDataChanges *parquet.GenericWriter[ContractDataChangeRow]
+}
+
+func NewParquetWriter(dir string) (*ParquetWriter, error) {
+	files, err := createTableFiles(dir, ".parquet")
+	if err != nil {
+		return nil, err
+	}
+
+	compression := parquet.Compression(&parquet.Snappy)
+	return &ParquetWriter{
+		files:               files,
+		transactions:        parquet.NewGenericWriter[TransactionRow](files[TableTransactions], compression),
+		operations:          parquet.NewGenericWriter[OperationRow](files[TableOperations], compression),
+		operationResults:    parquet.NewGenericWriter[OperationResultRow](files[TableOperationResults], compression),
+		effects:             parquet.NewGenericWriter[EffectRow](files[TableEffects], compression),
+		ledgerEntryChanges:  parquet.NewGenericWriter[LedgerEntryChangeRow](files[TableLedgerEntryChanges], compression),
+		contractEvents:      parquet.NewGenericWriter[ContractEventRow](files[TableContractEvents], compression),
+		contractDataChanges: parquet.NewGenericWriter[ContractDataChangeRow](files[TableContractDataChanges], compression),
+	}, nil
+}
+
+func (w *ParquetWriter) Write(rows *Rows) error {
+	if err := writeParquet(w.transactions, rows.Transactions); err != nil {
+		return err
+	}
+	if err := writeParquet(w.operations, rows.Operations); err != nil {
+		return err
+	}
+	if err := writeParquet(w.operationResults, rows.OperationResults); err != nil {
+		return err
+	}
+	if err := writeParquet(w.effects, rows.Effects); err != nil {
+		return err
+	}
+	if err := writeParquet(w.ledgerEntryChanges, rows.LedgerEntryChanges); err != nil {
+		return err
+	}
+	if err := writeParquet(w.contractEvents, rows.ContractEvents); err != nil {
+		return err
+	}
+	return writeParquet(w.contractDataChanges, rows.ContractDataChanges)
+}
+
+func writeParquet[T any](w *parquet.GenericWriter[T], rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if _, err := w.Write(rows); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+func (w *ParquetWriter) Close() error {
+	closers := []interface{ Close() error }{
+		w.transactions,
+		w.operations,
+		w.operationResults,
+		w.effects,
+		w.ledgerEntryChanges,
+		w.contractEvents,
+		w.contractDataChanges,
+	}
+
+	var first error
+	for _, c := range closers {
+		if err := c.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	if err := closeFiles(w.files); err != nil && first == nil {
+		first = err
+	}
+
+	return first
+}
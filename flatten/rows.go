@@ -0,0 +1,185 @@
+package flatten
+
+// Table names, also the base names of the files the writers create.
+const (
+	TableTransactions        = "transactions"
+	TableOperations          = "operations"
+	TableOperationResults    = "operation_results"
+	TableEffects             = "effects"
+	TableLedgerEntryChanges  = "ledger_entry_changes"
+	TableContractEvents      = "contract_events"
+	TableContractDataChanges = "contract_data_changes"
+)
+
+// Tables lists the tables in the order the writers create them.
+var Tables = []string{
+	TableTransactions,
+	TableOperations,
+	TableOperationResults,
+	TableEffects,
+	TableLedgerEntryChanges,
+	TableContractEvents,
+	TableContractDataChanges,
+}
+
+// The row types below are the fixed schemas of the tables. The parquet tag
+// names the column in every output and the json tag repeats it for rows
+// printed as JSON; nil pointers are NULL in Parquet and empty in CSV.
+// Columns ending in _json hold the converter's JSON for the part of the XDR
+// that has no column of its own.
+//
+// Ids are TOIDs as used by Horizon: the ledger sequence, the 1-based
+// application order of the transaction and the 1-based operation index
+// packed into an int64.
+
+type TransactionRow struct {
+	Id                 int64   `parquet:"id" json:"id"`
+	LedgerSeq          int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	CloseTime          int64   `parquet:"close_time" json:"close_time"`
+	ApplicationOrder   int32   `parquet:"application_order" json:"application_order"`
+	Hash               string  `parquet:"hash" json:"hash"`
+	EnvelopeType       string  `parquet:"envelope_type" json:"envelope_type"`
+	SourceAccount      string  `parquet:"source_account" json:"source_account"`
+	SourceAccountMuxed *string `parquet:"source_account_muxed" json:"source_account_muxed"`
+	FeeAccount         *string `parquet:"fee_account" json:"fee_account"`
+	InnerHash          *string `parquet:"inner_hash" json:"inner_hash"`
+	MaxFee             int64   `parquet:"max_fee" json:"max_fee"`
+	FeeCharged         int64   `parquet:"fee_charged" json:"fee_charged"`
+	SeqNum             int64   `parquet:"seq_num" json:"seq_num"`
+	MemoType           string  `parquet:"memo_type" json:"memo_type"`
+	Memo               *string `parquet:"memo" json:"memo"`
+	OperationCount     int32   `parquet:"operation_count" json:"operation_count"`
+	Successful         bool    `parquet:"successful" json:"successful"`
+	ResultCode         int32   `parquet:"result_code" json:"result_code"`
+	ResultCodeName     string  `parquet:"result_code_name" json:"result_code_name"`
+	ResourceFee        *int64  `parquet:"resource_fee" json:"resource_fee"`
+	Instructions       *int64  `parquet:"instructions" json:"instructions"`
+	ReadBytes          *int64  `parquet:"read_bytes" json:"read_bytes"`
+	WriteBytes         *int64  `parquet:"write_bytes" json:"write_bytes"`
+}
+
+type OperationRow struct {
+	Id                 int64   `parquet:"id" json:"id"`
+	TransactionId      int64   `parquet:"transaction_id" json:"transaction_id"`
+	LedgerSeq          int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	TransactionHash    string  `parquet:"transaction_hash" json:"transaction_hash"`
+	OpIndex            int32   `parquet:"op_index" json:"op_index"`
+	Type               string  `parquet:"type" json:"type"`
+	SourceAccount      string  `parquet:"source_account" json:"source_account"`
+	SourceAccountMuxed *string `parquet:"source_account_muxed" json:"source_account_muxed"`
+	Destination        *string `parquet:"destination" json:"destination"`
+	DestinationMuxed   *string `parquet:"destination_muxed" json:"destination_muxed"`
+	From               *string `parquet:"from" json:"from"`
+	FromMuxed          *string `parquet:"from_muxed" json:"from_muxed"`
+	Asset              *string `parquet:"asset" json:"asset"`
+	Amount             *int64  `parquet:"amount" json:"amount"`
+	SourceAsset        *string `parquet:"source_asset" json:"source_asset"`
+	SourceAmount       *int64  `parquet:"source_amount" json:"source_amount"`
+	SellingAsset       *string `parquet:"selling_asset" json:"selling_asset"`
+	BuyingAsset        *string `parquet:"buying_asset" json:"buying_asset"`
+	PriceN             *int32  `parquet:"price_n" json:"price_n"`
+	PriceD             *int32  `parquet:"price_d" json:"price_d"`
+	OfferId            *int64  `parquet:"offer_id" json:"offer_id"`
+	LiquidityPoolId    *string `parquet:"liquidity_pool_id" json:"liquidity_pool_id"`
+	BalanceId          *string `parquet:"balance_id" json:"balance_id"`
+	ContractId         *string `parquet:"contract_id" json:"contract_id"`
+	FunctionName       *string `parquet:"function_name" json:"function_name"`
+	BodyJson           string  `parquet:"body_json" json:"body_json"`
+}
+
+type OperationResultRow struct {
+	OperationId     int64   `parquet:"operation_id" json:"operation_id"`
+	LedgerSeq       int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	TransactionHash string  `parquet:"transaction_hash" json:"transaction_hash"`
+	OpIndex         int32   `parquet:"op_index" json:"op_index"`
+	Type            string  `parquet:"type" json:"type"`
+	Code            int32   `parquet:"code" json:"code"`
+	CodeName        string  `parquet:"code_name" json:"code_name"`
+	ResultCode      *int32  `parquet:"result_code" json:"result_code"`
+	ResultCodeName  *string `parquet:"result_code_name" json:"result_code_name"`
+	Successful      bool    `parquet:"successful" json:"successful"`
+	ResultJson      *string `parquet:"result_json" json:"result_json"`
+}
+
+// EffectRow is an account or balance change caused by an operation, see the
+// Effect constants.
+type EffectRow struct {
+	OperationId     int64   `parquet:"operation_id" json:"operation_id"`
+	LedgerSeq       int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	TransactionHash string  `parquet:"transaction_hash" json:"transaction_hash"`
+	OpIndex         int32   `parquet:"op_index" json:"op_index"`
+	EffectIndex     int32   `parquet:"effect_index" json:"effect_index"`
+	Type            string  `parquet:"type" json:"type"`
+	Account         string  `parquet:"account" json:"account"`
+	Asset           *string `parquet:"asset" json:"asset"`
+	Amount          *int64  `parquet:"amount" json:"amount"`
+}
+
+// LedgerEntryChangeRow is one change of a transaction's meta. Stage is fee,
+// before, operation, after or fee_refund; OpIndex is only set for the
+// operation stage.
+type LedgerEntryChangeRow struct {
+	LedgerSeq             int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	TransactionHash       string  `parquet:"transaction_hash" json:"transaction_hash"`
+	Stage                 string  `parquet:"stage" json:"stage"`
+	OpIndex               *int32  `parquet:"op_index" json:"op_index"`
+	ChangeIndex           int32   `parquet:"change_index" json:"change_index"`
+	ChangeType            string  `parquet:"change_type" json:"change_type"`
+	EntryType             string  `parquet:"entry_type" json:"entry_type"`
+	LastModifiedLedgerSeq *int64  `parquet:"last_modified_ledger_seq" json:"last_modified_ledger_seq"`
+	KeyJson               *string `parquet:"key_json" json:"key_json"`
+	EntryJson             *string `parquet:"entry_json" json:"entry_json"`
+}
+
+type ContractEventRow struct {
+	LedgerSeq       int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	TransactionHash string  `parquet:"transaction_hash" json:"transaction_hash"`
+	EventIndex      int32   `parquet:"event_index" json:"event_index"`
+	ContractId      *string `parquet:"contract_id" json:"contract_id"`
+	Type            string  `parquet:"type" json:"type"`
+	Topic           *string `parquet:"topic" json:"topic"`
+	From            *string `parquet:"from" json:"from"`
+	To              *string `parquet:"to" json:"to"`
+	Admin           *string `parquet:"admin" json:"admin"`
+	Amount          *string `parquet:"amount" json:"amount"`
+	TopicsJson      string  `parquet:"topics_json" json:"topics_json"`
+	DataJson        string  `parquet:"data_json" json:"data_json"`
+}
+
+// ContractDataChangeRow is a ledger_entry_changes row for a CONTRACT_DATA
+// entry, with the key and value split out. KeyXdr is the base64 XDR of the
+// key, which unlike its JSON does not depend on the converter settings.
+type ContractDataChangeRow struct {
+	LedgerSeq       int64   `parquet:"ledger_seq" json:"ledger_seq"`
+	TransactionHash string  `parquet:"transaction_hash" json:"transaction_hash"`
+	Stage           string  `parquet:"stage" json:"stage"`
+	OpIndex         *int32  `parquet:"op_index" json:"op_index"`
+	ChangeIndex     int32   `parquet:"change_index" json:"change_index"`
+	ChangeType      string  `parquet:"change_type" json:"change_type"`
+	ContractId      string  `parquet:"contract_id" json:"contract_id"`
+	Durability      string  `parquet:"durability" json:"durability"`
+	KeyJson         string  `parquet:"key_json" json:"key_json"`
+	KeyXdr          string  `parquet:"key_xdr" json:"key_xdr"`
+	ValueJson       *string `parquet:"value_json" json:"value_json"`
+}
+
+// Rows holds the rows of every table for a batch of transactions.
+type Rows struct {
+	Transactions        []TransactionRow
+	Operations          []OperationRow
+	OperationResults    []OperationResultRow
+	Effects             []EffectRow
+	LedgerEntryChanges  []LedgerEntryChangeRow
+	ContractEvents      []ContractEventRow
+	ContractDataChanges []ContractDataChangeRow
+}
+
+func (r *Rows) Reset() {
+	r.Transactions = r.Transactions[:0]
+	r.Operations = r.Operations[:0]
+	r.OperationResults = r.OperationResults[:0]
+	r.Effects = r.Effects[:0]
+	r.LedgerEntryChanges = r.LedgerEntryChanges[:0]
+	r.ContractEvents = r.ContractEvents[:0]
+	r.ContractDataChanges = r.ContractDataChanges[:0]
+}
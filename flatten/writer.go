@@ -0,0 +1,56 @@
+package flatten
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// Writer writes rows to one file per table.
+type Writer interface {
+	Write(rows *Rows) error
+	Close() error
+}
+
+// Columns returns the column names of a row type in schema order.
+func Columns(row interface{}) []string {
+	t := reflect.TypeOf(row)
+	var columns []string
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("parquet"), ",")
+		columns = append(columns, name)
+	}
+
+	return columns
+}
+
+// createTableFiles creates dir and a file named after each table with ext.
+func createTableFiles(dir string, ext string) (map[string]*os.File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*os.File, len(Tables))
+	for _, table := range Tables {
+		f, err := os.Create(filepath.Join(dir, table+ext))
+		if err != nil {
+			closeFiles(files)
+			return nil, err
+		}
+		files[table] = f
+	}
+
+	return files, nil
+}
+
+func closeFiles(files map[string]*os.File) error {
+	var first error
+	for _, f := range files {
+		if err := f.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
@@ -0,0 +1,125 @@
+package flatten
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+func writeRows(t *testing.T, w Writer, rows *Rows) {
+	t.Helper()
+
+	if err := w.Write(rows); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readCSV(t *testing.T, path string) [][]string {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return records
+}
+
+// checkCSV compares a table file with the rows it was written from, field by
+// field.
+func checkCSV[T any](t *testing.T, dir string, table string, rows []T) {
+	t.Helper()
+
+	records := readCSV(t, filepath.Join(dir, table+".csv"))
+	var zero T
+	if len(records) == 0 || !reflect.DeepEqual(records[0], Columns(zero)) {
+		t.Fatalf("%s: got header %v", table, records)
+	}
+	if len(records) != len(rows)+1 {
+		t.Fatalf("%s: got %d records for %d rows", table, len(records)-1, len(rows))
+	}
+	for i, row := range rows {
+		v := reflect.ValueOf(row)
+		for j, field := range records[i+1] {
+			if want := csvField(v.Field(j)); field != want {
+				t.Errorf("%s row %d column %s: got %q, want %q", table, i, records[0][j], field, want)
+			}
+		}
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	rows := protocol23Rows(t)
+	dir := t.TempDir()
+
+	w, err := NewCSVWriter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeRows(t, w, rows)
+
+	checkCSV(t, dir, TableTransactions, rows.Transactions)
+	checkCSV(t, dir, TableOperations, rows.Operations)
+	checkCSV(t, dir, TableOperationResults, rows.OperationResults)
+	checkCSV(t, dir, TableEffects, rows.Effects)
+	checkCSV(t, dir, TableLedgerEntryChanges, rows.LedgerEntryChanges)
+	checkCSV(t, dir, TableContractEvents, rows.ContractEvents)
+	checkCSV(t, dir, TableContractDataChanges, rows.ContractDataChanges)
+
+	// NULL is an empty field, unlike the empty string.
+	records := readCSV(t, filepath.Join(dir, TableTransactions+".csv"))
+	for i, column := range records[0] {
+		if column == "memo" && records[1][i] != "" {
+			t.Errorf("got memo %q for MEMO_NONE", records[1][i])
+		}
+	}
+}
+
+func checkParquet[T any](t *testing.T, dir string, table string, rows []T) {
+	t.Helper()
+
+	got, err := parquet.ReadFile[T](filepath.Join(dir, table+".parquet"))
+	if err != nil {
+		t.Fatalf("%s: %v", table, err)
+	}
+	if len(got) != len(rows) {
+		t.Fatalf("%s: got %d rows, want %d", table, len(got), len(rows))
+	}
+	for i := range rows {
+		if !reflect.DeepEqual(got[i], rows[i]) {
+			t.Errorf("%s row %d: got %+v, want %+v", table, i, got[i], rows[i])
+		}
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	rows := protocol23Rows(t)
+	dir := t.TempDir()
+
+	w, err := NewParquetWriter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeRows(t, w, rows)
+
+	checkParquet(t, dir, TableTransactions, rows.Transactions)
+	checkParquet(t, dir, TableOperations, rows.Operations)
+	checkParquet(t, dir, TableOperationResults, rows.OperationResults)
+	checkParquet(t, dir, TableEffects, rows.Effects)
+	checkParquet(t, dir, TableLedgerEntryChanges, rows.LedgerEntryChanges)
+	checkParquet(t, dir, TableContractEvents, rows.ContractEvents)
+	checkParquet(t, dir, TableContractDataChanges, rows.ContractDataChanges)
+}
@@ -0,0 +1,53 @@
+// Package grpcserver implements the XdrConverter gRPC service defined in
+// proto/xdrconverter/v1/service.proto.
+package grpcserver
+
+import (
+	"context"
+	"io"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	pb.UnimplementedXdrConverterServer
+}
+
+// Register adds the XdrConverter service to s.
+func Register(s *grpc.Server) {
+	pb.RegisterXdrConverterServer(s, &Server{})
+}
+
+func (s *Server) Decode(ctx context.Context, req *pb.DecodeRequest) (*pb.DecodeResponse, error) {
+	resp, err := pb.Decode(req.Type, req.Xdr, converter.Options{NetworkPassphrase: req.NetworkPassphrase, EnumNames: req.EnumNames})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (s *Server) DecodeStream(stream pb.XdrConverter_DecodeStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := pb.Decode(req.Type, req.Xdr, converter.Options{NetworkPassphrase: req.NetworkPassphrase, EnumNames: req.EnumNames})
+		if err != nil {
+			resp = &pb.DecodeResponse{Error: err.Error()}
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
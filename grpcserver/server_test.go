@@ -0,0 +1,196 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/pb"
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	loadTestPassphrase = "load test network"
+	loadTestSource     = "GDAENEQHN3V5LMYN3KBQUUHEOJ4C7FQJYFRJO2A4WP7ZDX3TCTFONWLL"
+	loadTestSAC        = "CAA6ET4T2RTBVZDDVJDRXTQBEZXLA3OAJUJ23STQCRJKL5D6U4UMMRTE"
+)
+
+func newClient(t *testing.T) pb.XdrConverterClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	Register(s)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewXdrConverterClient(conn)
+}
+
+// protocol23Transaction returns the envelope and result meta of the first
+// transaction of the protocol 23 test ledger, which transfers lumens from
+// loadTestSource through the SAC.
+func protocol23Transaction(t *testing.T) (envelope []byte, meta []byte) {
+	t.Helper()
+
+	r, err := xdrstream.OpenFile(filepath.Join("..", "testdata", "protocol23-ledger.xdr.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var lcm xdr.LedgerCloseMeta
+	if err := r.Read(&lcm); err != nil {
+		t.Fatal(err)
+	}
+
+	processed := lcm.V2.TxProcessing[0]
+	for _, env := range lcm.TransactionEnvelopes() {
+		hash, err := network.HashTransactionInEnvelope(env, loadTestPassphrase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if xdr.Hash(hash) == processed.Result.TransactionHash {
+			envelope, err = env.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if envelope == nil {
+		t.Fatal("no envelope for the first transaction")
+	}
+
+	meta, err = xdr.TransactionResultMeta{
+		Result:            processed.Result,
+		FeeProcessing:     processed.FeeProcessing,
+		TxApplyProcessing: processed.TxApplyProcessing,
+	}.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return envelope, meta
+}
+
+func checkEnvelope(t *testing.T, resp *pb.DecodeResponse) {
+	t.Helper()
+
+	if source := resp.GetTransactionEnvelope().GetV1().GetTx().GetSourceAccount().GetAddress(); source != loadTestSource {
+		t.Errorf("got envelope source %q, want %s", source, loadTestSource)
+	}
+}
+
+func checkMeta(t *testing.T, resp *pb.DecodeResponse, codeName string) {
+	t.Helper()
+
+	m := resp.GetTransactionResultMeta()
+	if name := m.GetResult().GetResult().GetResult().GetCodeName(); name != codeName {
+		t.Errorf("got result code name %q, want %q", name, codeName)
+	}
+
+	ops := m.GetTxApplyProcessing().GetV4().GetOperations()
+	if len(ops) != 1 || len(ops[0].GetEvents()) != 10 {
+		t.Fatalf("got operations %v, want one with 10 events", ops)
+	}
+	event := ops[0].GetEvents()[0]
+	if event.GetContractId() != loadTestSAC || event.GetTransfer().GetFrom() != loadTestSource {
+		t.Errorf("got event %v, want a transfer from %s by %s", event, loadTestSource, loadTestSAC)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	client := newClient(t)
+	envelope, meta := protocol23Transaction(t)
+	ctx := context.Background()
+
+	resp, err := client.Decode(ctx, &pb.DecodeRequest{
+		Type:              pb.XdrType_XDR_TYPE_TRANSACTION_ENVELOPE,
+		Xdr:               envelope,
+		NetworkPassphrase: loadTestPassphrase,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEnvelope(t, resp)
+
+	resp, err = client.Decode(ctx, &pb.DecodeRequest{Type: pb.XdrType_XDR_TYPE_TRANSACTION_RESULT_META, Xdr: meta})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMeta(t, resp, "")
+
+	resp, err = client.Decode(ctx, &pb.DecodeRequest{Type: pb.XdrType_XDR_TYPE_TRANSACTION_RESULT_META, Xdr: meta, EnumNames: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMeta(t, resp, "txSUCCESS")
+
+	_, err = client.Decode(ctx, &pb.DecodeRequest{Type: pb.XdrType_XDR_TYPE_TRANSACTION_RESULT_META, Xdr: envelope})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v decoding an envelope as meta, want InvalidArgument", err)
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	client := newClient(t)
+	envelope, meta := protocol23Transaction(t)
+
+	stream, err := client.DecodeStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := []*pb.DecodeRequest{
+		{Type: pb.XdrType_XDR_TYPE_TRANSACTION_ENVELOPE, Xdr: envelope, NetworkPassphrase: loadTestPassphrase},
+		{Type: pb.XdrType_XDR_TYPE_TRANSACTION_RESULT_META, Xdr: envelope},
+		{Type: pb.XdrType_XDR_TYPE_TRANSACTION_RESULT_META, Xdr: meta, EnumNames: true},
+	}
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+
+	var resps []*pb.DecodeResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		resps = append(resps, resp)
+	}
+	if len(resps) != len(reqs) {
+		t.Fatalf("got %d responses to %d requests", len(resps), len(reqs))
+	}
+
+	checkEnvelope(t, resps[0])
+	// A bad input fails its response only, not the stream.
+	if resps[1].GetError() == "" || resps[1].GetValue() != nil {
+		t.Errorf("got %v for an envelope decoded as meta, want an error", resps[1])
+	}
+	checkMeta(t, resps[2], "txSUCCESS")
+}
@@ -0,0 +1,374 @@
+// Package httpserver exposes the converter package over HTTP so that services
+// written in other languages can decode and encode XDR.
+//
+// Endpoints:
+//
+//	GET  /health                 liveness check
+//	POST /decode/{type}          {"xdr": "<base64 or hex>"} -> converted JSON
+//	POST /decode/auto            {"xdr": "..."} -> every matching type, see converter.DetectAndConvert
+//	POST /batch/decode/{type}    {"xdr": ["...", ...]} -> {"results": [...]}
+//	POST /encode/{type}          {"value": "..."} -> {"xdr": "<base64>"}, type is an ScVal type
+//
+// Decode requests may set "format" to "hex" or "base64" for blobs that read
+// both ways, see converter.DecodeXdrString, and "network_passphrase" to
+// derive contract IDs on another network than the server's
+// Config.NetworkPassphrase. "enum_names" overrides Config.EnumNames.
+//
+// Errors are returned as {"error": {"code": "...", "message": "..."}}.
+package httpserver
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	DefaultMaxBodyBytes = 1 << 20
+	DefaultMaxBatchSize = 1000
+)
+
+// Error codes returned in the code field of error responses.
+const (
+	ErrCodeBadRequest    = "bad_request"
+	ErrCodeUnknownType   = "unknown_type"
+	ErrCodeInvalidXdr    = "invalid_xdr"
+	ErrCodeInvalidValue  = "invalid_value"
+	ErrCodeBodyTooLarge  = "body_too_large"
+	ErrCodeBatchTooLarge = "batch_too_large"
+)
+
+type Config struct {
+	// MaxBodyBytes limits the size of a request body. Zero means
+	// DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// MaxBatchSize limits the number of blobs in a batch request. Zero means
+	// DefaultMaxBatchSize.
+	MaxBatchSize int
+	// NetworkPassphrase is the network of requests that do not name one.
+	// Empty means converter.DefaultNetworkPassphrase.
+	NetworkPassphrase string
+	// EnumNames adds enum names to the output of requests that do not set
+	// enum_names, see converter.Options.EnumNames.
+	EnumNames bool
+}
+
+type Server struct {
+	cfg Config
+	mux *http.ServeMux
+}
+
+type ErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+type DecodeRequest struct {
+	Xdr               string `json:"xdr"`
+	Format            string `json:"format,omitempty"`
+	NetworkPassphrase string `json:"network_passphrase,omitempty"`
+	EnumNames         *bool  `json:"enum_names,omitempty"`
+}
+
+type BatchDecodeRequest struct {
+	Xdr               []string `json:"xdr"`
+	Format            string   `json:"format,omitempty"`
+	NetworkPassphrase string   `json:"network_passphrase,omitempty"`
+	EnumNames         *bool    `json:"enum_names,omitempty"`
+}
+
+type BatchResult struct {
+	Value json.RawMessage `json:"value,omitempty"`
+	Error *ErrorBody      `json:"error,omitempty"`
+}
+
+type BatchDecodeResponse struct {
+	Results []BatchResult `json:"results"`
+}
+
+type EncodeRequest struct {
+	Value string `json:"value"`
+}
+
+type EncodeResponse struct {
+	Xdr string `json:"xdr"`
+}
+
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// requestError is an error with the status and code to report it with.
+type requestError struct {
+	status int
+	code   string
+	err    error
+}
+
+func (e *requestError) Error() string {
+	return e.err.Error()
+}
+
+func NewServer(cfg Config) *Server {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	if cfg.MaxBatchSize <= 0 {
+		cfg.MaxBatchSize = DefaultMaxBatchSize
+	}
+
+	s := &Server{
+		cfg: cfg,
+		mux: http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /health", s.handleHealth)
+	s.mux.HandleFunc("POST /decode/{type}", s.handleDecode)
+	s.mux.HandleFunc("POST /batch/decode/{type}", s.handleBatchDecode)
+	s.mux.HandleFunc("POST /encode/{type}", s.handleEncode)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, HealthResponse{Status: "ok"})
+}
+
+func (s *Server) handleDecode(w http.ResponseWriter, r *http.Request) {
+	var req DecodeRequest
+	if err := s.readRequest(w, r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	bz, err := decode(r.PathValue("type"), req.Xdr, req.Format, s.options(req.NetworkPassphrase, req.EnumNames))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeRawJSON(w, http.StatusOK, bz)
+}
+
+func (s *Server) handleBatchDecode(w http.ResponseWriter, r *http.Request) {
+	typ := r.PathValue("type")
+	if _, err := marshalFunc(typ); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req BatchDecodeRequest
+	if err := s.readRequest(w, r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if len(req.Xdr) > s.cfg.MaxBatchSize {
+		writeError(w, &requestError{
+			status: http.StatusRequestEntityTooLarge,
+			code:   ErrCodeBatchTooLarge,
+			err:    fmt.Errorf("batch of %d blobs exceeds the limit of %d", len(req.Xdr), s.cfg.MaxBatchSize),
+		})
+		return
+	}
+
+	// A failing blob does not fail the batch; its error is reported in place
+	// so that results line up with the request.
+	opts := s.options(req.NetworkPassphrase, req.EnumNames)
+	resp := BatchDecodeResponse{Results: make([]BatchResult, len(req.Xdr))}
+	for i, blob := range req.Xdr {
+		bz, err := decode(typ, blob, req.Format, opts)
+		if err != nil {
+			body := errorBody(err)
+			resp.Results[i].Error = &body
+			continue
+		}
+		resp.Results[i].Value = bz
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleEncode(w http.ResponseWriter, r *http.Request) {
+	var req EncodeRequest
+	if err := s.readRequest(w, r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	scVal, err := converter.ConvertToData(r.PathValue("type"), req.Value)
+	if err != nil {
+		writeError(w, &requestError{status: http.StatusBadRequest, code: ErrCodeInvalidValue, err: err})
+		return
+	}
+
+	encoded, err := xdr.MarshalBase64(scVal)
+	if err != nil {
+		writeError(w, &requestError{status: http.StatusBadRequest, code: ErrCodeInvalidValue, err: err})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, EncodeResponse{Xdr: encoded})
+}
+
+// options returns the converter options of a request naming passphrase and
+// asking for enumNames.
+func (s *Server) options(passphrase string, enumNames *bool) converter.Options {
+	if passphrase == "" {
+		passphrase = s.cfg.NetworkPassphrase
+	}
+
+	opts := converter.Options{NetworkPassphrase: passphrase, EnumNames: s.cfg.EnumNames}
+	if enumNames != nil {
+		opts.EnumNames = *enumNames
+	}
+
+	return opts
+}
+
+// readRequest decodes a JSON request body of at most MaxBodyBytes into v.
+func (s *Server) readRequest(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.cfg.MaxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return &requestError{
+				status: http.StatusRequestEntityTooLarge,
+				code:   ErrCodeBodyTooLarge,
+				err:    fmt.Errorf("request body exceeds the limit of %d bytes", maxErr.Limit),
+			}
+		}
+		return &requestError{status: http.StatusBadRequest, code: ErrCodeBadRequest, err: err}
+	}
+
+	return nil
+}
+
+// decode converts one base64 or hex blob of the given type to JSON. The
+// type "auto" returns every reading found by converter.DetectAndConvert.
+func decode(typ string, blob string, format string, opts converter.Options) ([]byte, error) {
+	inp, err := decodeBlob(blob, format)
+	if err != nil {
+		return nil, &requestError{status: http.StatusBadRequest, code: ErrCodeInvalidXdr, err: err}
+	}
+
+	if typ == "auto" {
+		matches, err := converter.DetectAndConvert(inp, opts)
+		if err != nil {
+			return nil, &requestError{status: http.StatusUnprocessableEntity, code: ErrCodeInvalidXdr, err: err}
+		}
+		return json.Marshal(matches)
+	}
+
+	marshal, err := marshalFunc(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := marshal(inp, opts)
+	if err != nil {
+		return nil, &requestError{status: http.StatusUnprocessableEntity, code: ErrCodeInvalidXdr, err: err}
+	}
+
+	return bz, nil
+}
+
+func marshalFunc(typ string) (func([]byte, converter.Options) ([]byte, error), error) {
+	if typ == "auto" {
+		return nil, nil
+	}
+
+	marshal, ok := converter.MarshalJSONFuncs[typ]
+	if !ok {
+		return nil, &requestError{
+			status: http.StatusNotFound,
+			code:   ErrCodeUnknownType,
+			err:    fmt.Errorf("unknown type %q, expected auto or one of %s", typ, strings.Join(decodeTypes(), ", ")),
+		}
+	}
+
+	return marshal, nil
+}
+
+func decodeTypes() []string {
+	types := make([]string, 0, len(converter.MarshalJSONFuncs))
+	for typ := range converter.MarshalJSONFuncs {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// decodeBlob decodes a blob in the given format, guessing it with
+// converter.DecodeXdrString for auto.
+func decodeBlob(s string, format string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, errors.New("empty xdr")
+	}
+
+	switch format {
+	case "", "auto":
+		return converter.DecodeXdrString(s)
+	case "hex":
+		return hex.DecodeString(s)
+	case "base64":
+		return base64.StdEncoding.DecodeString(s)
+	}
+
+	return nil, fmt.Errorf("unknown format %q, expected auto, hex or base64", format)
+}
+
+func errorBody(err error) ErrorBody {
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		return ErrorBody{Code: reqErr.code, Message: reqErr.Error()}
+	}
+
+	return ErrorBody{Code: ErrCodeInvalidXdr, Message: err.Error()}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		status = reqErr.status
+	}
+
+	writeJSON(w, status, ErrorResponse{Error: errorBody(err)})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		bz, _ = json.Marshal(ErrorResponse{Error: ErrorBody{Code: "internal", Message: err.Error()}})
+	}
+
+	writeRawJSON(w, status, bz)
+}
+
+func writeRawJSON(w http.ResponseWriter, status int, bz []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(bz)
+	w.Write([]byte("\n"))
+}
@@ -0,0 +1,241 @@
+package httpserver
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/stellar/go/xdr"
+)
+
+// u32Hex is SCV_U32 42 in hex, and u32Base64 the same in base64.
+const (
+	u32Hex    = "000000030000002a"
+	u32Base64 = "AAAAAwAAACo="
+)
+
+func post(t *testing.T, s *Server, path string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+
+	return w
+}
+
+func decodeResponse(t *testing.T, w *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("got content type %q", ct)
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("error decoding %s: %v", w.Body.String(), err)
+	}
+}
+
+func expectError(t *testing.T, w *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+
+	if w.Code != status {
+		t.Errorf("got status %d, want %d: %s", w.Code, status, w.Body.String())
+	}
+	var resp ErrorResponse
+	decodeResponse(t, w, &resp)
+	if resp.Error.Code != code || resp.Error.Message == "" {
+		t.Errorf("got error %+v, want code %s", resp.Error, code)
+	}
+}
+
+func ledgerKeyBase64(t *testing.T) string {
+	t.Helper()
+
+	id := xdr.ContractId{1}
+	key := xdr.LedgerKey{
+		Type: xdr.LedgerEntryTypeContractData,
+		ContractData: &xdr.LedgerKeyContractData{
+			Contract:   xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id},
+			Key:        xdr.ScVal{Type: xdr.ScValTypeScvLedgerKeyContractInstance},
+			Durability: xdr.ContractDataDurabilityPersistent,
+		},
+	}
+	s, err := xdr.MarshalBase64(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestHealth(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewServer(Config{}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var resp HealthResponse
+	decodeResponse(t, w, &resp)
+	if w.Code != http.StatusOK || resp.Status != "ok" {
+		t.Errorf("got %d %+v", w.Code, resp)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	s := NewServer(Config{})
+
+	for _, blob := range []string{u32Hex, u32Base64} {
+		w := post(t, s, "/decode/scval", `{"xdr": "`+blob+`"}`)
+		var v converter.ScVal
+		decodeResponse(t, w, &v)
+		if w.Code != http.StatusOK || v.U32 == nil || *v.U32 != 42 {
+			t.Errorf("%s: got %d %s", blob, w.Code, w.Body.String())
+		}
+	}
+
+	w := post(t, s, "/decode/ledger-key", `{"xdr": "`+ledgerKeyBase64(t)+`"}`)
+	var key converter.LedgerKey
+	decodeResponse(t, w, &key)
+	if w.Code != http.StatusOK || key.ContractData == nil || key.ContractData.TtlKeyHash == "" {
+		t.Errorf("ledger key: got %d %s", w.Code, w.Body.String())
+	}
+
+	// The u32 reads as a truncated offer key.
+	w = post(t, s, "/decode/ledger-key", `{"xdr": "`+u32Hex+`"}`)
+	expectError(t, w, http.StatusUnprocessableEntity, ErrCodeInvalidXdr)
+}
+
+func TestDecodeAuto(t *testing.T) {
+	w := post(t, NewServer(Config{}), "/decode/auto", `{"xdr": "`+u32Base64+`"}`)
+
+	var matches []struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	decodeResponse(t, w, &matches)
+	for _, m := range matches {
+		if m.Type == converter.XdrTypeScVal {
+			if string(m.Value) != `{"u32":42}` {
+				t.Errorf("got ScVal %s", m.Value)
+			}
+			return
+		}
+	}
+	t.Errorf("no ScVal reading in %s", w.Body.String())
+}
+
+func TestDecodeFormat(t *testing.T) {
+	s := NewServer(Config{})
+
+	// Upper case hex of 8 aligned bytes is also base64 of 12.
+	ambiguous := hex.EncodeToString([]byte{0, 0, 0, 3, 0, 0, 0, 0xab})
+	ambiguous = strings.ToUpper(ambiguous)
+	w := post(t, s, "/decode/scval", `{"xdr": "`+ambiguous+`"}`)
+	expectError(t, w, http.StatusBadRequest, ErrCodeInvalidXdr)
+
+	w = post(t, s, "/decode/scval", `{"xdr": "`+ambiguous+`", "format": "hex"}`)
+	var v converter.ScVal
+	decodeResponse(t, w, &v)
+	if w.Code != http.StatusOK || v.U32 == nil || *v.U32 != 0xab {
+		t.Errorf("format hex: got %d %s", w.Code, w.Body.String())
+	}
+
+	w = post(t, s, "/decode/scval", `{"xdr": "`+u32Hex+`", "format": "base64"}`)
+	expectError(t, w, http.StatusUnprocessableEntity, ErrCodeInvalidXdr)
+
+	w = post(t, s, "/decode/scval", `{"xdr": "`+u32Hex+`", "format": "octal"}`)
+	expectError(t, w, http.StatusBadRequest, ErrCodeInvalidXdr)
+}
+
+func TestDecodeEnumNames(t *testing.T) {
+	// A TransactionResultPair of zeros is a txSUCCESS with no operations.
+	result := `"` + base64.StdEncoding.EncodeToString(make([]byte, 52)) + `"`
+
+	for _, tc := range []struct {
+		cfg  Config
+		body string
+		want string
+	}{
+		{Config{}, `{"xdr": ` + result + `}`, ""},
+		{Config{}, `{"xdr": ` + result + `, "enum_names": true}`, "txSUCCESS"},
+		{Config{EnumNames: true}, `{"xdr": ` + result + `}`, "txSUCCESS"},
+		{Config{EnumNames: true}, `{"xdr": ` + result + `, "enum_names": false}`, ""},
+	} {
+		w := post(t, NewServer(tc.cfg), "/decode/result", tc.body)
+		var pair converter.TransactionResultPair
+		decodeResponse(t, w, &pair)
+		if w.Code != http.StatusOK || pair.Result.Result.CodeName != tc.want {
+			t.Errorf("%+v %s: got %d %s, want code name %q", tc.cfg, tc.body, w.Code, w.Body.String(), tc.want)
+		}
+	}
+
+	w := post(t, NewServer(Config{}), "/batch/decode/result", `{"xdr": [`+result+`], "enum_names": true}`)
+	var resp BatchDecodeResponse
+	decodeResponse(t, w, &resp)
+	if w.Code != http.StatusOK || len(resp.Results) != 1 || !strings.Contains(string(resp.Results[0].Value), `"code_name":"txSUCCESS"`) {
+		t.Errorf("batch: got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestBatchDecode(t *testing.T) {
+	s := NewServer(Config{MaxBatchSize: 3})
+
+	w := post(t, s, "/batch/decode/scval", `{"xdr": ["`+u32Hex+`", "not xdr", "`+u32Base64+`"]}`)
+	var resp BatchDecodeResponse
+	decodeResponse(t, w, &resp)
+	if w.Code != http.StatusOK || len(resp.Results) != 3 {
+		t.Fatalf("got %d %s", w.Code, w.Body.String())
+	}
+	for _, i := range []int{0, 2} {
+		if r := resp.Results[i]; r.Error != nil || string(r.Value) != `{"u32":42}` {
+			t.Errorf("result %d: got %+v", i, r)
+		}
+	}
+	if r := resp.Results[1]; r.Error == nil || r.Error.Code != ErrCodeInvalidXdr || r.Value != nil {
+		t.Errorf("result 1: got %+v, want an invalid_xdr error", r)
+	}
+
+	w = post(t, s, "/batch/decode/scval", `{"xdr": ["`+u32Hex+`", "`+u32Hex+`", "`+u32Hex+`", "`+u32Hex+`"]}`)
+	expectError(t, w, http.StatusRequestEntityTooLarge, ErrCodeBatchTooLarge)
+
+	w = post(t, s, "/batch/decode/nope", `{"xdr": []}`)
+	expectError(t, w, http.StatusNotFound, ErrCodeUnknownType)
+}
+
+func TestEncode(t *testing.T) {
+	w := post(t, NewServer(Config{}), "/encode/u32", `{"value": "42"}`)
+	var resp EncodeResponse
+	decodeResponse(t, w, &resp)
+	if w.Code != http.StatusOK || resp.Xdr != u32Base64 {
+		t.Errorf("got %d %s", w.Code, w.Body.String())
+	}
+
+	w = post(t, NewServer(Config{}), "/encode/u32", `{"value": "forty-two"}`)
+	expectError(t, w, http.StatusBadRequest, ErrCodeInvalidValue)
+}
+
+func TestRequestErrors(t *testing.T) {
+	s := NewServer(Config{MaxBodyBytes: 64})
+
+	for _, c := range []struct {
+		name   string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{"unknown type", "/decode/nope", `{"xdr": "` + u32Hex + `"}`, http.StatusNotFound, ErrCodeUnknownType},
+		{"malformed json", "/decode/scval", `{"xdr": `, http.StatusBadRequest, ErrCodeBadRequest},
+		{"unknown field", "/decode/scval", `{"blob": "` + u32Hex + `"}`, http.StatusBadRequest, ErrCodeBadRequest},
+		{"empty xdr", "/decode/scval", `{"xdr": " "}`, http.StatusBadRequest, ErrCodeInvalidXdr},
+		{"neither hex nor base64", "/decode/scval", `{"xdr": "%%%"}`, http.StatusBadRequest, ErrCodeInvalidXdr},
+		{"truncated xdr", "/decode/scval", `{"xdr": "00000003"}`, http.StatusUnprocessableEntity, ErrCodeInvalidXdr},
+		{"body too large", "/decode/scval", `{"xdr": "` + strings.Repeat("00", 64) + `"}`, http.StatusRequestEntityTooLarge, ErrCodeBodyTooLarge},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			expectError(t, post(t, s, c.path, c.body), c.status, c.code)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package metastream reads the LedgerCloseMeta stream stellar-core writes to
+// METADATA_OUTPUT_STREAM, a pipe or file of RFC 5531 record-marked frames,
+// one per closed ledger.
+package metastream
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/pkg/errors"
+	xdr3 "github.com/stellar/go-xdr/xdr3"
+	"github.com/stellar/go/xdr"
+)
+
+// Reader returns ledgers one at a time. Only one frame is held in memory,
+// and frames larger than MaxFrameSize are rejected.
+type Reader struct {
+	s       *xdrstream.Reader
+	from    uint32
+	lastSeq uint32
+	// passphrase is the network Read derives contract IDs on.
+	passphrase string
+	enumNames  bool
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{s: xdrstream.NewReader(r), passphrase: converter.DefaultNetworkPassphrase}
+}
+
+// SetMaxFrameSize overrides xdrstream.DefaultMaxRecordSize.
+func (r *Reader) SetMaxFrameSize(n int) {
+	r.s.MaxRecordSize = n
+}
+
+// SetNetworkPassphrase sets the network of the stream, which Read derives the
+// IDs of created contracts on. It defaults to converter.DefaultNetworkPassphrase.
+func (r *Reader) SetNetworkPassphrase(passphrase string) {
+	r.passphrase = passphrase
+}
+
+// SetEnumNames makes Read add enum names, see converter.Options.EnumNames.
+func (r *Reader) SetEnumNames(enumNames bool) {
+	r.enumNames = enumNames
+}
+
+// ResumeFrom makes the reader skip ledgers before seq. Skipped frames are
+// not decoded past their ledger header.
+func (r *Reader) ResumeFrom(seq uint32) {
+	r.from = seq
+}
+
+// ReadXdr returns the next ledger. It returns io.EOF at the end of the
+// stream, an error wrapping xdrstream.ErrTruncated when the stream ends
+// inside a frame, and an error when ledgers are not consecutive.
+func (r *Reader) ReadXdr() (xdr.LedgerCloseMeta, error) {
+	var meta xdr.LedgerCloseMeta
+
+	for {
+		offset := r.s.Offset()
+		frame, err := r.s.ReadRecord()
+		if err != nil {
+			return meta, err
+		}
+
+		seq, err := FrameLedgerSeq(frame)
+		if err != nil {
+			return meta, errors.Wrapf(err, "error reading frame at offset %d", offset)
+		}
+
+		if seq < r.from {
+			continue
+		}
+
+		if r.lastSeq != 0 && seq != r.lastSeq+1 {
+			return meta, errors.Errorf("error expected ledger %d after %d, got %d", r.lastSeq+1, r.lastSeq, seq)
+		}
+
+		if err := xdrstream.Decode(frame, &meta); err != nil {
+			return meta, errors.Wrapf(err, "error decoding ledger %d", seq)
+		}
+		r.lastSeq = seq
+
+		return meta, nil
+	}
+}
+
+func (r *Reader) Read() (converter.LedgerCloseMeta, error) {
+	meta, err := r.ReadXdr()
+	if err != nil {
+		return converter.LedgerCloseMeta{}, err
+	}
+
+	return converter.ConvertLedgerCloseMeta(meta, converter.Options{NetworkPassphrase: r.passphrase, EnumNames: r.enumNames})
+}
+
+// FrameLedgerSeq returns the ledger sequence of an encoded LedgerCloseMeta by
+// decoding only the version, extension and ledger header that start it.
+func FrameLedgerSeq(frame []byte) (uint32, error) {
+	opts := xdr3.DefaultDecodeOptions
+	opts.MaxInputLen = len(frame)
+	d := xdr3.NewDecoderWithOptions(bytes.NewReader(frame), opts)
+
+	v, _, err := d.DecodeInt()
+	if err != nil {
+		return 0, err
+	}
+
+	switch v {
+	case 0:
+	case 1, 2:
+		var ext xdr.LedgerCloseMetaExt
+		if _, err := ext.DecodeFrom(d, opts.MaxDepth); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, errors.Errorf("error invalid LedgerCloseMeta version %v", v)
+	}
+
+	var header xdr.LedgerHeaderHistoryEntry
+	if _, err := header.DecodeFrom(d, opts.MaxDepth); err != nil {
+		return 0, err
+	}
+
+	return uint32(header.Header.LedgerSeq), nil
+}
@@ -0,0 +1,229 @@
+package metastream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/xdrstream"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata/ledgers.xdr")
+
+// testStream is ledgers 10 to 12 of testdata/ledgers.xdr, the middle one a
+// version 1 LedgerCloseMeta.
+var testStream = filepath.Join("testdata", "ledgers.xdr")
+
+func testMeta(seq uint32) xdr.LedgerCloseMeta {
+	header := xdr.LedgerHeaderHistoryEntry{Header: xdr.LedgerHeader{LedgerVersion: 22, LedgerSeq: xdr.Uint32(seq)}}
+	if seq%2 == 0 {
+		return xdr.LedgerCloseMeta{V: 0, V0: &xdr.LedgerCloseMetaV0{LedgerHeader: header}}
+	}
+
+	return xdr.LedgerCloseMeta{V: 1, V1: &xdr.LedgerCloseMetaV1{
+		LedgerHeader: header,
+		TxSet:        xdr.GeneralizedTransactionSet{V: 1, V1TxSet: &xdr.TransactionSetV1{}},
+	}}
+}
+
+func encodeStream(t *testing.T, seqs ...uint32) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	for _, seq := range seqs {
+		bz, err := testMeta(seq).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := xdrstream.WriteRecord(&buf, bz); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func readTestStream(t *testing.T) []byte {
+	t.Helper()
+
+	want := encodeStream(t, 10, 11, 12)
+	if *update {
+		if err := os.WriteFile(testStream, want, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bz, err := os.ReadFile(testStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bz, want) {
+		t.Fatalf("%s is stale, run go test -update", testStream)
+	}
+
+	return bz
+}
+
+func readSeqs(r *Reader) ([]uint32, error) {
+	var seqs []uint32
+	for {
+		meta, err := r.ReadXdr()
+		if err == io.EOF {
+			return seqs, nil
+		}
+		if err != nil {
+			return seqs, err
+		}
+		seqs = append(seqs, meta.LedgerSequence())
+	}
+}
+
+func equalSeqs(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestFrameLedgerSeq(t *testing.T) {
+	for _, seq := range []uint32{10, 11} {
+		bz, err := testMeta(seq).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := FrameLedgerSeq(bz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != seq {
+			t.Errorf("got ledger %d, want %d", got, seq)
+		}
+
+		// The header is all that is read.
+		got, err = FrameLedgerSeq(bz[:len(bz)-8])
+		if err != nil || got != seq {
+			t.Errorf("got ledger %d, %v of a cut frame, want %d", got, err, seq)
+		}
+	}
+
+	if _, err := FrameLedgerSeq([]byte{0, 0, 0, 9}); err == nil {
+		t.Error("got no error for LedgerCloseMeta version 9")
+	}
+	if _, err := FrameLedgerSeq([]byte{0, 0, 0, 0, 0, 0}); err == nil {
+		t.Error("got no error for a frame cut inside the header")
+	}
+}
+
+func TestRead(t *testing.T) {
+	r := NewReader(bytes.NewReader(readTestStream(t)))
+
+	meta, err := r.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.LedgerHeader.Header.LedgerSeq != 10 {
+		t.Errorf("got ledger %d, want 10", meta.LedgerHeader.Header.LedgerSeq)
+	}
+
+	seqs, err := readSeqs(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalSeqs(seqs, []uint32{11, 12}) {
+		t.Errorf("got ledgers %v, want [11 12]", seqs)
+	}
+}
+
+func TestResumeFrom(t *testing.T) {
+	for _, c := range []struct {
+		from uint32
+		want []uint32
+	}{
+		{0, []uint32{10, 11, 12}},
+		{11, []uint32{11, 12}},
+		{12, []uint32{12}},
+		{13, nil},
+	} {
+		r := NewReader(bytes.NewReader(readTestStream(t)))
+		r.ResumeFrom(c.from)
+		seqs, err := readSeqs(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalSeqs(seqs, c.want) {
+			t.Errorf("from %d: got ledgers %v, want %v", c.from, seqs, c.want)
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	bz := readTestStream(t)
+	r := NewReader(bytes.NewReader(bz[:len(bz)-3]))
+
+	seqs, err := readSeqs(r)
+	if !errors.Is(err, xdrstream.ErrTruncated) {
+		t.Errorf("got error %v, want ErrTruncated", err)
+	}
+	if !equalSeqs(seqs, []uint32{10, 11}) {
+		t.Errorf("got ledgers %v before the cut frame, want [10 11]", seqs)
+	}
+}
+
+func TestNonConsecutive(t *testing.T) {
+	r := NewReader(bytes.NewReader(encodeStream(t, 10, 11, 13)))
+
+	seqs, err := readSeqs(r)
+	if err == nil {
+		t.Fatal("got no error for ledger 13 after 11")
+	}
+	if !equalSeqs(seqs, []uint32{10, 11}) {
+		t.Errorf("got ledgers %v before the gap, want [10 11]", seqs)
+	}
+
+	// Frames skipped by ResumeFrom are not checked.
+	r = NewReader(bytes.NewReader(encodeStream(t, 7, 9, 10, 11)))
+	r.ResumeFrom(10)
+	if seqs, err := readSeqs(r); err != nil || !equalSeqs(seqs, []uint32{10, 11}) {
+		t.Errorf("got ledgers %v, %v, want [10 11]", seqs, err)
+	}
+}
+
+// A protocol 23 stream frames LedgerCloseMeta V2.
+func TestReadProtocol23(t *testing.T) {
+	f, err := os.Open(filepath.Join("..", "testdata", "protocol23-ledger.xdr.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewReader(gz)
+	r.SetNetworkPassphrase("load test network")
+	meta, err := r.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.V != 2 || meta.LedgerHeader.Header.LedgerSeq != 96 || len(meta.TxSet) != 2 {
+		t.Errorf("got v%d ledger %d with %d transactions, want v2 ledger 96 with 2", meta.V, meta.LedgerHeader.Header.LedgerSeq, len(meta.TxSet))
+	}
+
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("got %v after the last ledger, want EOF", err)
+	}
+}
@@ -0,0 +1,120 @@
+// Package pb holds the protobuf messages mirroring the converter output types
+// and the XdrConverter gRPC service. The messages are generated from
+// converter/types.go, see go:generate below.
+package pb
+
+//go:generate go run ../cmd/protogen -o ../proto/xdrconverter/v1/types.proto
+//go:generate sh -c "cd .. && buf generate"
+
+import (
+	"encoding/json"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// FromTransactionEnvelope converts an envelope with opts, deriving contract IDs
+// on opts.Passphrase().
+func FromTransactionEnvelope(v xdr.TransactionEnvelope, opts converter.Options) (*TransactionEnvelope, error) {
+	converted, err := converter.ConvertTransactionEnvelopeWithOptions(v, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionEnvelope
+	return &result, fromConverted(converted, &result)
+}
+
+func FromTransactionResultMeta(v xdr.TransactionResultMeta, opts converter.Options) (*TransactionResultMeta, error) {
+	converted, err := converter.ConvertTransactionResultMetaWithOptions(v, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionResultMeta
+	return &result, fromConverted(converted, &result)
+}
+
+func FromContractEvent(v xdr.ContractEvent, opts converter.Options) (*ContractEvent, error) {
+	converted, err := converter.ConvertContractEventWithOptions(v, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ContractEvent
+	return &result, fromConverted(converted, &result)
+}
+
+func FromLedgerEntry(v xdr.LedgerEntry, opts converter.Options) (*LedgerEntry, error) {
+	converted, err := converter.ConvertLedgerEntryWithOptions(v, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LedgerEntry
+	return &result, fromConverted(converted, &result)
+}
+
+// fromConverted fills m from a converter output value. The messages use the
+// json tags of types.go as json_name, so the converter JSON is valid protojson
+// for them. Fields missing from a stale types.proto are dropped rather than
+// failing the call; cmd/protogen's tests catch them.
+func fromConverted(v interface{}, m proto.Message) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(bz, m)
+}
+
+// Decode unmarshals inp as the given type and converts it to a response.
+func Decode(typ XdrType, inp []byte, opts converter.Options) (*DecodeResponse, error) {
+	switch typ {
+	case XdrType_XDR_TYPE_TRANSACTION_ENVELOPE:
+		var v xdr.TransactionEnvelope
+		if err := v.UnmarshalBinary(inp); err != nil {
+			return nil, err
+		}
+		m, err := FromTransactionEnvelope(v, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &DecodeResponse{Value: &DecodeResponse_TransactionEnvelope{TransactionEnvelope: m}}, nil
+	case XdrType_XDR_TYPE_TRANSACTION_RESULT_META:
+		var v xdr.TransactionResultMeta
+		if err := v.UnmarshalBinary(inp); err != nil {
+			return nil, err
+		}
+		m, err := FromTransactionResultMeta(v, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &DecodeResponse{Value: &DecodeResponse_TransactionResultMeta{TransactionResultMeta: m}}, nil
+	case XdrType_XDR_TYPE_CONTRACT_EVENT:
+		var v xdr.ContractEvent
+		if err := v.UnmarshalBinary(inp); err != nil {
+			return nil, err
+		}
+		m, err := FromContractEvent(v, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &DecodeResponse{Value: &DecodeResponse_ContractEvent{ContractEvent: m}}, nil
+	case XdrType_XDR_TYPE_LEDGER_ENTRY:
+		var v xdr.LedgerEntry
+		if err := v.UnmarshalBinary(inp); err != nil {
+			return nil, err
+		}
+		m, err := FromLedgerEntry(v, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &DecodeResponse{Value: &DecodeResponse_LedgerEntry{LedgerEntry: m}}, nil
+	}
+
+	return nil, errors.Errorf("error invalid xdr type %v", typ)
+}
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: xdrconverter/v1/service.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type XdrType int32
+
+const (
+	XdrType_XDR_TYPE_UNSPECIFIED             XdrType = 0
+	XdrType_XDR_TYPE_TRANSACTION_ENVELOPE    XdrType = 1
+	XdrType_XDR_TYPE_TRANSACTION_RESULT_META XdrType = 2
+	XdrType_XDR_TYPE_CONTRACT_EVENT          XdrType = 3
+	XdrType_XDR_TYPE_LEDGER_ENTRY            XdrType = 4
+)
+
+// Enum value maps for XdrType.
+var (
+	XdrType_name = map[int32]string{
+		0: "XDR_TYPE_UNSPECIFIED",
+		1: "XDR_TYPE_TRANSACTION_ENVELOPE",
+		2: "XDR_TYPE_TRANSACTION_RESULT_META",
+		3: "XDR_TYPE_CONTRACT_EVENT",
+		4: "XDR_TYPE_LEDGER_ENTRY",
+	}
+	XdrType_value = map[string]int32{
+		"XDR_TYPE_UNSPECIFIED":             0,
+		"XDR_TYPE_TRANSACTION_ENVELOPE":    1,
+		"XDR_TYPE_TRANSACTION_RESULT_META": 2,
+		"XDR_TYPE_CONTRACT_EVENT":          3,
+		"XDR_TYPE_LEDGER_ENTRY":            4,
+	}
+)
+
+func (x XdrType) Enum() *XdrType {
+	p := new(XdrType)
+	*p = x
+	return p
+}
+
+func (x XdrType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (XdrType) Descriptor() protoreflect.EnumDescriptor {
+	return file_xdrconverter_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (XdrType) Type() protoreflect.EnumType {
+	return &file_xdrconverter_v1_service_proto_enumTypes[0]
+}
+
+func (x XdrType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use XdrType.Descriptor instead.
+func (XdrType) EnumDescriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+type DecodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type XdrType `protobuf:"varint,1,opt,name=type,proto3,enum=xdrconverter.v1.XdrType" json:"type,omitempty"`
+	// Raw XDR bytes, not base64.
+	Xdr []byte `protobuf:"bytes,2,opt,name=xdr,proto3" json:"xdr,omitempty"`
+	// Network the contract IDs of created contracts are derived on. Empty
+	// means the public network.
+	NetworkPassphrase string `protobuf:"bytes,3,opt,name=network_passphrase,json=networkPassphrase,proto3" json:"network_passphrase,omitempty"`
+	// Adds the XDR names of result codes, operation types, flags and other
+	// enums next to their numeric values.
+	EnumNames bool `protobuf:"varint,4,opt,name=enum_names,json=enumNames,proto3" json:"enum_names,omitempty"`
+}
+
+func (x *DecodeRequest) Reset() {
+	*x = DecodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeRequest) ProtoMessage() {}
+
+func (x *DecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeRequest.ProtoReflect.Descriptor instead.
+func (*DecodeRequest) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DecodeRequest) GetType() XdrType {
+	if x != nil {
+		return x.Type
+	}
+	return XdrType_XDR_TYPE_UNSPECIFIED
+}
+
+func (x *DecodeRequest) GetXdr() []byte {
+	if x != nil {
+		return x.Xdr
+	}
+	return nil
+}
+
+func (x *DecodeRequest) GetNetworkPassphrase() string {
+	if x != nil {
+		return x.NetworkPassphrase
+	}
+	return ""
+}
+
+func (x *DecodeRequest) GetEnumNames() bool {
+	if x != nil {
+		return x.EnumNames
+	}
+	return false
+}
+
+type DecodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*DecodeResponse_TransactionEnvelope
+	//	*DecodeResponse_TransactionResultMeta
+	//	*DecodeResponse_ContractEvent
+	//	*DecodeResponse_LedgerEntry
+	Value isDecodeResponse_Value `protobuf_oneof:"value"`
+	// Set instead of value when the blob could not be decoded. Only used by
+	// DecodeStream; Decode returns an error status.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DecodeResponse) Reset() {
+	*x = DecodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeResponse) ProtoMessage() {}
+
+func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeResponse.ProtoReflect.Descriptor instead.
+func (*DecodeResponse) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *DecodeResponse) GetValue() isDecodeResponse_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetTransactionEnvelope() *TransactionEnvelope {
+	if x, ok := x.GetValue().(*DecodeResponse_TransactionEnvelope); ok {
+		return x.TransactionEnvelope
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetTransactionResultMeta() *TransactionResultMeta {
+	if x, ok := x.GetValue().(*DecodeResponse_TransactionResultMeta); ok {
+		return x.TransactionResultMeta
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetContractEvent() *ContractEvent {
+	if x, ok := x.GetValue().(*DecodeResponse_ContractEvent); ok {
+		return x.ContractEvent
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetLedgerEntry() *LedgerEntry {
+	if x, ok := x.GetValue().(*DecodeResponse_LedgerEntry); ok {
+		return x.LedgerEntry
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type isDecodeResponse_Value interface {
+	isDecodeResponse_Value()
+}
+
+type DecodeResponse_TransactionEnvelope struct {
+	TransactionEnvelope *TransactionEnvelope `protobuf:"bytes,1,opt,name=transaction_envelope,json=transactionEnvelope,proto3,oneof"`
+}
+
+type DecodeResponse_TransactionResultMeta struct {
+	TransactionResultMeta *TransactionResultMeta `protobuf:"bytes,2,opt,name=transaction_result_meta,json=transactionResultMeta,proto3,oneof"`
+}
+
+type DecodeResponse_ContractEvent struct {
+	ContractEvent *ContractEvent `protobuf:"bytes,3,opt,name=contract_event,json=contractEvent,proto3,oneof"`
+}
+
+type DecodeResponse_LedgerEntry struct {
+	LedgerEntry *LedgerEntry `protobuf:"bytes,4,opt,name=ledger_entry,json=ledgerEntry,proto3,oneof"`
+}
+
+func (*DecodeResponse_TransactionEnvelope) isDecodeResponse_Value() {}
+
+func (*DecodeResponse_TransactionResultMeta) isDecodeResponse_Value() {}
+
+func (*DecodeResponse_ContractEvent) isDecodeResponse_Value() {}
+
+func (*DecodeResponse_LedgerEntry) isDecodeResponse_Value() {}
+
+var File_xdrconverter_v1_service_proto protoreflect.FileDescriptor
+
+var file_xdrconverter_v1_service_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0f, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x1a, 0x1b, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x01,
+	0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x58, 0x64, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x78, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x78, 0x64, 0x72, 0x12,
+	0x2d, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xf8, 0x02,
+	0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x59, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x60, 0x0a, 0x17, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x47, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0xa4, 0x01, 0x0a, 0x07, 0x58, 0x64, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x58, 0x44, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x58, 0x44, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x56, 0x45, 0x4c, 0x4f, 0x50, 0x45, 0x10,
+	0x01, 0x12, 0x24, 0x0a, 0x20, 0x58, 0x44, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x4d, 0x45, 0x54, 0x41, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x58, 0x44, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x58, 0x44, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x04, 0x32,
+	0xae, 0x01, 0x0a, 0x0c, 0x58, 0x64, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x12, 0x49, 0x0a, 0x06, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01,
+	0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x6f, 0x2f, 0x78, 0x64, 0x72, 0x2d, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xdrconverter_v1_service_proto_rawDescOnce sync.Once
+	file_xdrconverter_v1_service_proto_rawDescData = file_xdrconverter_v1_service_proto_rawDesc
+)
+
+func file_xdrconverter_v1_service_proto_rawDescGZIP() []byte {
+	file_xdrconverter_v1_service_proto_rawDescOnce.Do(func() {
+		file_xdrconverter_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_xdrconverter_v1_service_proto_rawDescData)
+	})
+	return file_xdrconverter_v1_service_proto_rawDescData
+}
+
+var file_xdrconverter_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xdrconverter_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_xdrconverter_v1_service_proto_goTypes = []any{
+	(XdrType)(0),                  // 0: xdrconverter.v1.XdrType
+	(*DecodeRequest)(nil),         // 1: xdrconverter.v1.DecodeRequest
+	(*DecodeResponse)(nil),        // 2: xdrconverter.v1.DecodeResponse
+	(*TransactionEnvelope)(nil),   // 3: xdrconverter.v1.TransactionEnvelope
+	(*TransactionResultMeta)(nil), // 4: xdrconverter.v1.TransactionResultMeta
+	(*ContractEvent)(nil),         // 5: xdrconverter.v1.ContractEvent
+	(*LedgerEntry)(nil),           // 6: xdrconverter.v1.LedgerEntry
+}
+var file_xdrconverter_v1_service_proto_depIdxs = []int32{
+	0, // 0: xdrconverter.v1.DecodeRequest.type:type_name -> xdrconverter.v1.XdrType
+	3, // 1: xdrconverter.v1.DecodeResponse.transaction_envelope:type_name -> xdrconverter.v1.TransactionEnvelope
+	4, // 2: xdrconverter.v1.DecodeResponse.transaction_result_meta:type_name -> xdrconverter.v1.TransactionResultMeta
+	5, // 3: xdrconverter.v1.DecodeResponse.contract_event:type_name -> xdrconverter.v1.ContractEvent
+	6, // 4: xdrconverter.v1.DecodeResponse.ledger_entry:type_name -> xdrconverter.v1.LedgerEntry
+	1, // 5: xdrconverter.v1.XdrConverter.Decode:input_type -> xdrconverter.v1.DecodeRequest
+	1, // 6: xdrconverter.v1.XdrConverter.DecodeStream:input_type -> xdrconverter.v1.DecodeRequest
+	2, // 7: xdrconverter.v1.XdrConverter.Decode:output_type -> xdrconverter.v1.DecodeResponse
+	2, // 8: xdrconverter.v1.XdrConverter.DecodeStream:output_type -> xdrconverter.v1.DecodeResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_xdrconverter_v1_service_proto_init() }
+func file_xdrconverter_v1_service_proto_init() {
+	if File_xdrconverter_v1_service_proto != nil {
+		return
+	}
+	file_xdrconverter_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_xdrconverter_v1_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*DecodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*DecodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_xdrconverter_v1_service_proto_msgTypes[1].OneofWrappers = []any{
+		(*DecodeResponse_TransactionEnvelope)(nil),
+		(*DecodeResponse_TransactionResultMeta)(nil),
+		(*DecodeResponse_ContractEvent)(nil),
+		(*DecodeResponse_LedgerEntry)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xdrconverter_v1_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_xdrconverter_v1_service_proto_goTypes,
+		DependencyIndexes: file_xdrconverter_v1_service_proto_depIdxs,
+		EnumInfos:         file_xdrconverter_v1_service_proto_enumTypes,
+		MessageInfos:      file_xdrconverter_v1_service_proto_msgTypes,
+	}.Build()
+	File_xdrconverter_v1_service_proto = out.File
+	file_xdrconverter_v1_service_proto_rawDesc = nil
+	file_xdrconverter_v1_service_proto_goTypes = nil
+	file_xdrconverter_v1_service_proto_depIdxs = nil
+}
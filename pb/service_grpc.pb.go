@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: xdrconverter/v1/service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	XdrConverter_Decode_FullMethodName       = "/xdrconverter.v1.XdrConverter/Decode"
+	XdrConverter_DecodeStream_FullMethodName = "/xdrconverter.v1.XdrConverter/DecodeStream"
+)
+
+// XdrConverterClient is the client API for XdrConverter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type XdrConverterClient interface {
+	// Decode converts a single XDR blob.
+	Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error)
+	// DecodeStream converts a stream of XDR blobs and answers every request in
+	// order. A blob that fails to decode gets a response with error set and
+	// does not end the stream.
+	DecodeStream(ctx context.Context, opts ...grpc.CallOption) (XdrConverter_DecodeStreamClient, error)
+}
+
+type xdrConverterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewXdrConverterClient(cc grpc.ClientConnInterface) XdrConverterClient {
+	return &xdrConverterClient{cc}
+}
+
+func (c *xdrConverterClient) Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error) {
+	out := new(DecodeResponse)
+	err := c.cc.Invoke(ctx, XdrConverter_Decode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xdrConverterClient) DecodeStream(ctx context.Context, opts ...grpc.CallOption) (XdrConverter_DecodeStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &XdrConverter_ServiceDesc.Streams[0], XdrConverter_DecodeStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xdrConverterDecodeStreamClient{stream}
+	return x, nil
+}
+
+type XdrConverter_DecodeStreamClient interface {
+	Send(*DecodeRequest) error
+	Recv() (*DecodeResponse, error)
+	grpc.ClientStream
+}
+
+type xdrConverterDecodeStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *xdrConverterDecodeStreamClient) Send(m *DecodeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *xdrConverterDecodeStreamClient) Recv() (*DecodeResponse, error) {
+	m := new(DecodeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// XdrConverterServer is the server API for XdrConverter service.
+// All implementations must embed UnimplementedXdrConverterServer
+// for forward compatibility
+type XdrConverterServer interface {
+	// Decode converts a single XDR blob.
+	Decode(context.Context, *DecodeRequest) (*DecodeResponse, error)
+	// DecodeStream converts a stream of XDR blobs and answers every request in
+	// order. A blob that fails to decode gets a response with error set and
+	// does not end the stream.
+	DecodeStream(XdrConverter_DecodeStreamServer) error
+	mustEmbedUnimplementedXdrConverterServer()
+}
+
+// UnimplementedXdrConverterServer must be embedded to have forward compatible implementations.
+type UnimplementedXdrConverterServer struct {
+}
+
+func (UnimplementedXdrConverterServer) Decode(context.Context, *DecodeRequest) (*DecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decode not implemented")
+}
+func (UnimplementedXdrConverterServer) DecodeStream(XdrConverter_DecodeStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecodeStream not implemented")
+}
+func (UnimplementedXdrConverterServer) mustEmbedUnimplementedXdrConverterServer() {}
+
+// UnsafeXdrConverterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to XdrConverterServer will
+// result in compilation errors.
+type UnsafeXdrConverterServer interface {
+	mustEmbedUnimplementedXdrConverterServer()
+}
+
+func RegisterXdrConverterServer(s grpc.ServiceRegistrar, srv XdrConverterServer) {
+	s.RegisterService(&XdrConverter_ServiceDesc, srv)
+}
+
+func _XdrConverter_Decode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XdrConverterServer).Decode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: XdrConverter_Decode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XdrConverterServer).Decode(ctx, req.(*DecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XdrConverter_DecodeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(XdrConverterServer).DecodeStream(&xdrConverterDecodeStreamServer{stream})
+}
+
+type XdrConverter_DecodeStreamServer interface {
+	Send(*DecodeResponse) error
+	Recv() (*DecodeRequest, error)
+	grpc.ServerStream
+}
+
+type xdrConverterDecodeStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *xdrConverterDecodeStreamServer) Send(m *DecodeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *xdrConverterDecodeStreamServer) Recv() (*DecodeRequest, error) {
+	m := new(DecodeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// XdrConverter_ServiceDesc is the grpc.ServiceDesc for XdrConverter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var XdrConverter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "xdrconverter.v1.XdrConverter",
+	HandlerType: (*XdrConverterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Decode",
+			Handler:    _XdrConverter_Decode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DecodeStream",
+			Handler:       _XdrConverter_DecodeStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "xdrconverter/v1/service.proto",
+}
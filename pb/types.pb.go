@@ -0,0 +1,19414 @@
+// Code generated by cmd/protogen from converter/types.go. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: xdrconverter/v1/types.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId     *AccountId       `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	Balance       int64            `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	SeqNum        int64            `protobuf:"varint,3,opt,name=seq_num,proto3" json:"seq_num,omitempty"`
+	NumSubEntries uint32           `protobuf:"varint,4,opt,name=num_sub_entries,proto3" json:"num_sub_entries,omitempty"`
+	InflationDest *AccountId       `protobuf:"bytes,5,opt,name=inflation_dest,proto3" json:"inflation_dest,omitempty"`
+	Flags         uint32           `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	FlagNames     []string         `protobuf:"bytes,7,rep,name=flag_names,proto3" json:"flag_names,omitempty"`
+	HomeDomain    string           `protobuf:"bytes,8,opt,name=home_domain,proto3" json:"home_domain,omitempty"`
+	Thresholds    []byte           `protobuf:"bytes,9,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Signers       []*Signer        `protobuf:"bytes,10,rep,name=signers,proto3" json:"signers,omitempty"`
+	Ext           *AccountEntryExt `protobuf:"bytes,11,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *AccountEntry) Reset() {
+	*x = AccountEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntry) ProtoMessage() {}
+
+func (x *AccountEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntry.ProtoReflect.Descriptor instead.
+func (*AccountEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountEntry) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *AccountEntry) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AccountEntry) GetSeqNum() int64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *AccountEntry) GetNumSubEntries() uint32 {
+	if x != nil {
+		return x.NumSubEntries
+	}
+	return 0
+}
+
+func (x *AccountEntry) GetInflationDest() *AccountId {
+	if x != nil {
+		return x.InflationDest
+	}
+	return nil
+}
+
+func (x *AccountEntry) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *AccountEntry) GetFlagNames() []string {
+	if x != nil {
+		return x.FlagNames
+	}
+	return nil
+}
+
+func (x *AccountEntry) GetHomeDomain() string {
+	if x != nil {
+		return x.HomeDomain
+	}
+	return ""
+}
+
+func (x *AccountEntry) GetThresholds() []byte {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *AccountEntry) GetSigners() []*Signer {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *AccountEntry) GetExt() *AccountEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type AccountEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                    `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V1 *AccountEntryExtensionV1 `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *AccountEntryExt) Reset() {
+	*x = AccountEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntryExt) ProtoMessage() {}
+
+func (x *AccountEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntryExt.ProtoReflect.Descriptor instead.
+func (*AccountEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *AccountEntryExt) GetV1() *AccountEntryExtensionV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type AccountEntryExtensionV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Liabilities *Liabilities                `protobuf:"bytes,1,opt,name=liabilities,proto3" json:"liabilities,omitempty"`
+	Ext         *AccountEntryExtensionV1Ext `protobuf:"bytes,2,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *AccountEntryExtensionV1) Reset() {
+	*x = AccountEntryExtensionV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntryExtensionV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntryExtensionV1) ProtoMessage() {}
+
+func (x *AccountEntryExtensionV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntryExtensionV1.ProtoReflect.Descriptor instead.
+func (*AccountEntryExtensionV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountEntryExtensionV1) GetLiabilities() *Liabilities {
+	if x != nil {
+		return x.Liabilities
+	}
+	return nil
+}
+
+func (x *AccountEntryExtensionV1) GetExt() *AccountEntryExtensionV1Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type AccountEntryExtensionV1Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                    `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V2 *AccountEntryExtensionV2 `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
+}
+
+func (x *AccountEntryExtensionV1Ext) Reset() {
+	*x = AccountEntryExtensionV1Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntryExtensionV1Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntryExtensionV1Ext) ProtoMessage() {}
+
+func (x *AccountEntryExtensionV1Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntryExtensionV1Ext.ProtoReflect.Descriptor instead.
+func (*AccountEntryExtensionV1Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccountEntryExtensionV1Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *AccountEntryExtensionV1Ext) GetV2() *AccountEntryExtensionV2 {
+	if x != nil {
+		return x.V2
+	}
+	return nil
+}
+
+type AccountEntryExtensionV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumSponsored        uint32                      `protobuf:"varint,1,opt,name=num_sponsored,proto3" json:"num_sponsored,omitempty"`
+	NumSponsoring       uint32                      `protobuf:"varint,2,opt,name=num_sponsoring,proto3" json:"num_sponsoring,omitempty"`
+	SignerSponsoringIds []*AccountId                `protobuf:"bytes,3,rep,name=signer_sponsoring_ids,proto3" json:"signer_sponsoring_ids,omitempty"`
+	Ext                 *AccountEntryExtensionV2Ext `protobuf:"bytes,4,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *AccountEntryExtensionV2) Reset() {
+	*x = AccountEntryExtensionV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntryExtensionV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntryExtensionV2) ProtoMessage() {}
+
+func (x *AccountEntryExtensionV2) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntryExtensionV2.ProtoReflect.Descriptor instead.
+func (*AccountEntryExtensionV2) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccountEntryExtensionV2) GetNumSponsored() uint32 {
+	if x != nil {
+		return x.NumSponsored
+	}
+	return 0
+}
+
+func (x *AccountEntryExtensionV2) GetNumSponsoring() uint32 {
+	if x != nil {
+		return x.NumSponsoring
+	}
+	return 0
+}
+
+func (x *AccountEntryExtensionV2) GetSignerSponsoringIds() []*AccountId {
+	if x != nil {
+		return x.SignerSponsoringIds
+	}
+	return nil
+}
+
+func (x *AccountEntryExtensionV2) GetExt() *AccountEntryExtensionV2Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type AccountEntryExtensionV2Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                    `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V3 *AccountEntryExtensionV3 `protobuf:"bytes,2,opt,name=v3,proto3" json:"v3,omitempty"`
+}
+
+func (x *AccountEntryExtensionV2Ext) Reset() {
+	*x = AccountEntryExtensionV2Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntryExtensionV2Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntryExtensionV2Ext) ProtoMessage() {}
+
+func (x *AccountEntryExtensionV2Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntryExtensionV2Ext.ProtoReflect.Descriptor instead.
+func (*AccountEntryExtensionV2Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AccountEntryExtensionV2Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *AccountEntryExtensionV2Ext) GetV3() *AccountEntryExtensionV3 {
+	if x != nil {
+		return x.V3
+	}
+	return nil
+}
+
+type AccountEntryExtensionV3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext       *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	SeqLedger uint32          `protobuf:"varint,2,opt,name=seq_ledger,proto3" json:"seq_ledger,omitempty"`
+	SeqTime   uint64          `protobuf:"varint,3,opt,name=seq_time,proto3" json:"seq_time,omitempty"`
+}
+
+func (x *AccountEntryExtensionV3) Reset() {
+	*x = AccountEntryExtensionV3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEntryExtensionV3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEntryExtensionV3) ProtoMessage() {}
+
+func (x *AccountEntryExtensionV3) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEntryExtensionV3.ProtoReflect.Descriptor instead.
+func (*AccountEntryExtensionV3) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AccountEntryExtensionV3) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *AccountEntryExtensionV3) GetSeqLedger() uint32 {
+	if x != nil {
+		return x.SeqLedger
+	}
+	return 0
+}
+
+func (x *AccountEntryExtensionV3) GetSeqTime() uint64 {
+	if x != nil {
+		return x.SeqTime
+	}
+	return 0
+}
+
+type AccountId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AccountId) Reset() {
+	*x = AccountId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountId) ProtoMessage() {}
+
+func (x *AccountId) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountId.ProtoReflect.Descriptor instead.
+func (*AccountId) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountId) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AccountMergeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code                 int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName             string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	SourceAccountBalance *int64 `protobuf:"varint,3,opt,name=source_account_balance,proto3,oneof" json:"source_account_balance,omitempty"`
+}
+
+func (x *AccountMergeResult) Reset() {
+	*x = AccountMergeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMergeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMergeResult) ProtoMessage() {}
+
+func (x *AccountMergeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMergeResult.ProtoReflect.Descriptor instead.
+func (*AccountMergeResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AccountMergeResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AccountMergeResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *AccountMergeResult) GetSourceAccountBalance() int64 {
+	if x != nil && x.SourceAccountBalance != nil {
+		return *x.SourceAccountBalance
+	}
+	return 0
+}
+
+type AllowTrustOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trustor   *AccountId `protobuf:"bytes,1,opt,name=trustor,proto3" json:"trustor,omitempty"`
+	AssetCode []byte     `protobuf:"bytes,2,opt,name=asset_code,proto3" json:"asset_code,omitempty"`
+	Authorize uint32     `protobuf:"varint,3,opt,name=authorize,proto3" json:"authorize,omitempty"`
+}
+
+func (x *AllowTrustOp) Reset() {
+	*x = AllowTrustOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowTrustOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowTrustOp) ProtoMessage() {}
+
+func (x *AllowTrustOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowTrustOp.ProtoReflect.Descriptor instead.
+func (*AllowTrustOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AllowTrustOp) GetTrustor() *AccountId {
+	if x != nil {
+		return x.Trustor
+	}
+	return nil
+}
+
+func (x *AllowTrustOp) GetAssetCode() []byte {
+	if x != nil {
+		return x.AssetCode
+	}
+	return nil
+}
+
+func (x *AllowTrustOp) GetAuthorize() uint32 {
+	if x != nil {
+		return x.Authorize
+	}
+	return 0
+}
+
+type AllowTrustResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *AllowTrustResult) Reset() {
+	*x = AllowTrustResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowTrustResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowTrustResult) ProtoMessage() {}
+
+func (x *AllowTrustResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowTrustResult.ProtoReflect.Descriptor instead.
+func (*AllowTrustResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AllowTrustResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AllowTrustResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type Asset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetType       string     `protobuf:"bytes,1,opt,name=asset_type,proto3" json:"asset_type,omitempty"`
+	AssetCode       []byte     `protobuf:"bytes,2,opt,name=asset_code,proto3" json:"asset_code,omitempty"`
+	Issuer          *AccountId `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Code            string     `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Canonical       string     `protobuf:"bytes,5,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Sep11           string     `protobuf:"bytes,6,opt,name=sep11,proto3" json:"sep11,omitempty"`
+	LiquidityPoolId string     `protobuf:"bytes,7,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Asset) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *Asset) GetAssetCode() []byte {
+	if x != nil {
+		return x.AssetCode
+	}
+	return nil
+}
+
+func (x *Asset) GetIssuer() *AccountId {
+	if x != nil {
+		return x.Issuer
+	}
+	return nil
+}
+
+func (x *Asset) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Asset) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+func (x *Asset) GetSep11() string {
+	if x != nil {
+		return x.Sep11
+	}
+	return ""
+}
+
+func (x *Asset) GetLiquidityPoolId() string {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return ""
+}
+
+type BeginSponsoringFutureReservesOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SponsoredId *AccountId `protobuf:"bytes,1,opt,name=sponsored_id,proto3" json:"sponsored_id,omitempty"`
+}
+
+func (x *BeginSponsoringFutureReservesOp) Reset() {
+	*x = BeginSponsoringFutureReservesOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginSponsoringFutureReservesOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginSponsoringFutureReservesOp) ProtoMessage() {}
+
+func (x *BeginSponsoringFutureReservesOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginSponsoringFutureReservesOp.ProtoReflect.Descriptor instead.
+func (*BeginSponsoringFutureReservesOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BeginSponsoringFutureReservesOp) GetSponsoredId() *AccountId {
+	if x != nil {
+		return x.SponsoredId
+	}
+	return nil
+}
+
+type BeginSponsoringFutureReservesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *BeginSponsoringFutureReservesResult) Reset() {
+	*x = BeginSponsoringFutureReservesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginSponsoringFutureReservesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginSponsoringFutureReservesResult) ProtoMessage() {}
+
+func (x *BeginSponsoringFutureReservesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginSponsoringFutureReservesResult.ProtoReflect.Descriptor instead.
+func (*BeginSponsoringFutureReservesResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BeginSponsoringFutureReservesResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BeginSponsoringFutureReservesResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type BumpSequenceOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BumpTo int64 `protobuf:"varint,1,opt,name=bump_to,proto3" json:"bump_to,omitempty"`
+}
+
+func (x *BumpSequenceOp) Reset() {
+	*x = BumpSequenceOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpSequenceOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpSequenceOp) ProtoMessage() {}
+
+func (x *BumpSequenceOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpSequenceOp.ProtoReflect.Descriptor instead.
+func (*BumpSequenceOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BumpSequenceOp) GetBumpTo() int64 {
+	if x != nil {
+		return x.BumpTo
+	}
+	return 0
+}
+
+type BumpSequenceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *BumpSequenceResult) Reset() {
+	*x = BumpSequenceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpSequenceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpSequenceResult) ProtoMessage() {}
+
+func (x *BumpSequenceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpSequenceResult.ProtoReflect.Descriptor instead.
+func (*BumpSequenceResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BumpSequenceResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BumpSequenceResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type BurnEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   string       `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Amount *Int128Parts `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *BurnEvent) Reset() {
+	*x = BurnEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnEvent) ProtoMessage() {}
+
+func (x *BurnEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnEvent.ProtoReflect.Descriptor instead.
+func (*BurnEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BurnEvent) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BurnEvent) GetAmount() *Int128Parts {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ChangeTrustAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset         *Asset                   `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	LiquidityPool *LiquidityPoolParameters `protobuf:"bytes,2,opt,name=liquidity_pool,proto3" json:"liquidity_pool,omitempty"`
+}
+
+func (x *ChangeTrustAsset) Reset() {
+	*x = ChangeTrustAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeTrustAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTrustAsset) ProtoMessage() {}
+
+func (x *ChangeTrustAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTrustAsset.ProtoReflect.Descriptor instead.
+func (*ChangeTrustAsset) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChangeTrustAsset) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *ChangeTrustAsset) GetLiquidityPool() *LiquidityPoolParameters {
+	if x != nil {
+		return x.LiquidityPool
+	}
+	return nil
+}
+
+type ChangeTrustOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line  *ChangeTrustAsset `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Limit int64             `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ChangeTrustOp) Reset() {
+	*x = ChangeTrustOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeTrustOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTrustOp) ProtoMessage() {}
+
+func (x *ChangeTrustOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTrustOp.ProtoReflect.Descriptor instead.
+func (*ChangeTrustOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChangeTrustOp) GetLine() *ChangeTrustAsset {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *ChangeTrustOp) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ChangeTrustResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ChangeTrustResult) Reset() {
+	*x = ChangeTrustResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeTrustResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTrustResult) ProtoMessage() {}
+
+func (x *ChangeTrustResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTrustResult.ProtoReflect.Descriptor instead.
+func (*ChangeTrustResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChangeTrustResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ChangeTrustResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ClaimAtom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V0            *ClaimOfferAtomV0   `protobuf:"bytes,1,opt,name=v0,proto3" json:"v0,omitempty"`
+	OrderBook     *ClaimOfferAtom     `protobuf:"bytes,2,opt,name=order_book,proto3" json:"order_book,omitempty"`
+	LiquidityPool *ClaimLiquidityAtom `protobuf:"bytes,3,opt,name=liquidity_pool,proto3" json:"liquidity_pool,omitempty"`
+}
+
+func (x *ClaimAtom) Reset() {
+	*x = ClaimAtom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimAtom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAtom) ProtoMessage() {}
+
+func (x *ClaimAtom) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAtom.ProtoReflect.Descriptor instead.
+func (*ClaimAtom) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClaimAtom) GetV0() *ClaimOfferAtomV0 {
+	if x != nil {
+		return x.V0
+	}
+	return nil
+}
+
+func (x *ClaimAtom) GetOrderBook() *ClaimOfferAtom {
+	if x != nil {
+		return x.OrderBook
+	}
+	return nil
+}
+
+func (x *ClaimAtom) GetLiquidityPool() *ClaimLiquidityAtom {
+	if x != nil {
+		return x.LiquidityPool
+	}
+	return nil
+}
+
+type ClaimClaimableBalanceOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BalanceId *ClaimableBalanceId `protobuf:"bytes,1,opt,name=balance_id,proto3" json:"balance_id,omitempty"`
+}
+
+func (x *ClaimClaimableBalanceOp) Reset() {
+	*x = ClaimClaimableBalanceOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimClaimableBalanceOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimClaimableBalanceOp) ProtoMessage() {}
+
+func (x *ClaimClaimableBalanceOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimClaimableBalanceOp.ProtoReflect.Descriptor instead.
+func (*ClaimClaimableBalanceOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClaimClaimableBalanceOp) GetBalanceId() *ClaimableBalanceId {
+	if x != nil {
+		return x.BalanceId
+	}
+	return nil
+}
+
+type ClaimClaimableBalanceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ClaimClaimableBalanceResult) Reset() {
+	*x = ClaimClaimableBalanceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimClaimableBalanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimClaimableBalanceResult) ProtoMessage() {}
+
+func (x *ClaimClaimableBalanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimClaimableBalanceResult.ProtoReflect.Descriptor instead.
+func (*ClaimClaimableBalanceResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ClaimClaimableBalanceResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ClaimClaimableBalanceResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ClaimLiquidityAtom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiquidityPoolId []byte `protobuf:"bytes,1,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+	AssetSold       *Asset `protobuf:"bytes,2,opt,name=asset_sold,proto3" json:"asset_sold,omitempty"`
+	AmountSold      int64  `protobuf:"varint,3,opt,name=amount_sold,proto3" json:"amount_sold,omitempty"`
+	AssetBought     *Asset `protobuf:"bytes,4,opt,name=asset_bought,proto3" json:"asset_bought,omitempty"`
+	AmountBought    int64  `protobuf:"varint,5,opt,name=amount_bought,proto3" json:"amount_bought,omitempty"`
+}
+
+func (x *ClaimLiquidityAtom) Reset() {
+	*x = ClaimLiquidityAtom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimLiquidityAtom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimLiquidityAtom) ProtoMessage() {}
+
+func (x *ClaimLiquidityAtom) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimLiquidityAtom.ProtoReflect.Descriptor instead.
+func (*ClaimLiquidityAtom) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClaimLiquidityAtom) GetLiquidityPoolId() []byte {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return nil
+}
+
+func (x *ClaimLiquidityAtom) GetAssetSold() *Asset {
+	if x != nil {
+		return x.AssetSold
+	}
+	return nil
+}
+
+func (x *ClaimLiquidityAtom) GetAmountSold() int64 {
+	if x != nil {
+		return x.AmountSold
+	}
+	return 0
+}
+
+func (x *ClaimLiquidityAtom) GetAssetBought() *Asset {
+	if x != nil {
+		return x.AssetBought
+	}
+	return nil
+}
+
+func (x *ClaimLiquidityAtom) GetAmountBought() int64 {
+	if x != nil {
+		return x.AmountBought
+	}
+	return 0
+}
+
+type ClaimOfferAtom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SellerId     *AccountId `protobuf:"bytes,1,opt,name=seller_id,proto3" json:"seller_id,omitempty"`
+	OfferId      int64      `protobuf:"varint,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+	AssetSold    *Asset     `protobuf:"bytes,3,opt,name=asset_sold,proto3" json:"asset_sold,omitempty"`
+	AmountSold   int64      `protobuf:"varint,4,opt,name=amount_sold,proto3" json:"amount_sold,omitempty"`
+	AssetBought  *Asset     `protobuf:"bytes,5,opt,name=asset_bought,proto3" json:"asset_bought,omitempty"`
+	AmountBought int64      `protobuf:"varint,6,opt,name=amount_bought,proto3" json:"amount_bought,omitempty"`
+}
+
+func (x *ClaimOfferAtom) Reset() {
+	*x = ClaimOfferAtom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimOfferAtom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimOfferAtom) ProtoMessage() {}
+
+func (x *ClaimOfferAtom) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimOfferAtom.ProtoReflect.Descriptor instead.
+func (*ClaimOfferAtom) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClaimOfferAtom) GetSellerId() *AccountId {
+	if x != nil {
+		return x.SellerId
+	}
+	return nil
+}
+
+func (x *ClaimOfferAtom) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *ClaimOfferAtom) GetAssetSold() *Asset {
+	if x != nil {
+		return x.AssetSold
+	}
+	return nil
+}
+
+func (x *ClaimOfferAtom) GetAmountSold() int64 {
+	if x != nil {
+		return x.AmountSold
+	}
+	return 0
+}
+
+func (x *ClaimOfferAtom) GetAssetBought() *Asset {
+	if x != nil {
+		return x.AssetBought
+	}
+	return nil
+}
+
+func (x *ClaimOfferAtom) GetAmountBought() int64 {
+	if x != nil {
+		return x.AmountBought
+	}
+	return 0
+}
+
+type ClaimOfferAtomV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SellerEd25519 string `protobuf:"bytes,1,opt,name=seller_ed25519,proto3" json:"seller_ed25519,omitempty"`
+	OfferId       int64  `protobuf:"varint,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+	AssetSold     *Asset `protobuf:"bytes,3,opt,name=asset_sold,proto3" json:"asset_sold,omitempty"`
+	AmountSold    int64  `protobuf:"varint,4,opt,name=amount_sold,proto3" json:"amount_sold,omitempty"`
+	AssetBought   *Asset `protobuf:"bytes,5,opt,name=asset_bought,proto3" json:"asset_bought,omitempty"`
+	AmountBought  int64  `protobuf:"varint,6,opt,name=amount_bought,proto3" json:"amount_bought,omitempty"`
+}
+
+func (x *ClaimOfferAtomV0) Reset() {
+	*x = ClaimOfferAtomV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimOfferAtomV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimOfferAtomV0) ProtoMessage() {}
+
+func (x *ClaimOfferAtomV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimOfferAtomV0.ProtoReflect.Descriptor instead.
+func (*ClaimOfferAtomV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClaimOfferAtomV0) GetSellerEd25519() string {
+	if x != nil {
+		return x.SellerEd25519
+	}
+	return ""
+}
+
+func (x *ClaimOfferAtomV0) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *ClaimOfferAtomV0) GetAssetSold() *Asset {
+	if x != nil {
+		return x.AssetSold
+	}
+	return nil
+}
+
+func (x *ClaimOfferAtomV0) GetAmountSold() int64 {
+	if x != nil {
+		return x.AmountSold
+	}
+	return 0
+}
+
+func (x *ClaimOfferAtomV0) GetAssetBought() *Asset {
+	if x != nil {
+		return x.AssetBought
+	}
+	return nil
+}
+
+func (x *ClaimOfferAtomV0) GetAmountBought() int64 {
+	if x != nil {
+		return x.AmountBought
+	}
+	return 0
+}
+
+type ClaimPredicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AndPredicates  []*ClaimPredicate      `protobuf:"bytes,1,rep,name=and_predicates,proto3" json:"and_predicates,omitempty"`
+	OrPredicates   []*ClaimPredicate      `protobuf:"bytes,2,rep,name=or_predicates,proto3" json:"or_predicates,omitempty"`
+	NotPredicates  *ClaimPredicate        `protobuf:"bytes,3,opt,name=not_predicates,proto3" json:"not_predicates,omitempty"`
+	AbsBefore      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=abs_before,proto3" json:"abs_before,omitempty"`
+	AbsBeforeEpoch *int64                 `protobuf:"varint,5,opt,name=abs_before_epoch,proto3,oneof" json:"abs_before_epoch,omitempty"`
+	RelBefore      *int64                 `protobuf:"varint,6,opt,name=rel_before,proto3,oneof" json:"rel_before,omitempty"`
+}
+
+func (x *ClaimPredicate) Reset() {
+	*x = ClaimPredicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimPredicate) ProtoMessage() {}
+
+func (x *ClaimPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimPredicate.ProtoReflect.Descriptor instead.
+func (*ClaimPredicate) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClaimPredicate) GetAndPredicates() []*ClaimPredicate {
+	if x != nil {
+		return x.AndPredicates
+	}
+	return nil
+}
+
+func (x *ClaimPredicate) GetOrPredicates() []*ClaimPredicate {
+	if x != nil {
+		return x.OrPredicates
+	}
+	return nil
+}
+
+func (x *ClaimPredicate) GetNotPredicates() *ClaimPredicate {
+	if x != nil {
+		return x.NotPredicates
+	}
+	return nil
+}
+
+func (x *ClaimPredicate) GetAbsBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AbsBefore
+	}
+	return nil
+}
+
+func (x *ClaimPredicate) GetAbsBeforeEpoch() int64 {
+	if x != nil && x.AbsBeforeEpoch != nil {
+		return *x.AbsBeforeEpoch
+	}
+	return 0
+}
+
+func (x *ClaimPredicate) GetRelBefore() int64 {
+	if x != nil && x.RelBefore != nil {
+		return *x.RelBefore
+	}
+	return 0
+}
+
+type ClaimableBalanceEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BalanceId *ClaimableBalanceId       `protobuf:"bytes,1,opt,name=balance_id,proto3" json:"balance_id,omitempty"`
+	Claimants []*Claimant               `protobuf:"bytes,2,rep,name=claimants,proto3" json:"claimants,omitempty"`
+	Asset     *Asset                    `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount    int64                     `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Ext       *ClaimableBalanceEntryExt `protobuf:"bytes,5,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *ClaimableBalanceEntry) Reset() {
+	*x = ClaimableBalanceEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimableBalanceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableBalanceEntry) ProtoMessage() {}
+
+func (x *ClaimableBalanceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimableBalanceEntry.ProtoReflect.Descriptor instead.
+func (*ClaimableBalanceEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ClaimableBalanceEntry) GetBalanceId() *ClaimableBalanceId {
+	if x != nil {
+		return x.BalanceId
+	}
+	return nil
+}
+
+func (x *ClaimableBalanceEntry) GetClaimants() []*Claimant {
+	if x != nil {
+		return x.Claimants
+	}
+	return nil
+}
+
+func (x *ClaimableBalanceEntry) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *ClaimableBalanceEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ClaimableBalanceEntry) GetExt() *ClaimableBalanceEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type ClaimableBalanceEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                             `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V1 *ClaimableBalanceEntryExtensionV1 `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *ClaimableBalanceEntryExt) Reset() {
+	*x = ClaimableBalanceEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimableBalanceEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableBalanceEntryExt) ProtoMessage() {}
+
+func (x *ClaimableBalanceEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimableBalanceEntryExt.ProtoReflect.Descriptor instead.
+func (*ClaimableBalanceEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ClaimableBalanceEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *ClaimableBalanceEntryExt) GetV1() *ClaimableBalanceEntryExtensionV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type ClaimableBalanceEntryExtensionV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     uint32                               `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	FlagNames []string                             `protobuf:"bytes,2,rep,name=flag_names,proto3" json:"flag_names,omitempty"`
+	Ext       *ClaimableBalanceEntryExtensionV1Ext `protobuf:"bytes,3,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *ClaimableBalanceEntryExtensionV1) Reset() {
+	*x = ClaimableBalanceEntryExtensionV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimableBalanceEntryExtensionV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableBalanceEntryExtensionV1) ProtoMessage() {}
+
+func (x *ClaimableBalanceEntryExtensionV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimableBalanceEntryExtensionV1.ProtoReflect.Descriptor instead.
+func (*ClaimableBalanceEntryExtensionV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClaimableBalanceEntryExtensionV1) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *ClaimableBalanceEntryExtensionV1) GetFlagNames() []string {
+	if x != nil {
+		return x.FlagNames
+	}
+	return nil
+}
+
+func (x *ClaimableBalanceEntryExtensionV1) GetExt() *ClaimableBalanceEntryExtensionV1Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type ClaimableBalanceEntryExtensionV1Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *ClaimableBalanceEntryExtensionV1Ext) Reset() {
+	*x = ClaimableBalanceEntryExtensionV1Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimableBalanceEntryExtensionV1Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableBalanceEntryExtensionV1Ext) ProtoMessage() {}
+
+func (x *ClaimableBalanceEntryExtensionV1Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimableBalanceEntryExtensionV1Ext.ProtoReflect.Descriptor instead.
+func (*ClaimableBalanceEntryExtensionV1Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClaimableBalanceEntryExtensionV1Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type ClaimableBalanceId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V0     *string `protobuf:"bytes,1,opt,name=v0,proto3,oneof" json:"v0,omitempty"`
+	Hex    string  `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+	Strkey string  `protobuf:"bytes,3,opt,name=strkey,proto3" json:"strkey,omitempty"`
+}
+
+func (x *ClaimableBalanceId) Reset() {
+	*x = ClaimableBalanceId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimableBalanceId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableBalanceId) ProtoMessage() {}
+
+func (x *ClaimableBalanceId) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimableBalanceId.ProtoReflect.Descriptor instead.
+func (*ClaimableBalanceId) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClaimableBalanceId) GetV0() string {
+	if x != nil && x.V0 != nil {
+		return *x.V0
+	}
+	return ""
+}
+
+func (x *ClaimableBalanceId) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *ClaimableBalanceId) GetStrkey() string {
+	if x != nil {
+		return x.Strkey
+	}
+	return ""
+}
+
+type Claimant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V0 *ClaimantV0 `protobuf:"bytes,1,opt,name=v0,proto3" json:"v0,omitempty"`
+}
+
+func (x *Claimant) Reset() {
+	*x = Claimant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Claimant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Claimant) ProtoMessage() {}
+
+func (x *Claimant) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Claimant.ProtoReflect.Descriptor instead.
+func (*Claimant) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Claimant) GetV0() *ClaimantV0 {
+	if x != nil {
+		return x.V0
+	}
+	return nil
+}
+
+type ClaimantV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination *AccountId      `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Predicate   *ClaimPredicate `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+}
+
+func (x *ClaimantV0) Reset() {
+	*x = ClaimantV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimantV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimantV0) ProtoMessage() {}
+
+func (x *ClaimantV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimantV0.ProtoReflect.Descriptor instead.
+func (*ClaimantV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClaimantV0) GetDestination() *AccountId {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *ClaimantV0) GetPredicate() *ClaimPredicate {
+	if x != nil {
+		return x.Predicate
+	}
+	return nil
+}
+
+type ClawbackClaimableBalanceOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BalanceId *ClaimableBalanceId `protobuf:"bytes,1,opt,name=balance_id,proto3" json:"balance_id,omitempty"`
+}
+
+func (x *ClawbackClaimableBalanceOp) Reset() {
+	*x = ClawbackClaimableBalanceOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClawbackClaimableBalanceOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClawbackClaimableBalanceOp) ProtoMessage() {}
+
+func (x *ClawbackClaimableBalanceOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClawbackClaimableBalanceOp.ProtoReflect.Descriptor instead.
+func (*ClawbackClaimableBalanceOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClawbackClaimableBalanceOp) GetBalanceId() *ClaimableBalanceId {
+	if x != nil {
+		return x.BalanceId
+	}
+	return nil
+}
+
+type ClawbackClaimableBalanceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ClawbackClaimableBalanceResult) Reset() {
+	*x = ClawbackClaimableBalanceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClawbackClaimableBalanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClawbackClaimableBalanceResult) ProtoMessage() {}
+
+func (x *ClawbackClaimableBalanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClawbackClaimableBalanceResult.ProtoReflect.Descriptor instead.
+func (*ClawbackClaimableBalanceResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ClawbackClaimableBalanceResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ClawbackClaimableBalanceResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ClawbackEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Admin  string       `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	From   string       `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Amount *Int128Parts `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ClawbackEvent) Reset() {
+	*x = ClawbackEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClawbackEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClawbackEvent) ProtoMessage() {}
+
+func (x *ClawbackEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClawbackEvent.ProtoReflect.Descriptor instead.
+func (*ClawbackEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClawbackEvent) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *ClawbackEvent) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ClawbackEvent) GetAmount() *Int128Parts {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ClawbackOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset  *Asset        `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	From   *MuxedAccount `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Amount int64         `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ClawbackOp) Reset() {
+	*x = ClawbackOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClawbackOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClawbackOp) ProtoMessage() {}
+
+func (x *ClawbackOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClawbackOp.ProtoReflect.Descriptor instead.
+func (*ClawbackOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ClawbackOp) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *ClawbackOp) GetFrom() *MuxedAccount {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ClawbackOp) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ClawbackResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ClawbackResult) Reset() {
+	*x = ClawbackResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClawbackResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClawbackResult) ProtoMessage() {}
+
+func (x *ClawbackResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClawbackResult.ProtoReflect.Descriptor instead.
+func (*ClawbackResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClawbackResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ClawbackResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ConfigSettingContractBandwidthV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LedgerMaxTxsSizeBytes uint32 `protobuf:"varint,1,opt,name=ledger_max_txs_size_bytes,proto3" json:"ledger_max_txs_size_bytes,omitempty"`
+	TxMaxSizeBytes        uint32 `protobuf:"varint,2,opt,name=tx_max_size_bytes,proto3" json:"tx_max_size_bytes,omitempty"`
+	FeeTxSize_1Kb         int64  `protobuf:"varint,3,opt,name=fee_tx_size_1kb,proto3" json:"fee_tx_size_1kb,omitempty"`
+}
+
+func (x *ConfigSettingContractBandwidthV0) Reset() {
+	*x = ConfigSettingContractBandwidthV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingContractBandwidthV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingContractBandwidthV0) ProtoMessage() {}
+
+func (x *ConfigSettingContractBandwidthV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingContractBandwidthV0.ProtoReflect.Descriptor instead.
+func (*ConfigSettingContractBandwidthV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ConfigSettingContractBandwidthV0) GetLedgerMaxTxsSizeBytes() uint32 {
+	if x != nil {
+		return x.LedgerMaxTxsSizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractBandwidthV0) GetTxMaxSizeBytes() uint32 {
+	if x != nil {
+		return x.TxMaxSizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractBandwidthV0) GetFeeTxSize_1Kb() int64 {
+	if x != nil {
+		return x.FeeTxSize_1Kb
+	}
+	return 0
+}
+
+type ConfigSettingContractComputeV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LedgerMaxInstructions           int64  `protobuf:"varint,1,opt,name=ledger_max_instructions,proto3" json:"ledger_max_instructions,omitempty"`
+	TxMaxInstructions               int64  `protobuf:"varint,2,opt,name=tx_max_instructions,proto3" json:"tx_max_instructions,omitempty"`
+	FeeRatePerInstructionsIncrement int64  `protobuf:"varint,3,opt,name=fee_rate_per_instructions_increment,proto3" json:"fee_rate_per_instructions_increment,omitempty"`
+	TxMemoryLimit                   uint32 `protobuf:"varint,4,opt,name=tx_memory_limit,proto3" json:"tx_memory_limit,omitempty"`
+}
+
+func (x *ConfigSettingContractComputeV0) Reset() {
+	*x = ConfigSettingContractComputeV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingContractComputeV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingContractComputeV0) ProtoMessage() {}
+
+func (x *ConfigSettingContractComputeV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingContractComputeV0.ProtoReflect.Descriptor instead.
+func (*ConfigSettingContractComputeV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ConfigSettingContractComputeV0) GetLedgerMaxInstructions() int64 {
+	if x != nil {
+		return x.LedgerMaxInstructions
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractComputeV0) GetTxMaxInstructions() int64 {
+	if x != nil {
+		return x.TxMaxInstructions
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractComputeV0) GetFeeRatePerInstructionsIncrement() int64 {
+	if x != nil {
+		return x.FeeRatePerInstructionsIncrement
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractComputeV0) GetTxMemoryLimit() uint32 {
+	if x != nil {
+		return x.TxMemoryLimit
+	}
+	return 0
+}
+
+type ConfigSettingContractEventsV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxMaxContractEventsSizeBytes uint32 `protobuf:"varint,1,opt,name=tx_max_contract_events_size_bytes,proto3" json:"tx_max_contract_events_size_bytes,omitempty"`
+	FeeContractEvents_1Kb        int64  `protobuf:"varint,2,opt,name=fee_contract_events_1kb,proto3" json:"fee_contract_events_1kb,omitempty"`
+}
+
+func (x *ConfigSettingContractEventsV0) Reset() {
+	*x = ConfigSettingContractEventsV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingContractEventsV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingContractEventsV0) ProtoMessage() {}
+
+func (x *ConfigSettingContractEventsV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingContractEventsV0.ProtoReflect.Descriptor instead.
+func (*ConfigSettingContractEventsV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConfigSettingContractEventsV0) GetTxMaxContractEventsSizeBytes() uint32 {
+	if x != nil {
+		return x.TxMaxContractEventsSizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractEventsV0) GetFeeContractEvents_1Kb() int64 {
+	if x != nil {
+		return x.FeeContractEvents_1Kb
+	}
+	return 0
+}
+
+type ConfigSettingContractExecutionLanesV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LedgerMaxTxCount uint32 `protobuf:"varint,1,opt,name=ledger_max_tx_count,proto3" json:"ledger_max_tx_count,omitempty"`
+}
+
+func (x *ConfigSettingContractExecutionLanesV0) Reset() {
+	*x = ConfigSettingContractExecutionLanesV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingContractExecutionLanesV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingContractExecutionLanesV0) ProtoMessage() {}
+
+func (x *ConfigSettingContractExecutionLanesV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingContractExecutionLanesV0.ProtoReflect.Descriptor instead.
+func (*ConfigSettingContractExecutionLanesV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ConfigSettingContractExecutionLanesV0) GetLedgerMaxTxCount() uint32 {
+	if x != nil {
+		return x.LedgerMaxTxCount
+	}
+	return 0
+}
+
+type ConfigSettingContractHistoricalDataV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeHistorical_1Kb int64 `protobuf:"varint,1,opt,name=fee_historical_1kb,proto3" json:"fee_historical_1kb,omitempty"`
+}
+
+func (x *ConfigSettingContractHistoricalDataV0) Reset() {
+	*x = ConfigSettingContractHistoricalDataV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingContractHistoricalDataV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingContractHistoricalDataV0) ProtoMessage() {}
+
+func (x *ConfigSettingContractHistoricalDataV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingContractHistoricalDataV0.ProtoReflect.Descriptor instead.
+func (*ConfigSettingContractHistoricalDataV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ConfigSettingContractHistoricalDataV0) GetFeeHistorical_1Kb() int64 {
+	if x != nil {
+		return x.FeeHistorical_1Kb
+	}
+	return 0
+}
+
+type ConfigSettingContractLedgerCostV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LedgerMaxReadLedgerEntries     uint32 `protobuf:"varint,1,opt,name=ledger_max_read_ledger_entries,proto3" json:"ledger_max_read_ledger_entries,omitempty"`
+	LedgerMaxReadBytes             uint32 `protobuf:"varint,2,opt,name=ledger_max_read_bytes,proto3" json:"ledger_max_read_bytes,omitempty"`
+	LedgerMaxWriteLedgerEntries    uint32 `protobuf:"varint,3,opt,name=ledger_max_write_ledger_entries,proto3" json:"ledger_max_write_ledger_entries,omitempty"`
+	LedgerMaxWriteBytes            uint32 `protobuf:"varint,4,opt,name=ledger_max_write_bytes,proto3" json:"ledger_max_write_bytes,omitempty"`
+	TxMaxReadLedgerEntries         uint32 `protobuf:"varint,5,opt,name=tx_max_read_ledger_entries,proto3" json:"tx_max_read_ledger_entries,omitempty"`
+	TxMaxReadBytes                 uint32 `protobuf:"varint,6,opt,name=tx_max_read_bytes,proto3" json:"tx_max_read_bytes,omitempty"`
+	TxMaxWriteLedgerEntries        uint32 `protobuf:"varint,7,opt,name=tx_max_write_ledger_entries,proto3" json:"tx_max_write_ledger_entries,omitempty"`
+	TxMaxWriteBytes                uint32 `protobuf:"varint,8,opt,name=tx_max_write_bytes,proto3" json:"tx_max_write_bytes,omitempty"`
+	FeeReadLedgerEntry             int64  `protobuf:"varint,9,opt,name=fee_read_ledger_entry,proto3" json:"fee_read_ledger_entry,omitempty"`
+	FeeWriteLedgerEntry            int64  `protobuf:"varint,10,opt,name=fee_write_ledger_entry,proto3" json:"fee_write_ledger_entry,omitempty"`
+	FeeRead_1Kb                    int64  `protobuf:"varint,11,opt,name=fee_read_1kb,proto3" json:"fee_read_1kb,omitempty"`
+	BucketListTargetSizeBytes      int64  `protobuf:"varint,12,opt,name=bucket_list_target_size_bytes,proto3" json:"bucket_list_target_size_bytes,omitempty"`
+	WriteFee_1KbBucketListLow      int64  `protobuf:"varint,13,opt,name=write_fee_1kb_bucket_list_low,proto3" json:"write_fee_1kb_bucket_list_low,omitempty"`
+	WriteFee_1KbBucketListHigh     int64  `protobuf:"varint,14,opt,name=write_fee_1kb_bucket_list_high,proto3" json:"write_fee_1kb_bucket_list_high,omitempty"`
+	BucketListWriteFeeGrowthFactor uint32 `protobuf:"varint,15,opt,name=bucket_list_write_fee_growth_factor,proto3" json:"bucket_list_write_fee_growth_factor,omitempty"`
+}
+
+func (x *ConfigSettingContractLedgerCostV0) Reset() {
+	*x = ConfigSettingContractLedgerCostV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingContractLedgerCostV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingContractLedgerCostV0) ProtoMessage() {}
+
+func (x *ConfigSettingContractLedgerCostV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingContractLedgerCostV0.ProtoReflect.Descriptor instead.
+func (*ConfigSettingContractLedgerCostV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetLedgerMaxReadLedgerEntries() uint32 {
+	if x != nil {
+		return x.LedgerMaxReadLedgerEntries
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetLedgerMaxReadBytes() uint32 {
+	if x != nil {
+		return x.LedgerMaxReadBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetLedgerMaxWriteLedgerEntries() uint32 {
+	if x != nil {
+		return x.LedgerMaxWriteLedgerEntries
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetLedgerMaxWriteBytes() uint32 {
+	if x != nil {
+		return x.LedgerMaxWriteBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetTxMaxReadLedgerEntries() uint32 {
+	if x != nil {
+		return x.TxMaxReadLedgerEntries
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetTxMaxReadBytes() uint32 {
+	if x != nil {
+		return x.TxMaxReadBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetTxMaxWriteLedgerEntries() uint32 {
+	if x != nil {
+		return x.TxMaxWriteLedgerEntries
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetTxMaxWriteBytes() uint32 {
+	if x != nil {
+		return x.TxMaxWriteBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetFeeReadLedgerEntry() int64 {
+	if x != nil {
+		return x.FeeReadLedgerEntry
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetFeeWriteLedgerEntry() int64 {
+	if x != nil {
+		return x.FeeWriteLedgerEntry
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetFeeRead_1Kb() int64 {
+	if x != nil {
+		return x.FeeRead_1Kb
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetBucketListTargetSizeBytes() int64 {
+	if x != nil {
+		return x.BucketListTargetSizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetWriteFee_1KbBucketListLow() int64 {
+	if x != nil {
+		return x.WriteFee_1KbBucketListLow
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetWriteFee_1KbBucketListHigh() int64 {
+	if x != nil {
+		return x.WriteFee_1KbBucketListHigh
+	}
+	return 0
+}
+
+func (x *ConfigSettingContractLedgerCostV0) GetBucketListWriteFeeGrowthFactor() uint32 {
+	if x != nil {
+		return x.BucketListWriteFeeGrowthFactor
+	}
+	return 0
+}
+
+type ConfigSettingEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigSettingId            int32                                  `protobuf:"varint,1,opt,name=config_setting_id,proto3" json:"config_setting_id,omitempty"`
+	ConfigSettingIdName        string                                 `protobuf:"bytes,2,opt,name=config_setting_id_name,proto3" json:"config_setting_id_name,omitempty"`
+	ContractMaxSizeBytes       *uint32                                `protobuf:"varint,3,opt,name=contract_max_size_bytes,proto3,oneof" json:"contract_max_size_bytes,omitempty"`
+	ContractCompute            *ConfigSettingContractComputeV0        `protobuf:"bytes,4,opt,name=contract_compute,proto3" json:"contract_compute,omitempty"`
+	ContractLedgerCost         *ConfigSettingContractLedgerCostV0     `protobuf:"bytes,5,opt,name=contract_ledger_cost,proto3" json:"contract_ledger_cost,omitempty"`
+	ContractHistoricalData     *ConfigSettingContractHistoricalDataV0 `protobuf:"bytes,6,opt,name=contract_historical_data,proto3" json:"contract_historical_data,omitempty"`
+	ContractEvents             *ConfigSettingContractEventsV0         `protobuf:"bytes,7,opt,name=contract_events,proto3" json:"contract_events,omitempty"`
+	ContractBandwith           *ConfigSettingContractBandwidthV0      `protobuf:"bytes,8,opt,name=contract_bandwith,proto3" json:"contract_bandwith,omitempty"`
+	ContractCostParamsCpuInsns []*ContractCostParamEntry              `protobuf:"bytes,9,rep,name=contract_cost_params_cpu_insns,proto3" json:"contract_cost_params_cpu_insns,omitempty"`
+	ContractCostParamsMemBytes []*ContractCostParamEntry              `protobuf:"bytes,10,rep,name=contract_cost_params_mem_bytes,proto3" json:"contract_cost_params_mem_bytes,omitempty"`
+	ContractDataKeySizeBytes   *uint32                                `protobuf:"varint,11,opt,name=contract_data_key_size_bytes,proto3,oneof" json:"contract_data_key_size_bytes,omitempty"`
+	ContractDataEntrySizeBytes *uint32                                `protobuf:"varint,12,opt,name=contract_data_entry_size_bytes,proto3,oneof" json:"contract_data_entry_size_bytes,omitempty"`
+	StateArchivalSettings      *StateArchivalSettings                 `protobuf:"bytes,13,opt,name=state_archival_settings,proto3" json:"state_archival_settings,omitempty"`
+	ContractExecuteLanes       *ConfigSettingContractExecutionLanesV0 `protobuf:"bytes,14,opt,name=contract_execute_lanes,proto3" json:"contract_execute_lanes,omitempty"`
+	BucketListSizeWindow       []uint64                               `protobuf:"varint,15,rep,packed,name=bucket_list_size_window,proto3" json:"bucket_list_size_window,omitempty"`
+	EvictionIterator           *EvictionIterator                      `protobuf:"bytes,16,opt,name=eviction_iterator,proto3" json:"eviction_iterator,omitempty"`
+}
+
+func (x *ConfigSettingEntry) Reset() {
+	*x = ConfigSettingEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSettingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSettingEntry) ProtoMessage() {}
+
+func (x *ConfigSettingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSettingEntry.ProtoReflect.Descriptor instead.
+func (*ConfigSettingEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ConfigSettingEntry) GetConfigSettingId() int32 {
+	if x != nil {
+		return x.ConfigSettingId
+	}
+	return 0
+}
+
+func (x *ConfigSettingEntry) GetConfigSettingIdName() string {
+	if x != nil {
+		return x.ConfigSettingIdName
+	}
+	return ""
+}
+
+func (x *ConfigSettingEntry) GetContractMaxSizeBytes() uint32 {
+	if x != nil && x.ContractMaxSizeBytes != nil {
+		return *x.ContractMaxSizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingEntry) GetContractCompute() *ConfigSettingContractComputeV0 {
+	if x != nil {
+		return x.ContractCompute
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractLedgerCost() *ConfigSettingContractLedgerCostV0 {
+	if x != nil {
+		return x.ContractLedgerCost
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractHistoricalData() *ConfigSettingContractHistoricalDataV0 {
+	if x != nil {
+		return x.ContractHistoricalData
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractEvents() *ConfigSettingContractEventsV0 {
+	if x != nil {
+		return x.ContractEvents
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractBandwith() *ConfigSettingContractBandwidthV0 {
+	if x != nil {
+		return x.ContractBandwith
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractCostParamsCpuInsns() []*ContractCostParamEntry {
+	if x != nil {
+		return x.ContractCostParamsCpuInsns
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractCostParamsMemBytes() []*ContractCostParamEntry {
+	if x != nil {
+		return x.ContractCostParamsMemBytes
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractDataKeySizeBytes() uint32 {
+	if x != nil && x.ContractDataKeySizeBytes != nil {
+		return *x.ContractDataKeySizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingEntry) GetContractDataEntrySizeBytes() uint32 {
+	if x != nil && x.ContractDataEntrySizeBytes != nil {
+		return *x.ContractDataEntrySizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigSettingEntry) GetStateArchivalSettings() *StateArchivalSettings {
+	if x != nil {
+		return x.StateArchivalSettings
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetContractExecuteLanes() *ConfigSettingContractExecutionLanesV0 {
+	if x != nil {
+		return x.ContractExecuteLanes
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetBucketListSizeWindow() []uint64 {
+	if x != nil {
+		return x.BucketListSizeWindow
+	}
+	return nil
+}
+
+func (x *ConfigSettingEntry) GetEvictionIterator() *EvictionIterator {
+	if x != nil {
+		return x.EvictionIterator
+	}
+	return nil
+}
+
+type ContractCodeCostInputs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext               *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	NInstructions     uint32          `protobuf:"varint,2,opt,name=n_instructions,proto3" json:"n_instructions,omitempty"`
+	NFunctions        uint32          `protobuf:"varint,3,opt,name=n_functions,proto3" json:"n_functions,omitempty"`
+	NGlobals          uint32          `protobuf:"varint,4,opt,name=n_globals,proto3" json:"n_globals,omitempty"`
+	NTableEntries     uint32          `protobuf:"varint,5,opt,name=n_table_entries,proto3" json:"n_table_entries,omitempty"`
+	NTypes            uint32          `protobuf:"varint,6,opt,name=n_types,proto3" json:"n_types,omitempty"`
+	NDataSegments     uint32          `protobuf:"varint,7,opt,name=n_data_segments,proto3" json:"n_data_segments,omitempty"`
+	NElemSegments     uint32          `protobuf:"varint,8,opt,name=n_elem_segments,proto3" json:"n_elem_segments,omitempty"`
+	NImports          uint32          `protobuf:"varint,9,opt,name=n_imports,proto3" json:"n_imports,omitempty"`
+	NExports          uint32          `protobuf:"varint,10,opt,name=n_exports,proto3" json:"n_exports,omitempty"`
+	NDataSegmentBytes uint32          `protobuf:"varint,11,opt,name=n_data_segment_bytes,proto3" json:"n_data_segment_bytes,omitempty"`
+}
+
+func (x *ContractCodeCostInputs) Reset() {
+	*x = ContractCodeCostInputs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCodeCostInputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCodeCostInputs) ProtoMessage() {}
+
+func (x *ContractCodeCostInputs) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCodeCostInputs.ProtoReflect.Descriptor instead.
+func (*ContractCodeCostInputs) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ContractCodeCostInputs) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ContractCodeCostInputs) GetNInstructions() uint32 {
+	if x != nil {
+		return x.NInstructions
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNFunctions() uint32 {
+	if x != nil {
+		return x.NFunctions
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNGlobals() uint32 {
+	if x != nil {
+		return x.NGlobals
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNTableEntries() uint32 {
+	if x != nil {
+		return x.NTableEntries
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNTypes() uint32 {
+	if x != nil {
+		return x.NTypes
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNDataSegments() uint32 {
+	if x != nil {
+		return x.NDataSegments
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNElemSegments() uint32 {
+	if x != nil {
+		return x.NElemSegments
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNImports() uint32 {
+	if x != nil {
+		return x.NImports
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNExports() uint32 {
+	if x != nil {
+		return x.NExports
+	}
+	return 0
+}
+
+func (x *ContractCodeCostInputs) GetNDataSegmentBytes() uint32 {
+	if x != nil {
+		return x.NDataSegmentBytes
+	}
+	return 0
+}
+
+type ContractCodeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext  *ContractCodeEntryExt `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	Hash string                `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Code []byte                `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ContractCodeEntry) Reset() {
+	*x = ContractCodeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCodeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCodeEntry) ProtoMessage() {}
+
+func (x *ContractCodeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCodeEntry.ProtoReflect.Descriptor instead.
+func (*ContractCodeEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ContractCodeEntry) GetExt() *ContractCodeEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ContractCodeEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ContractCodeEntry) GetCode() []byte {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+type ContractCodeEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V1 *ContractCodeEntryV1 `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *ContractCodeEntryExt) Reset() {
+	*x = ContractCodeEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCodeEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCodeEntryExt) ProtoMessage() {}
+
+func (x *ContractCodeEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCodeEntryExt.ProtoReflect.Descriptor instead.
+func (*ContractCodeEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ContractCodeEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *ContractCodeEntryExt) GetV1() *ContractCodeEntryV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type ContractCodeEntryV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext        *ExtensionPoint         `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	CostInputs *ContractCodeCostInputs `protobuf:"bytes,2,opt,name=cost_inputs,proto3" json:"cost_inputs,omitempty"`
+}
+
+func (x *ContractCodeEntryV1) Reset() {
+	*x = ContractCodeEntryV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCodeEntryV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCodeEntryV1) ProtoMessage() {}
+
+func (x *ContractCodeEntryV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCodeEntryV1.ProtoReflect.Descriptor instead.
+func (*ContractCodeEntryV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ContractCodeEntryV1) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ContractCodeEntryV1) GetCostInputs() *ContractCodeCostInputs {
+	if x != nil {
+		return x.CostInputs
+	}
+	return nil
+}
+
+type ContractCostParamEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext        *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	ConstTerm  int64           `protobuf:"varint,2,opt,name=const_term,proto3" json:"const_term,omitempty"`
+	LinearTerm int64           `protobuf:"varint,3,opt,name=linear_term,proto3" json:"linear_term,omitempty"`
+}
+
+func (x *ContractCostParamEntry) Reset() {
+	*x = ContractCostParamEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCostParamEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCostParamEntry) ProtoMessage() {}
+
+func (x *ContractCostParamEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCostParamEntry.ProtoReflect.Descriptor instead.
+func (*ContractCostParamEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ContractCostParamEntry) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ContractCostParamEntry) GetConstTerm() int64 {
+	if x != nil {
+		return x.ConstTerm
+	}
+	return 0
+}
+
+func (x *ContractCostParamEntry) GetLinearTerm() int64 {
+	if x != nil {
+		return x.LinearTerm
+	}
+	return 0
+}
+
+type ContractDataEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext            *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	Contract       *ScAddress      `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key            *ScVal          `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Durability     int32           `protobuf:"varint,4,opt,name=durability,proto3" json:"durability,omitempty"`
+	DurabilityName string          `protobuf:"bytes,5,opt,name=durability_name,proto3" json:"durability_name,omitempty"`
+	Val            *ScVal          `protobuf:"bytes,6,opt,name=val,proto3" json:"val,omitempty"`
+}
+
+func (x *ContractDataEntry) Reset() {
+	*x = ContractDataEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractDataEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractDataEntry) ProtoMessage() {}
+
+func (x *ContractDataEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractDataEntry.ProtoReflect.Descriptor instead.
+func (*ContractDataEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ContractDataEntry) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ContractDataEntry) GetContract() *ScAddress {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *ContractDataEntry) GetKey() *ScVal {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ContractDataEntry) GetDurability() int32 {
+	if x != nil {
+		return x.Durability
+	}
+	return 0
+}
+
+func (x *ContractDataEntry) GetDurabilityName() string {
+	if x != nil {
+		return x.DurabilityName
+	}
+	return ""
+}
+
+func (x *ContractDataEntry) GetVal() *ScVal {
+	if x != nil {
+		return x.Val
+	}
+	return nil
+}
+
+type ContractEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext                   *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	ContractId            *string         `protobuf:"bytes,2,opt,name=contract_id,proto3,oneof" json:"contract_id,omitempty"`
+	ContractEventType     int32           `protobuf:"varint,3,opt,name=contract_event_type,proto3" json:"contract_event_type,omitempty"`
+	ContractEventTypeName string          `protobuf:"bytes,4,opt,name=contract_event_type_name,proto3" json:"contract_event_type_name,omitempty"`
+	EventType             string          `protobuf:"bytes,5,opt,name=event_type,proto3" json:"event_type,omitempty"`
+	Transfer              *TransferEvent  `protobuf:"bytes,6,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Mint                  *MintEvent      `protobuf:"bytes,7,opt,name=mint,proto3" json:"mint,omitempty"`
+	ClawBack              *ClawbackEvent  `protobuf:"bytes,8,opt,name=claw_back,proto3" json:"claw_back,omitempty"`
+	Burn                  *BurnEvent      `protobuf:"bytes,9,opt,name=burn,proto3" json:"burn,omitempty"`
+}
+
+func (x *ContractEvent) Reset() {
+	*x = ContractEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractEvent) ProtoMessage() {}
+
+func (x *ContractEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractEvent.ProtoReflect.Descriptor instead.
+func (*ContractEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ContractEvent) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ContractEvent) GetContractId() string {
+	if x != nil && x.ContractId != nil {
+		return *x.ContractId
+	}
+	return ""
+}
+
+func (x *ContractEvent) GetContractEventType() int32 {
+	if x != nil {
+		return x.ContractEventType
+	}
+	return 0
+}
+
+func (x *ContractEvent) GetContractEventTypeName() string {
+	if x != nil {
+		return x.ContractEventTypeName
+	}
+	return ""
+}
+
+func (x *ContractEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ContractEvent) GetTransfer() *TransferEvent {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *ContractEvent) GetMint() *MintEvent {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *ContractEvent) GetClawBack() *ClawbackEvent {
+	if x != nil {
+		return x.ClawBack
+	}
+	return nil
+}
+
+func (x *ContractEvent) GetBurn() *BurnEvent {
+	if x != nil {
+		return x.Burn
+	}
+	return nil
+}
+
+type ContractExecutable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WasmHash *string `protobuf:"bytes,1,opt,name=wasm_hash,proto3,oneof" json:"wasm_hash,omitempty"`
+}
+
+func (x *ContractExecutable) Reset() {
+	*x = ContractExecutable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractExecutable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractExecutable) ProtoMessage() {}
+
+func (x *ContractExecutable) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractExecutable.ProtoReflect.Descriptor instead.
+func (*ContractExecutable) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ContractExecutable) GetWasmHash() string {
+	if x != nil && x.WasmHash != nil {
+		return *x.WasmHash
+	}
+	return ""
+}
+
+type ContractIdPreimage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromAddress *ContractIdPreimageFromAddress `protobuf:"bytes,1,opt,name=from_address,proto3" json:"from_address,omitempty"`
+	FromAsset   *Asset                         `protobuf:"bytes,2,opt,name=from_asset,proto3" json:"from_asset,omitempty"`
+}
+
+func (x *ContractIdPreimage) Reset() {
+	*x = ContractIdPreimage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractIdPreimage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractIdPreimage) ProtoMessage() {}
+
+func (x *ContractIdPreimage) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractIdPreimage.ProtoReflect.Descriptor instead.
+func (*ContractIdPreimage) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ContractIdPreimage) GetFromAddress() *ContractIdPreimageFromAddress {
+	if x != nil {
+		return x.FromAddress
+	}
+	return nil
+}
+
+func (x *ContractIdPreimage) GetFromAsset() *Asset {
+	if x != nil {
+		return x.FromAsset
+	}
+	return nil
+}
+
+type ContractIdPreimageFromAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *ScAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Salt    string     `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *ContractIdPreimageFromAddress) Reset() {
+	*x = ContractIdPreimageFromAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractIdPreimageFromAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractIdPreimageFromAddress) ProtoMessage() {}
+
+func (x *ContractIdPreimageFromAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractIdPreimageFromAddress.ProtoReflect.Descriptor instead.
+func (*ContractIdPreimageFromAddress) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ContractIdPreimageFromAddress) GetAddress() *ScAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ContractIdPreimageFromAddress) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+type CreateAccountOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination     *AccountId `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	StartingBalance int64      `protobuf:"varint,2,opt,name=starting_balance,proto3" json:"starting_balance,omitempty"`
+}
+
+func (x *CreateAccountOp) Reset() {
+	*x = CreateAccountOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAccountOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountOp) ProtoMessage() {}
+
+func (x *CreateAccountOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountOp.ProtoReflect.Descriptor instead.
+func (*CreateAccountOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateAccountOp) GetDestination() *AccountId {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *CreateAccountOp) GetStartingBalance() int64 {
+	if x != nil {
+		return x.StartingBalance
+	}
+	return 0
+}
+
+type CreateAccountResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *CreateAccountResult) Reset() {
+	*x = CreateAccountResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAccountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResult) ProtoMessage() {}
+
+func (x *CreateAccountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResult.ProtoReflect.Descriptor instead.
+func (*CreateAccountResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateAccountResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateAccountResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type CreateClaimableBalanceOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset     *Asset              `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount    int64               `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Claimants []*Claimant         `protobuf:"bytes,3,rep,name=claimants,proto3" json:"claimants,omitempty"`
+	BalanceId *ClaimableBalanceId `protobuf:"bytes,4,opt,name=balance_id,proto3" json:"balance_id,omitempty"`
+}
+
+func (x *CreateClaimableBalanceOp) Reset() {
+	*x = CreateClaimableBalanceOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClaimableBalanceOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClaimableBalanceOp) ProtoMessage() {}
+
+func (x *CreateClaimableBalanceOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClaimableBalanceOp.ProtoReflect.Descriptor instead.
+func (*CreateClaimableBalanceOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateClaimableBalanceOp) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *CreateClaimableBalanceOp) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateClaimableBalanceOp) GetClaimants() []*Claimant {
+	if x != nil {
+		return x.Claimants
+	}
+	return nil
+}
+
+func (x *CreateClaimableBalanceOp) GetBalanceId() *ClaimableBalanceId {
+	if x != nil {
+		return x.BalanceId
+	}
+	return nil
+}
+
+type CreateClaimableBalanceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName  string              `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	BalanceId *ClaimableBalanceId `protobuf:"bytes,3,opt,name=balance_id,proto3" json:"balance_id,omitempty"`
+}
+
+func (x *CreateClaimableBalanceResult) Reset() {
+	*x = CreateClaimableBalanceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClaimableBalanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClaimableBalanceResult) ProtoMessage() {}
+
+func (x *CreateClaimableBalanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClaimableBalanceResult.ProtoReflect.Descriptor instead.
+func (*CreateClaimableBalanceResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateClaimableBalanceResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateClaimableBalanceResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *CreateClaimableBalanceResult) GetBalanceId() *ClaimableBalanceId {
+	if x != nil {
+		return x.BalanceId
+	}
+	return nil
+}
+
+type CreateContractArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractIdPreimage *ContractIdPreimage `protobuf:"bytes,1,opt,name=contract_id_preimage,proto3" json:"contract_id_preimage,omitempty"`
+	Executable         *ContractExecutable `protobuf:"bytes,2,opt,name=executable,proto3" json:"executable,omitempty"`
+	ContractId         string              `protobuf:"bytes,3,opt,name=contract_id,proto3" json:"contract_id,omitempty"`
+}
+
+func (x *CreateContractArgs) Reset() {
+	*x = CreateContractArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContractArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractArgs) ProtoMessage() {}
+
+func (x *CreateContractArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractArgs.ProtoReflect.Descriptor instead.
+func (*CreateContractArgs) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateContractArgs) GetContractIdPreimage() *ContractIdPreimage {
+	if x != nil {
+		return x.ContractIdPreimage
+	}
+	return nil
+}
+
+func (x *CreateContractArgs) GetExecutable() *ContractExecutable {
+	if x != nil {
+		return x.Executable
+	}
+	return nil
+}
+
+func (x *CreateContractArgs) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+type CreatePassiveSellOfferOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selling *Asset `protobuf:"bytes,1,opt,name=selling,proto3" json:"selling,omitempty"`
+	Buying  *Asset `protobuf:"bytes,2,opt,name=buying,proto3" json:"buying,omitempty"`
+	Amount  int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price   *Price `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *CreatePassiveSellOfferOp) Reset() {
+	*x = CreatePassiveSellOfferOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePassiveSellOfferOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePassiveSellOfferOp) ProtoMessage() {}
+
+func (x *CreatePassiveSellOfferOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePassiveSellOfferOp.ProtoReflect.Descriptor instead.
+func (*CreatePassiveSellOfferOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreatePassiveSellOfferOp) GetSelling() *Asset {
+	if x != nil {
+		return x.Selling
+	}
+	return nil
+}
+
+func (x *CreatePassiveSellOfferOp) GetBuying() *Asset {
+	if x != nil {
+		return x.Buying
+	}
+	return nil
+}
+
+func (x *CreatePassiveSellOfferOp) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreatePassiveSellOfferOp) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type DataEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *AccountId    `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	DataName  string        `protobuf:"bytes,2,opt,name=data_name,proto3" json:"data_name,omitempty"`
+	DataValue []byte        `protobuf:"bytes,3,opt,name=data_value,proto3" json:"data_value,omitempty"`
+	Ext       *DataEntryExt `protobuf:"bytes,4,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *DataEntry) Reset() {
+	*x = DataEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataEntry) ProtoMessage() {}
+
+func (x *DataEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataEntry.ProtoReflect.Descriptor instead.
+func (*DataEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DataEntry) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *DataEntry) GetDataName() string {
+	if x != nil {
+		return x.DataName
+	}
+	return ""
+}
+
+func (x *DataEntry) GetDataValue() []byte {
+	if x != nil {
+		return x.DataValue
+	}
+	return nil
+}
+
+func (x *DataEntry) GetExt() *DataEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type DataEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *DataEntryExt) Reset() {
+	*x = DataEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataEntryExt) ProtoMessage() {}
+
+func (x *DataEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataEntryExt.ProtoReflect.Descriptor instead.
+func (*DataEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DataEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type DecoratedSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hint      []byte `protobuf:"bytes,1,opt,name=hint,proto3" json:"hint,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *DecoratedSignature) Reset() {
+	*x = DecoratedSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecoratedSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecoratedSignature) ProtoMessage() {}
+
+func (x *DecoratedSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecoratedSignature.ProtoReflect.Descriptor instead.
+func (*DecoratedSignature) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DecoratedSignature) GetHint() []byte {
+	if x != nil {
+		return x.Hint
+	}
+	return nil
+}
+
+func (x *DecoratedSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type DiagnosticEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InSuccessfulContractCall bool           `protobuf:"varint,1,opt,name=in_successful_contract_call,proto3" json:"in_successful_contract_call,omitempty"`
+	Event                    *ContractEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *DiagnosticEvent) Reset() {
+	*x = DiagnosticEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticEvent) ProtoMessage() {}
+
+func (x *DiagnosticEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticEvent.ProtoReflect.Descriptor instead.
+func (*DiagnosticEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DiagnosticEvent) GetInSuccessfulContractCall() bool {
+	if x != nil {
+		return x.InSuccessfulContractCall
+	}
+	return false
+}
+
+func (x *DiagnosticEvent) GetEvent() *ContractEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type EndSponsoringFutureReservesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *EndSponsoringFutureReservesResult) Reset() {
+	*x = EndSponsoringFutureReservesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndSponsoringFutureReservesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSponsoringFutureReservesResult) ProtoMessage() {}
+
+func (x *EndSponsoringFutureReservesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSponsoringFutureReservesResult.ProtoReflect.Descriptor instead.
+func (*EndSponsoringFutureReservesResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EndSponsoringFutureReservesResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EndSponsoringFutureReservesResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type EvictionIterator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketListLevel  uint32 `protobuf:"varint,1,opt,name=bucket_list_level,proto3" json:"bucket_list_level,omitempty"`
+	IsCurrBucket     bool   `protobuf:"varint,2,opt,name=is_curr_bucket,proto3" json:"is_curr_bucket,omitempty"`
+	BucketFileOffset uint64 `protobuf:"varint,3,opt,name=bucket_file_offset,proto3" json:"bucket_file_offset,omitempty"`
+}
+
+func (x *EvictionIterator) Reset() {
+	*x = EvictionIterator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictionIterator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictionIterator) ProtoMessage() {}
+
+func (x *EvictionIterator) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictionIterator.ProtoReflect.Descriptor instead.
+func (*EvictionIterator) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EvictionIterator) GetBucketListLevel() uint32 {
+	if x != nil {
+		return x.BucketListLevel
+	}
+	return 0
+}
+
+func (x *EvictionIterator) GetIsCurrBucket() bool {
+	if x != nil {
+		return x.IsCurrBucket
+	}
+	return false
+}
+
+func (x *EvictionIterator) GetBucketFileOffset() uint64 {
+	if x != nil {
+		return x.BucketFileOffset
+	}
+	return 0
+}
+
+type ExtendFootprintTtlOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext      *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	ExtendTo uint32          `protobuf:"varint,2,opt,name=extend_to,proto3" json:"extend_to,omitempty"`
+}
+
+func (x *ExtendFootprintTtlOp) Reset() {
+	*x = ExtendFootprintTtlOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendFootprintTtlOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendFootprintTtlOp) ProtoMessage() {}
+
+func (x *ExtendFootprintTtlOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendFootprintTtlOp.ProtoReflect.Descriptor instead.
+func (*ExtendFootprintTtlOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExtendFootprintTtlOp) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *ExtendFootprintTtlOp) GetExtendTo() uint32 {
+	if x != nil {
+		return x.ExtendTo
+	}
+	return 0
+}
+
+type ExtendFootprintTtlResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ExtendFootprintTtlResult) Reset() {
+	*x = ExtendFootprintTtlResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendFootprintTtlResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendFootprintTtlResult) ProtoMessage() {}
+
+func (x *ExtendFootprintTtlResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendFootprintTtlResult.ProtoReflect.Descriptor instead.
+func (*ExtendFootprintTtlResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExtendFootprintTtlResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ExtendFootprintTtlResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ExtensionPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *ExtensionPoint) Reset() {
+	*x = ExtensionPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionPoint) ProtoMessage() {}
+
+func (x *ExtensionPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionPoint.ProtoReflect.Descriptor instead.
+func (*ExtensionPoint) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExtensionPoint) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type FeeBumpTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeSource *MuxedAccount              `protobuf:"bytes,1,opt,name=fee_source,proto3" json:"fee_source,omitempty"`
+	Fee       int64                      `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	InnerTx   *FeeBumpTransactionInnerTx `protobuf:"bytes,3,opt,name=inner_tx,proto3" json:"inner_tx,omitempty"`
+	Ext       *FeeBumpTransactionExt     `protobuf:"bytes,4,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *FeeBumpTransaction) Reset() {
+	*x = FeeBumpTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBumpTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBumpTransaction) ProtoMessage() {}
+
+func (x *FeeBumpTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBumpTransaction.ProtoReflect.Descriptor instead.
+func (*FeeBumpTransaction) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FeeBumpTransaction) GetFeeSource() *MuxedAccount {
+	if x != nil {
+		return x.FeeSource
+	}
+	return nil
+}
+
+func (x *FeeBumpTransaction) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *FeeBumpTransaction) GetInnerTx() *FeeBumpTransactionInnerTx {
+	if x != nil {
+		return x.InnerTx
+	}
+	return nil
+}
+
+func (x *FeeBumpTransaction) GetExt() *FeeBumpTransactionExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type FeeBumpTransactionEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx         *FeeBumpTransaction   `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Signatures []*DecoratedSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *FeeBumpTransactionEnvelope) Reset() {
+	*x = FeeBumpTransactionEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBumpTransactionEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBumpTransactionEnvelope) ProtoMessage() {}
+
+func (x *FeeBumpTransactionEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBumpTransactionEnvelope.ProtoReflect.Descriptor instead.
+func (*FeeBumpTransactionEnvelope) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FeeBumpTransactionEnvelope) GetTx() *FeeBumpTransaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *FeeBumpTransactionEnvelope) GetSignatures() []*DecoratedSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type FeeBumpTransactionExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *FeeBumpTransactionExt) Reset() {
+	*x = FeeBumpTransactionExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBumpTransactionExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBumpTransactionExt) ProtoMessage() {}
+
+func (x *FeeBumpTransactionExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBumpTransactionExt.ProtoReflect.Descriptor instead.
+func (*FeeBumpTransactionExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FeeBumpTransactionExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type FeeBumpTransactionInnerTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V1 *TransactionV1Envelope `protobuf:"bytes,1,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *FeeBumpTransactionInnerTx) Reset() {
+	*x = FeeBumpTransactionInnerTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBumpTransactionInnerTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBumpTransactionInnerTx) ProtoMessage() {}
+
+func (x *FeeBumpTransactionInnerTx) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBumpTransactionInnerTx.ProtoReflect.Descriptor instead.
+func (*FeeBumpTransactionInnerTx) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *FeeBumpTransactionInnerTx) GetV1() *TransactionV1Envelope {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type HostFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvokeContract *InvokeContractArgs `protobuf:"bytes,1,opt,name=invoke_contract,proto3" json:"invoke_contract,omitempty"`
+	CreateContract *CreateContractArgs `protobuf:"bytes,2,opt,name=create_contract,proto3" json:"create_contract,omitempty"`
+	Wasm           []byte              `protobuf:"bytes,3,opt,name=wasm,proto3,oneof" json:"wasm,omitempty"`
+}
+
+func (x *HostFunction) Reset() {
+	*x = HostFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostFunction) ProtoMessage() {}
+
+func (x *HostFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostFunction.ProtoReflect.Descriptor instead.
+func (*HostFunction) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *HostFunction) GetInvokeContract() *InvokeContractArgs {
+	if x != nil {
+		return x.InvokeContract
+	}
+	return nil
+}
+
+func (x *HostFunction) GetCreateContract() *CreateContractArgs {
+	if x != nil {
+		return x.CreateContract
+	}
+	return nil
+}
+
+func (x *HostFunction) GetWasm() []byte {
+	if x != nil {
+		return x.Wasm
+	}
+	return nil
+}
+
+type InflationPayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination *AccountId `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount      int64      `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *InflationPayout) Reset() {
+	*x = InflationPayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationPayout) ProtoMessage() {}
+
+func (x *InflationPayout) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InflationPayout.ProtoReflect.Descriptor instead.
+func (*InflationPayout) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *InflationPayout) GetDestination() *AccountId {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *InflationPayout) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type InflationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string             `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Payouts  []*InflationPayout `protobuf:"bytes,3,rep,name=payouts,proto3" json:"payouts,omitempty"`
+}
+
+func (x *InflationResult) Reset() {
+	*x = InflationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationResult) ProtoMessage() {}
+
+func (x *InflationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InflationResult.ProtoReflect.Descriptor instead.
+func (*InflationResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *InflationResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *InflationResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *InflationResult) GetPayouts() []*InflationPayout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+type InnerTransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeCharged int64                         `protobuf:"varint,1,opt,name=fee_charged,proto3" json:"fee_charged,omitempty"`
+	Result     *InnerTransactionResultResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Ext        *InnerTransactionResultExt    `protobuf:"bytes,3,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *InnerTransactionResult) Reset() {
+	*x = InnerTransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InnerTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InnerTransactionResult) ProtoMessage() {}
+
+func (x *InnerTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InnerTransactionResult.ProtoReflect.Descriptor instead.
+func (*InnerTransactionResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InnerTransactionResult) GetFeeCharged() int64 {
+	if x != nil {
+		return x.FeeCharged
+	}
+	return 0
+}
+
+func (x *InnerTransactionResult) GetResult() *InnerTransactionResultResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *InnerTransactionResult) GetExt() *InnerTransactionResultExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type InnerTransactionResultExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *InnerTransactionResultExt) Reset() {
+	*x = InnerTransactionResultExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InnerTransactionResultExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InnerTransactionResultExt) ProtoMessage() {}
+
+func (x *InnerTransactionResultExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InnerTransactionResultExt.ProtoReflect.Descriptor instead.
+func (*InnerTransactionResultExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *InnerTransactionResultExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type InnerTransactionResultPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionHash string                  `protobuf:"bytes,1,opt,name=transaction_hash,proto3" json:"transaction_hash,omitempty"`
+	Result          *InnerTransactionResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *InnerTransactionResultPair) Reset() {
+	*x = InnerTransactionResultPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InnerTransactionResultPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InnerTransactionResultPair) ProtoMessage() {}
+
+func (x *InnerTransactionResultPair) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InnerTransactionResultPair.ProtoReflect.Descriptor instead.
+func (*InnerTransactionResultPair) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *InnerTransactionResultPair) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *InnerTransactionResultPair) GetResult() *InnerTransactionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type InnerTransactionResultResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string             `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Results  []*OperationResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *InnerTransactionResultResult) Reset() {
+	*x = InnerTransactionResultResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InnerTransactionResultResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InnerTransactionResultResult) ProtoMessage() {}
+
+func (x *InnerTransactionResultResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InnerTransactionResultResult.ProtoReflect.Descriptor instead.
+func (*InnerTransactionResultResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *InnerTransactionResultResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *InnerTransactionResultResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *InnerTransactionResultResult) GetResults() []*OperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Int128Parts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hi int64  `protobuf:"varint,1,opt,name=hi,proto3" json:"hi,omitempty"`
+	Lo uint64 `protobuf:"varint,2,opt,name=lo,proto3" json:"lo,omitempty"`
+}
+
+func (x *Int128Parts) Reset() {
+	*x = Int128Parts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Int128Parts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int128Parts) ProtoMessage() {}
+
+func (x *Int128Parts) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int128Parts.ProtoReflect.Descriptor instead.
+func (*Int128Parts) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Int128Parts) GetHi() int64 {
+	if x != nil {
+		return x.Hi
+	}
+	return 0
+}
+
+func (x *Int128Parts) GetLo() uint64 {
+	if x != nil {
+		return x.Lo
+	}
+	return 0
+}
+
+type Int256Parts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hihi int64  `protobuf:"varint,1,opt,name=hihi,proto3" json:"hihi,omitempty"`
+	Hilo uint64 `protobuf:"varint,2,opt,name=hilo,proto3" json:"hilo,omitempty"`
+	Lohi uint64 `protobuf:"varint,3,opt,name=lohi,proto3" json:"lohi,omitempty"`
+	Lolo uint64 `protobuf:"varint,4,opt,name=lolo,proto3" json:"lolo,omitempty"`
+}
+
+func (x *Int256Parts) Reset() {
+	*x = Int256Parts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Int256Parts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int256Parts) ProtoMessage() {}
+
+func (x *Int256Parts) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int256Parts.ProtoReflect.Descriptor instead.
+func (*Int256Parts) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Int256Parts) GetHihi() int64 {
+	if x != nil {
+		return x.Hihi
+	}
+	return 0
+}
+
+func (x *Int256Parts) GetHilo() uint64 {
+	if x != nil {
+		return x.Hilo
+	}
+	return 0
+}
+
+func (x *Int256Parts) GetLohi() uint64 {
+	if x != nil {
+		return x.Lohi
+	}
+	return 0
+}
+
+func (x *Int256Parts) GetLolo() uint64 {
+	if x != nil {
+		return x.Lolo
+	}
+	return 0
+}
+
+type InvokeContractArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddress *ScAddress `protobuf:"bytes,1,opt,name=contract_address,proto3" json:"contract_address,omitempty"`
+	FunctionName    string     `protobuf:"bytes,2,opt,name=function_name,proto3" json:"function_name,omitempty"`
+	Args            []*ScVal   `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *InvokeContractArgs) Reset() {
+	*x = InvokeContractArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeContractArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeContractArgs) ProtoMessage() {}
+
+func (x *InvokeContractArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeContractArgs.ProtoReflect.Descriptor instead.
+func (*InvokeContractArgs) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *InvokeContractArgs) GetContractAddress() *ScAddress {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return nil
+}
+
+func (x *InvokeContractArgs) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *InvokeContractArgs) GetArgs() []*ScVal {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type InvokeHostFunctionOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostFunction *HostFunction                `protobuf:"bytes,1,opt,name=host_function,proto3" json:"host_function,omitempty"`
+	Auth         []*SorobanAuthorizationEntry `protobuf:"bytes,2,rep,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *InvokeHostFunctionOp) Reset() {
+	*x = InvokeHostFunctionOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeHostFunctionOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeHostFunctionOp) ProtoMessage() {}
+
+func (x *InvokeHostFunctionOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeHostFunctionOp.ProtoReflect.Descriptor instead.
+func (*InvokeHostFunctionOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *InvokeHostFunctionOp) GetHostFunction() *HostFunction {
+	if x != nil {
+		return x.HostFunction
+	}
+	return nil
+}
+
+func (x *InvokeHostFunctionOp) GetAuth() []*SorobanAuthorizationEntry {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type InvokeHostFunctionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string  `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Success  *string `protobuf:"bytes,3,opt,name=success,proto3,oneof" json:"success,omitempty"`
+}
+
+func (x *InvokeHostFunctionResult) Reset() {
+	*x = InvokeHostFunctionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeHostFunctionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeHostFunctionResult) ProtoMessage() {}
+
+func (x *InvokeHostFunctionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeHostFunctionResult.ProtoReflect.Descriptor instead.
+func (*InvokeHostFunctionResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *InvokeHostFunctionResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *InvokeHostFunctionResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *InvokeHostFunctionResult) GetSuccess() string {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return ""
+}
+
+type LedgerBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLedger uint32 `protobuf:"varint,1,opt,name=min_ledger,proto3" json:"min_ledger,omitempty"`
+	MaxLedger uint32 `protobuf:"varint,2,opt,name=max_ledger,proto3" json:"max_ledger,omitempty"`
+}
+
+func (x *LedgerBounds) Reset() {
+	*x = LedgerBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerBounds) ProtoMessage() {}
+
+func (x *LedgerBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerBounds.ProtoReflect.Descriptor instead.
+func (*LedgerBounds) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LedgerBounds) GetMinLedger() uint32 {
+	if x != nil {
+		return x.MinLedger
+	}
+	return 0
+}
+
+func (x *LedgerBounds) GetMaxLedger() uint32 {
+	if x != nil {
+		return x.MaxLedger
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastModifiedLedgerSeq uint32           `protobuf:"varint,1,opt,name=last_modified_ledger_seq,proto3" json:"last_modified_ledger_seq,omitempty"`
+	Data                  *LedgerEntryData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Ext                   *LedgerEntryExt  `protobuf:"bytes,3,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *LedgerEntry) GetLastModifiedLedgerSeq() uint32 {
+	if x != nil {
+		return x.LastModifiedLedgerSeq
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetData() *LedgerEntryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetExt() *LedgerEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type LedgerEntryChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created  *LedgerEntry `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated  *LedgerEntry `protobuf:"bytes,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Removed  *LedgerKey   `protobuf:"bytes,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	State    *LedgerEntry `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Restored *LedgerEntry `protobuf:"bytes,5,opt,name=restored,proto3" json:"restored,omitempty"`
+}
+
+func (x *LedgerEntryChange) Reset() {
+	*x = LedgerEntryChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntryChange) ProtoMessage() {}
+
+func (x *LedgerEntryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntryChange.ProtoReflect.Descriptor instead.
+func (*LedgerEntryChange) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LedgerEntryChange) GetCreated() *LedgerEntry {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *LedgerEntryChange) GetUpdated() *LedgerEntry {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *LedgerEntryChange) GetRemoved() *LedgerKey {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *LedgerEntryChange) GetState() *LedgerEntry {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *LedgerEntryChange) GetRestored() *LedgerEntry {
+	if x != nil {
+		return x.Restored
+	}
+	return nil
+}
+
+type LedgerEntryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account          *AccountEntry          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	TrustLine        *TrustLineEntry        `protobuf:"bytes,2,opt,name=trust_line,proto3" json:"trust_line,omitempty"`
+	Offer            *OfferEntry            `protobuf:"bytes,3,opt,name=offer,proto3" json:"offer,omitempty"`
+	Data             *DataEntry             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	ClaimableBalance *ClaimableBalanceEntry `protobuf:"bytes,5,opt,name=claimable_balance,proto3" json:"claimable_balance,omitempty"`
+	LiquidityPool    *LiquidityPoolEntry    `protobuf:"bytes,6,opt,name=liquidity_pool,proto3" json:"liquidity_pool,omitempty"`
+	ContractData     *ContractDataEntry     `protobuf:"bytes,7,opt,name=contract_data,proto3" json:"contract_data,omitempty"`
+	ContractCode     *ContractCodeEntry     `protobuf:"bytes,8,opt,name=contract_code,proto3" json:"contract_code,omitempty"`
+	ConfigSetting    *ConfigSettingEntry    `protobuf:"bytes,9,opt,name=config_setting,proto3" json:"config_setting,omitempty"`
+	Ttl              *TtlEntry              `protobuf:"bytes,10,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *LedgerEntryData) Reset() {
+	*x = LedgerEntryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntryData) ProtoMessage() {}
+
+func (x *LedgerEntryData) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntryData.ProtoReflect.Descriptor instead.
+func (*LedgerEntryData) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LedgerEntryData) GetAccount() *AccountEntry {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetTrustLine() *TrustLineEntry {
+	if x != nil {
+		return x.TrustLine
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetOffer() *OfferEntry {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetData() *DataEntry {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetClaimableBalance() *ClaimableBalanceEntry {
+	if x != nil {
+		return x.ClaimableBalance
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetLiquidityPool() *LiquidityPoolEntry {
+	if x != nil {
+		return x.LiquidityPool
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetContractData() *ContractDataEntry {
+	if x != nil {
+		return x.ContractData
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetContractCode() *ContractCodeEntry {
+	if x != nil {
+		return x.ContractCode
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetConfigSetting() *ConfigSettingEntry {
+	if x != nil {
+		return x.ConfigSetting
+	}
+	return nil
+}
+
+func (x *LedgerEntryData) GetTtl() *TtlEntry {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type LedgerEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                   `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V1 *LedgerEntryExtensionV1 `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *LedgerEntryExt) Reset() {
+	*x = LedgerEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntryExt) ProtoMessage() {}
+
+func (x *LedgerEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntryExt.ProtoReflect.Descriptor instead.
+func (*LedgerEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *LedgerEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *LedgerEntryExt) GetV1() *LedgerEntryExtensionV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type LedgerEntryExtensionV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SponsoringId *AccountId                 `protobuf:"bytes,1,opt,name=sponsoring_id,proto3" json:"sponsoring_id,omitempty"`
+	Ext          *LedgerEntryExtensionV1Ext `protobuf:"bytes,2,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *LedgerEntryExtensionV1) Reset() {
+	*x = LedgerEntryExtensionV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntryExtensionV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntryExtensionV1) ProtoMessage() {}
+
+func (x *LedgerEntryExtensionV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntryExtensionV1.ProtoReflect.Descriptor instead.
+func (*LedgerEntryExtensionV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *LedgerEntryExtensionV1) GetSponsoringId() *AccountId {
+	if x != nil {
+		return x.SponsoringId
+	}
+	return nil
+}
+
+func (x *LedgerEntryExtensionV1) GetExt() *LedgerEntryExtensionV1Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type LedgerEntryExtensionV1Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *LedgerEntryExtensionV1Ext) Reset() {
+	*x = LedgerEntryExtensionV1Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntryExtensionV1Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntryExtensionV1Ext) ProtoMessage() {}
+
+func (x *LedgerEntryExtensionV1Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntryExtensionV1Ext.ProtoReflect.Descriptor instead.
+func (*LedgerEntryExtensionV1Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *LedgerEntryExtensionV1Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type LedgerFootprint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadOnly  []*LedgerKey `protobuf:"bytes,1,rep,name=read_only,proto3" json:"read_only,omitempty"`
+	ReadWrite []*LedgerKey `protobuf:"bytes,2,rep,name=read_write,proto3" json:"read_write,omitempty"`
+}
+
+func (x *LedgerFootprint) Reset() {
+	*x = LedgerFootprint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerFootprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerFootprint) ProtoMessage() {}
+
+func (x *LedgerFootprint) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerFootprint.ProtoReflect.Descriptor instead.
+func (*LedgerFootprint) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *LedgerFootprint) GetReadOnly() []*LedgerKey {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return nil
+}
+
+func (x *LedgerFootprint) GetReadWrite() []*LedgerKey {
+	if x != nil {
+		return x.ReadWrite
+	}
+	return nil
+}
+
+type LedgerKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account          *LedgerKeyAccount          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	TrustLine        *LedgerKeyTrustLine        `protobuf:"bytes,2,opt,name=trust_line,proto3" json:"trust_line,omitempty"`
+	Offer            *LedgerKeyOffer            `protobuf:"bytes,3,opt,name=offer,proto3" json:"offer,omitempty"`
+	Data             *LedgerKeyData             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	ClaimableBalance *LedgerKeyClaimableBalance `protobuf:"bytes,5,opt,name=claimable_balance,proto3" json:"claimable_balance,omitempty"`
+	LiquidityPool    *LedgerKeyLiquidityPool    `protobuf:"bytes,6,opt,name=liquidity_pool,proto3" json:"liquidity_pool,omitempty"`
+	ContractData     *LedgerKeyContractData     `protobuf:"bytes,7,opt,name=contract_data,proto3" json:"contract_data,omitempty"`
+	ContractCode     *LedgerKeyContractCode     `protobuf:"bytes,8,opt,name=contract_code,proto3" json:"contract_code,omitempty"`
+	ConfigSetting    *LedgerKeyConfigSetting    `protobuf:"bytes,9,opt,name=config_setting,proto3" json:"config_setting,omitempty"`
+	Ttl              *LedgerKeyTtl              `protobuf:"bytes,10,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *LedgerKey) Reset() {
+	*x = LedgerKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKey) ProtoMessage() {}
+
+func (x *LedgerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKey.ProtoReflect.Descriptor instead.
+func (*LedgerKey) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *LedgerKey) GetAccount() *LedgerKeyAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetTrustLine() *LedgerKeyTrustLine {
+	if x != nil {
+		return x.TrustLine
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetOffer() *LedgerKeyOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetData() *LedgerKeyData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetClaimableBalance() *LedgerKeyClaimableBalance {
+	if x != nil {
+		return x.ClaimableBalance
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetLiquidityPool() *LedgerKeyLiquidityPool {
+	if x != nil {
+		return x.LiquidityPool
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetContractData() *LedgerKeyContractData {
+	if x != nil {
+		return x.ContractData
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetContractCode() *LedgerKeyContractCode {
+	if x != nil {
+		return x.ContractCode
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetConfigSetting() *LedgerKeyConfigSetting {
+	if x != nil {
+		return x.ConfigSetting
+	}
+	return nil
+}
+
+func (x *LedgerKey) GetTtl() *LedgerKeyTtl {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type LedgerKeyAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *AccountId `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+}
+
+func (x *LedgerKeyAccount) Reset() {
+	*x = LedgerKeyAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyAccount) ProtoMessage() {}
+
+func (x *LedgerKeyAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyAccount.ProtoReflect.Descriptor instead.
+func (*LedgerKeyAccount) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *LedgerKeyAccount) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+type LedgerKeyClaimableBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BalanceId *ClaimableBalanceId `protobuf:"bytes,1,opt,name=balance_id,proto3" json:"balance_id,omitempty"`
+}
+
+func (x *LedgerKeyClaimableBalance) Reset() {
+	*x = LedgerKeyClaimableBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyClaimableBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyClaimableBalance) ProtoMessage() {}
+
+func (x *LedgerKeyClaimableBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyClaimableBalance.ProtoReflect.Descriptor instead.
+func (*LedgerKeyClaimableBalance) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *LedgerKeyClaimableBalance) GetBalanceId() *ClaimableBalanceId {
+	if x != nil {
+		return x.BalanceId
+	}
+	return nil
+}
+
+type LedgerKeyConfigSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigSettingId     int32  `protobuf:"varint,1,opt,name=config_setting_id,proto3" json:"config_setting_id,omitempty"`
+	ConfigSettingIdName string `protobuf:"bytes,2,opt,name=config_setting_id_name,proto3" json:"config_setting_id_name,omitempty"`
+}
+
+func (x *LedgerKeyConfigSetting) Reset() {
+	*x = LedgerKeyConfigSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyConfigSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyConfigSetting) ProtoMessage() {}
+
+func (x *LedgerKeyConfigSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyConfigSetting.ProtoReflect.Descriptor instead.
+func (*LedgerKeyConfigSetting) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *LedgerKeyConfigSetting) GetConfigSettingId() int32 {
+	if x != nil {
+		return x.ConfigSettingId
+	}
+	return 0
+}
+
+func (x *LedgerKeyConfigSetting) GetConfigSettingIdName() string {
+	if x != nil {
+		return x.ConfigSettingIdName
+	}
+	return ""
+}
+
+type LedgerKeyContractCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash       string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	TtlKeyHash string `protobuf:"bytes,2,opt,name=ttl_key_hash,proto3" json:"ttl_key_hash,omitempty"`
+}
+
+func (x *LedgerKeyContractCode) Reset() {
+	*x = LedgerKeyContractCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyContractCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyContractCode) ProtoMessage() {}
+
+func (x *LedgerKeyContractCode) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyContractCode.ProtoReflect.Descriptor instead.
+func (*LedgerKeyContractCode) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *LedgerKeyContractCode) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LedgerKeyContractCode) GetTtlKeyHash() string {
+	if x != nil {
+		return x.TtlKeyHash
+	}
+	return ""
+}
+
+type LedgerKeyContractData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract       *ScAddress `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key            *ScVal     `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Durability     int32      `protobuf:"varint,3,opt,name=durability,proto3" json:"durability,omitempty"`
+	DurabilityName string     `protobuf:"bytes,4,opt,name=durability_name,proto3" json:"durability_name,omitempty"`
+	TtlKeyHash     string     `protobuf:"bytes,5,opt,name=ttl_key_hash,proto3" json:"ttl_key_hash,omitempty"`
+}
+
+func (x *LedgerKeyContractData) Reset() {
+	*x = LedgerKeyContractData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyContractData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyContractData) ProtoMessage() {}
+
+func (x *LedgerKeyContractData) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyContractData.ProtoReflect.Descriptor instead.
+func (*LedgerKeyContractData) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *LedgerKeyContractData) GetContract() *ScAddress {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *LedgerKeyContractData) GetKey() *ScVal {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *LedgerKeyContractData) GetDurability() int32 {
+	if x != nil {
+		return x.Durability
+	}
+	return 0
+}
+
+func (x *LedgerKeyContractData) GetDurabilityName() string {
+	if x != nil {
+		return x.DurabilityName
+	}
+	return ""
+}
+
+func (x *LedgerKeyContractData) GetTtlKeyHash() string {
+	if x != nil {
+		return x.TtlKeyHash
+	}
+	return ""
+}
+
+type LedgerKeyData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *AccountId `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	DataName  string     `protobuf:"bytes,2,opt,name=data_name,proto3" json:"data_name,omitempty"`
+}
+
+func (x *LedgerKeyData) Reset() {
+	*x = LedgerKeyData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyData) ProtoMessage() {}
+
+func (x *LedgerKeyData) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyData.ProtoReflect.Descriptor instead.
+func (*LedgerKeyData) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *LedgerKeyData) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *LedgerKeyData) GetDataName() string {
+	if x != nil {
+		return x.DataName
+	}
+	return ""
+}
+
+type LedgerKeyLiquidityPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiquidityPoolId []byte `protobuf:"bytes,1,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+}
+
+func (x *LedgerKeyLiquidityPool) Reset() {
+	*x = LedgerKeyLiquidityPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyLiquidityPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyLiquidityPool) ProtoMessage() {}
+
+func (x *LedgerKeyLiquidityPool) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyLiquidityPool.ProtoReflect.Descriptor instead.
+func (*LedgerKeyLiquidityPool) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *LedgerKeyLiquidityPool) GetLiquidityPoolId() []byte {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return nil
+}
+
+type LedgerKeyOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SellerId *AccountId `protobuf:"bytes,1,opt,name=seller_id,proto3" json:"seller_id,omitempty"`
+	OfferId  int64      `protobuf:"varint,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+}
+
+func (x *LedgerKeyOffer) Reset() {
+	*x = LedgerKeyOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyOffer) ProtoMessage() {}
+
+func (x *LedgerKeyOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyOffer.ProtoReflect.Descriptor instead.
+func (*LedgerKeyOffer) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *LedgerKeyOffer) GetSellerId() *AccountId {
+	if x != nil {
+		return x.SellerId
+	}
+	return nil
+}
+
+func (x *LedgerKeyOffer) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type LedgerKeyTrustLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *AccountId      `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	Asset     *TrustLineAsset `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (x *LedgerKeyTrustLine) Reset() {
+	*x = LedgerKeyTrustLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyTrustLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyTrustLine) ProtoMessage() {}
+
+func (x *LedgerKeyTrustLine) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyTrustLine.ProtoReflect.Descriptor instead.
+func (*LedgerKeyTrustLine) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *LedgerKeyTrustLine) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *LedgerKeyTrustLine) GetAsset() *TrustLineAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type LedgerKeyTtl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyHash string `protobuf:"bytes,1,opt,name=key_hash,proto3" json:"key_hash,omitempty"`
+}
+
+func (x *LedgerKeyTtl) Reset() {
+	*x = LedgerKeyTtl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerKeyTtl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerKeyTtl) ProtoMessage() {}
+
+func (x *LedgerKeyTtl) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerKeyTtl.ProtoReflect.Descriptor instead.
+func (*LedgerKeyTtl) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *LedgerKeyTtl) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+type Liabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buying  int64 `protobuf:"varint,1,opt,name=buying,proto3" json:"buying,omitempty"`
+	Selling int64 `protobuf:"varint,2,opt,name=selling,proto3" json:"selling,omitempty"`
+}
+
+func (x *Liabilities) Reset() {
+	*x = Liabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Liabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Liabilities) ProtoMessage() {}
+
+func (x *Liabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Liabilities.ProtoReflect.Descriptor instead.
+func (*Liabilities) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Liabilities) GetBuying() int64 {
+	if x != nil {
+		return x.Buying
+	}
+	return 0
+}
+
+func (x *Liabilities) GetSelling() int64 {
+	if x != nil {
+		return x.Selling
+	}
+	return 0
+}
+
+type LiquidityPoolConstantProductParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetA *Asset `protobuf:"bytes,1,opt,name=asset_a,proto3" json:"asset_a,omitempty"`
+	AssetB *Asset `protobuf:"bytes,2,opt,name=asset_b,proto3" json:"asset_b,omitempty"`
+	Fee    int32  `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *LiquidityPoolConstantProductParameters) Reset() {
+	*x = LiquidityPoolConstantProductParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolConstantProductParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolConstantProductParameters) ProtoMessage() {}
+
+func (x *LiquidityPoolConstantProductParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolConstantProductParameters.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolConstantProductParameters) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *LiquidityPoolConstantProductParameters) GetAssetA() *Asset {
+	if x != nil {
+		return x.AssetA
+	}
+	return nil
+}
+
+func (x *LiquidityPoolConstantProductParameters) GetAssetB() *Asset {
+	if x != nil {
+		return x.AssetB
+	}
+	return nil
+}
+
+func (x *LiquidityPoolConstantProductParameters) GetFee() int32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type LiquidityPoolDepositOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiquidityPoolId []byte `protobuf:"bytes,1,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+	MaxAmountA      int64  `protobuf:"varint,2,opt,name=max_amount_a,proto3" json:"max_amount_a,omitempty"`
+	MaxAmountB      int64  `protobuf:"varint,3,opt,name=max_amount_b,proto3" json:"max_amount_b,omitempty"`
+	MinPrice        *Price `protobuf:"bytes,4,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice        *Price `protobuf:"bytes,5,opt,name=max_price,proto3" json:"max_price,omitempty"`
+}
+
+func (x *LiquidityPoolDepositOp) Reset() {
+	*x = LiquidityPoolDepositOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolDepositOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolDepositOp) ProtoMessage() {}
+
+func (x *LiquidityPoolDepositOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolDepositOp.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolDepositOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *LiquidityPoolDepositOp) GetLiquidityPoolId() []byte {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return nil
+}
+
+func (x *LiquidityPoolDepositOp) GetMaxAmountA() int64 {
+	if x != nil {
+		return x.MaxAmountA
+	}
+	return 0
+}
+
+func (x *LiquidityPoolDepositOp) GetMaxAmountB() int64 {
+	if x != nil {
+		return x.MaxAmountB
+	}
+	return 0
+}
+
+func (x *LiquidityPoolDepositOp) GetMinPrice() *Price {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *LiquidityPoolDepositOp) GetMaxPrice() *Price {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+type LiquidityPoolDepositResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *LiquidityPoolDepositResult) Reset() {
+	*x = LiquidityPoolDepositResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolDepositResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolDepositResult) ProtoMessage() {}
+
+func (x *LiquidityPoolDepositResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolDepositResult.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolDepositResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *LiquidityPoolDepositResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *LiquidityPoolDepositResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type LiquidityPoolEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiquidityPoolId []byte                  `protobuf:"bytes,1,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+	Body            *LiquidityPoolEntryBody `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *LiquidityPoolEntry) Reset() {
+	*x = LiquidityPoolEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolEntry) ProtoMessage() {}
+
+func (x *LiquidityPoolEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolEntry.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *LiquidityPoolEntry) GetLiquidityPoolId() []byte {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return nil
+}
+
+func (x *LiquidityPoolEntry) GetBody() *LiquidityPoolEntryBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type LiquidityPoolEntryBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConstantProduct *LiquidityPoolEntryConstantProduct `protobuf:"bytes,1,opt,name=constant_product,proto3" json:"constant_product,omitempty"`
+}
+
+func (x *LiquidityPoolEntryBody) Reset() {
+	*x = LiquidityPoolEntryBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolEntryBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolEntryBody) ProtoMessage() {}
+
+func (x *LiquidityPoolEntryBody) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolEntryBody.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolEntryBody) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *LiquidityPoolEntryBody) GetConstantProduct() *LiquidityPoolEntryConstantProduct {
+	if x != nil {
+		return x.ConstantProduct
+	}
+	return nil
+}
+
+type LiquidityPoolEntryConstantProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params                   *LiquidityPoolConstantProductParameters `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ReserveA                 int64                                   `protobuf:"varint,2,opt,name=reserve_a,proto3" json:"reserve_a,omitempty"`
+	ReserveB                 int64                                   `protobuf:"varint,3,opt,name=reserve_b,proto3" json:"reserve_b,omitempty"`
+	TotalPoolShares          int64                                   `protobuf:"varint,4,opt,name=total_pool_shares,proto3" json:"total_pool_shares,omitempty"`
+	PoolSharesTrustLineCount int64                                   `protobuf:"varint,5,opt,name=pool_shares_trust_line_count,proto3" json:"pool_shares_trust_line_count,omitempty"`
+}
+
+func (x *LiquidityPoolEntryConstantProduct) Reset() {
+	*x = LiquidityPoolEntryConstantProduct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolEntryConstantProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolEntryConstantProduct) ProtoMessage() {}
+
+func (x *LiquidityPoolEntryConstantProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolEntryConstantProduct.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolEntryConstantProduct) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *LiquidityPoolEntryConstantProduct) GetParams() *LiquidityPoolConstantProductParameters {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *LiquidityPoolEntryConstantProduct) GetReserveA() int64 {
+	if x != nil {
+		return x.ReserveA
+	}
+	return 0
+}
+
+func (x *LiquidityPoolEntryConstantProduct) GetReserveB() int64 {
+	if x != nil {
+		return x.ReserveB
+	}
+	return 0
+}
+
+func (x *LiquidityPoolEntryConstantProduct) GetTotalPoolShares() int64 {
+	if x != nil {
+		return x.TotalPoolShares
+	}
+	return 0
+}
+
+func (x *LiquidityPoolEntryConstantProduct) GetPoolSharesTrustLineCount() int64 {
+	if x != nil {
+		return x.PoolSharesTrustLineCount
+	}
+	return 0
+}
+
+type LiquidityPoolParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConstantProduct     *LiquidityPoolConstantProductParameters `protobuf:"bytes,1,opt,name=constant_product,proto3" json:"constant_product,omitempty"`
+	LiquidityPoolId     string                                  `protobuf:"bytes,2,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+	LiquidityPoolStrkey string                                  `protobuf:"bytes,3,opt,name=liquidity_pool_strkey,proto3" json:"liquidity_pool_strkey,omitempty"`
+}
+
+func (x *LiquidityPoolParameters) Reset() {
+	*x = LiquidityPoolParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolParameters) ProtoMessage() {}
+
+func (x *LiquidityPoolParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolParameters.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolParameters) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *LiquidityPoolParameters) GetConstantProduct() *LiquidityPoolConstantProductParameters {
+	if x != nil {
+		return x.ConstantProduct
+	}
+	return nil
+}
+
+func (x *LiquidityPoolParameters) GetLiquidityPoolId() string {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return ""
+}
+
+func (x *LiquidityPoolParameters) GetLiquidityPoolStrkey() string {
+	if x != nil {
+		return x.LiquidityPoolStrkey
+	}
+	return ""
+}
+
+type LiquidityPoolWithdrawOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiquidityPoolId []byte `protobuf:"bytes,1,opt,name=liquidity_pool_id,proto3" json:"liquidity_pool_id,omitempty"`
+	Amount          int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinAmountA      int64  `protobuf:"varint,3,opt,name=min_amount_a,proto3" json:"min_amount_a,omitempty"`
+	MinAmountB      int64  `protobuf:"varint,4,opt,name=min_amount_b,proto3" json:"min_amount_b,omitempty"`
+}
+
+func (x *LiquidityPoolWithdrawOp) Reset() {
+	*x = LiquidityPoolWithdrawOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolWithdrawOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolWithdrawOp) ProtoMessage() {}
+
+func (x *LiquidityPoolWithdrawOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolWithdrawOp.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolWithdrawOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *LiquidityPoolWithdrawOp) GetLiquidityPoolId() []byte {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return nil
+}
+
+func (x *LiquidityPoolWithdrawOp) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LiquidityPoolWithdrawOp) GetMinAmountA() int64 {
+	if x != nil {
+		return x.MinAmountA
+	}
+	return 0
+}
+
+func (x *LiquidityPoolWithdrawOp) GetMinAmountB() int64 {
+	if x != nil {
+		return x.MinAmountB
+	}
+	return 0
+}
+
+type LiquidityPoolWithdrawResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *LiquidityPoolWithdrawResult) Reset() {
+	*x = LiquidityPoolWithdrawResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityPoolWithdrawResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityPoolWithdrawResult) ProtoMessage() {}
+
+func (x *LiquidityPoolWithdrawResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityPoolWithdrawResult.ProtoReflect.Descriptor instead.
+func (*LiquidityPoolWithdrawResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *LiquidityPoolWithdrawResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *LiquidityPoolWithdrawResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ManageBuyOfferOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selling   *Asset `protobuf:"bytes,1,opt,name=selling,proto3" json:"selling,omitempty"`
+	Buying    *Asset `protobuf:"bytes,2,opt,name=buying,proto3" json:"buying,omitempty"`
+	BuyAmount int64  `protobuf:"varint,3,opt,name=buy_amount,proto3" json:"buy_amount,omitempty"`
+	Price     *Price `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	OfferId   int64  `protobuf:"varint,5,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+}
+
+func (x *ManageBuyOfferOp) Reset() {
+	*x = ManageBuyOfferOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageBuyOfferOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageBuyOfferOp) ProtoMessage() {}
+
+func (x *ManageBuyOfferOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageBuyOfferOp.ProtoReflect.Descriptor instead.
+func (*ManageBuyOfferOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ManageBuyOfferOp) GetSelling() *Asset {
+	if x != nil {
+		return x.Selling
+	}
+	return nil
+}
+
+func (x *ManageBuyOfferOp) GetBuying() *Asset {
+	if x != nil {
+		return x.Buying
+	}
+	return nil
+}
+
+func (x *ManageBuyOfferOp) GetBuyAmount() int64 {
+	if x != nil {
+		return x.BuyAmount
+	}
+	return 0
+}
+
+func (x *ManageBuyOfferOp) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ManageBuyOfferOp) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type ManageBuyOfferResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32                     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string                    `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Success  *ManageOfferSuccessResult `protobuf:"bytes,3,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ManageBuyOfferResult) Reset() {
+	*x = ManageBuyOfferResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageBuyOfferResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageBuyOfferResult) ProtoMessage() {}
+
+func (x *ManageBuyOfferResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageBuyOfferResult.ProtoReflect.Descriptor instead.
+func (*ManageBuyOfferResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ManageBuyOfferResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ManageBuyOfferResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *ManageBuyOfferResult) GetSuccess() *ManageOfferSuccessResult {
+	if x != nil {
+		return x.Success
+	}
+	return nil
+}
+
+type ManageDataOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataName  string `protobuf:"bytes,1,opt,name=data_name,proto3" json:"data_name,omitempty"`
+	DataValue []byte `protobuf:"bytes,2,opt,name=data_value,proto3" json:"data_value,omitempty"`
+}
+
+func (x *ManageDataOp) Reset() {
+	*x = ManageDataOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageDataOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageDataOp) ProtoMessage() {}
+
+func (x *ManageDataOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageDataOp.ProtoReflect.Descriptor instead.
+func (*ManageDataOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ManageDataOp) GetDataName() string {
+	if x != nil {
+		return x.DataName
+	}
+	return ""
+}
+
+func (x *ManageDataOp) GetDataValue() []byte {
+	if x != nil {
+		return x.DataValue
+	}
+	return nil
+}
+
+type ManageDataResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ManageDataResult) Reset() {
+	*x = ManageDataResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageDataResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageDataResult) ProtoMessage() {}
+
+func (x *ManageDataResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageDataResult.ProtoReflect.Descriptor instead.
+func (*ManageDataResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ManageDataResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ManageDataResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ManageOfferSuccessResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffersClaimed []*ClaimAtom                   `protobuf:"bytes,1,rep,name=offers_claimed,proto3" json:"offers_claimed,omitempty"`
+	Offer         *ManageOfferSuccessResultOffer `protobuf:"bytes,2,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *ManageOfferSuccessResult) Reset() {
+	*x = ManageOfferSuccessResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageOfferSuccessResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageOfferSuccessResult) ProtoMessage() {}
+
+func (x *ManageOfferSuccessResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageOfferSuccessResult.ProtoReflect.Descriptor instead.
+func (*ManageOfferSuccessResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ManageOfferSuccessResult) GetOffersClaimed() []*ClaimAtom {
+	if x != nil {
+		return x.OffersClaimed
+	}
+	return nil
+}
+
+func (x *ManageOfferSuccessResult) GetOffer() *ManageOfferSuccessResultOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type ManageOfferSuccessResultOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Effect     int32       `protobuf:"varint,1,opt,name=effect,proto3" json:"effect,omitempty"`
+	EffectName string      `protobuf:"bytes,2,opt,name=effect_name,proto3" json:"effect_name,omitempty"`
+	Offer      *OfferEntry `protobuf:"bytes,3,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *ManageOfferSuccessResultOffer) Reset() {
+	*x = ManageOfferSuccessResultOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageOfferSuccessResultOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageOfferSuccessResultOffer) ProtoMessage() {}
+
+func (x *ManageOfferSuccessResultOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageOfferSuccessResultOffer.ProtoReflect.Descriptor instead.
+func (*ManageOfferSuccessResultOffer) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ManageOfferSuccessResultOffer) GetEffect() int32 {
+	if x != nil {
+		return x.Effect
+	}
+	return 0
+}
+
+func (x *ManageOfferSuccessResultOffer) GetEffectName() string {
+	if x != nil {
+		return x.EffectName
+	}
+	return ""
+}
+
+func (x *ManageOfferSuccessResultOffer) GetOffer() *OfferEntry {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type ManageSellOfferOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selling   *Asset `protobuf:"bytes,1,opt,name=selling,proto3" json:"selling,omitempty"`
+	Buying    *Asset `protobuf:"bytes,2,opt,name=buying,proto3" json:"buying,omitempty"`
+	BuyAmount int64  `protobuf:"varint,3,opt,name=buy_amount,proto3" json:"buy_amount,omitempty"`
+	Price     *Price `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	OfferId   int64  `protobuf:"varint,5,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+}
+
+func (x *ManageSellOfferOp) Reset() {
+	*x = ManageSellOfferOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageSellOfferOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageSellOfferOp) ProtoMessage() {}
+
+func (x *ManageSellOfferOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageSellOfferOp.ProtoReflect.Descriptor instead.
+func (*ManageSellOfferOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ManageSellOfferOp) GetSelling() *Asset {
+	if x != nil {
+		return x.Selling
+	}
+	return nil
+}
+
+func (x *ManageSellOfferOp) GetBuying() *Asset {
+	if x != nil {
+		return x.Buying
+	}
+	return nil
+}
+
+func (x *ManageSellOfferOp) GetBuyAmount() int64 {
+	if x != nil {
+		return x.BuyAmount
+	}
+	return 0
+}
+
+func (x *ManageSellOfferOp) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ManageSellOfferOp) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type ManageSellOfferResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32                     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string                    `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Success  *ManageOfferSuccessResult `protobuf:"bytes,3,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ManageSellOfferResult) Reset() {
+	*x = ManageSellOfferResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageSellOfferResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageSellOfferResult) ProtoMessage() {}
+
+func (x *ManageSellOfferResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageSellOfferResult.ProtoReflect.Descriptor instead.
+func (*ManageSellOfferResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ManageSellOfferResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ManageSellOfferResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *ManageSellOfferResult) GetSuccess() *ManageOfferSuccessResult {
+	if x != nil {
+		return x.Success
+	}
+	return nil
+}
+
+type Memo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    *string `protobuf:"bytes,1,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	Id      *uint64 `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Hash    *string `protobuf:"bytes,3,opt,name=hash,proto3,oneof" json:"hash,omitempty"`
+	Rethash *string `protobuf:"bytes,4,opt,name=rethash,proto3,oneof" json:"rethash,omitempty"`
+}
+
+func (x *Memo) Reset() {
+	*x = Memo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Memo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo) ProtoMessage() {}
+
+func (x *Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo.ProtoReflect.Descriptor instead.
+func (*Memo) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Memo) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *Memo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Memo) GetHash() string {
+	if x != nil && x.Hash != nil {
+		return *x.Hash
+	}
+	return ""
+}
+
+func (x *Memo) GetRethash() string {
+	if x != nil && x.Rethash != nil {
+		return *x.Rethash
+	}
+	return ""
+}
+
+type MintEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Admin  string       `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	To     string       `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount *Int128Parts `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MintEvent) Reset() {
+	*x = MintEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintEvent) ProtoMessage() {}
+
+func (x *MintEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintEvent.ProtoReflect.Descriptor instead.
+func (*MintEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *MintEvent) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *MintEvent) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MintEvent) GetAmount() *Int128Parts {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type MuxedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AccountId string  `protobuf:"bytes,2,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	MuxedId   *uint64 `protobuf:"varint,3,opt,name=muxed_id,proto3,oneof" json:"muxed_id,omitempty"`
+	IsMuxed   bool    `protobuf:"varint,4,opt,name=is_muxed,proto3" json:"is_muxed,omitempty"`
+}
+
+func (x *MuxedAccount) Reset() {
+	*x = MuxedAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuxedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuxedAccount) ProtoMessage() {}
+
+func (x *MuxedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuxedAccount.ProtoReflect.Descriptor instead.
+func (*MuxedAccount) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *MuxedAccount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MuxedAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *MuxedAccount) GetMuxedId() uint64 {
+	if x != nil && x.MuxedId != nil {
+		return *x.MuxedId
+	}
+	return 0
+}
+
+func (x *MuxedAccount) GetIsMuxed() bool {
+	if x != nil {
+		return x.IsMuxed
+	}
+	return false
+}
+
+type OfferEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SellerId  *AccountId     `protobuf:"bytes,1,opt,name=seller_id,proto3" json:"seller_id,omitempty"`
+	OfferId   int64          `protobuf:"varint,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+	Selling   *Asset         `protobuf:"bytes,3,opt,name=selling,proto3" json:"selling,omitempty"`
+	Buying    *Asset         `protobuf:"bytes,4,opt,name=buying,proto3" json:"buying,omitempty"`
+	Amount    int64          `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price     *Price         `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Flags     uint32         `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
+	FlagNames []string       `protobuf:"bytes,8,rep,name=flag_names,proto3" json:"flag_names,omitempty"`
+	Ext       *OfferEntryExt `protobuf:"bytes,9,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *OfferEntry) Reset() {
+	*x = OfferEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferEntry) ProtoMessage() {}
+
+func (x *OfferEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferEntry.ProtoReflect.Descriptor instead.
+func (*OfferEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *OfferEntry) GetSellerId() *AccountId {
+	if x != nil {
+		return x.SellerId
+	}
+	return nil
+}
+
+func (x *OfferEntry) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *OfferEntry) GetSelling() *Asset {
+	if x != nil {
+		return x.Selling
+	}
+	return nil
+}
+
+func (x *OfferEntry) GetBuying() *Asset {
+	if x != nil {
+		return x.Buying
+	}
+	return nil
+}
+
+func (x *OfferEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OfferEntry) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OfferEntry) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *OfferEntry) GetFlagNames() []string {
+	if x != nil {
+		return x.FlagNames
+	}
+	return nil
+}
+
+func (x *OfferEntry) GetExt() *OfferEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type OfferEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *OfferEntryExt) Reset() {
+	*x = OfferEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferEntryExt) ProtoMessage() {}
+
+func (x *OfferEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferEntryExt.ProtoReflect.Descriptor instead.
+func (*OfferEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *OfferEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceAccount *MuxedAccount  `protobuf:"bytes,1,opt,name=source_account,proto3" json:"source_account,omitempty"`
+	Body          *OperationBody `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Operation) GetSourceAccount() *MuxedAccount {
+	if x != nil {
+		return x.SourceAccount
+	}
+	return nil
+}
+
+func (x *Operation) GetBody() *OperationBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type OperationBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                            string                           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	CreateAccountOp                 *CreateAccountOp                 `protobuf:"bytes,2,opt,name=create_account_op,proto3" json:"create_account_op,omitempty"`
+	PaymentOp                       *PaymentOp                       `protobuf:"bytes,3,opt,name=payment_op,proto3" json:"payment_op,omitempty"`
+	PathPaymentStrictReceiveOp      *PathPaymentStrictReceiveOp      `protobuf:"bytes,4,opt,name=path_payment_strict_receive_op,proto3" json:"path_payment_strict_receive_op,omitempty"`
+	ManageSellOfferOp               *ManageSellOfferOp               `protobuf:"bytes,5,opt,name=manage_sell_offer_op,proto3" json:"manage_sell_offer_op,omitempty"`
+	CreatePassiveSellOfferOp        *CreatePassiveSellOfferOp        `protobuf:"bytes,6,opt,name=create_passive_sell_offer_op,proto3" json:"create_passive_sell_offer_op,omitempty"`
+	SetOptionsOp                    *SetOptionsOp                    `protobuf:"bytes,7,opt,name=set_options_op,proto3" json:"set_options_op,omitempty"`
+	ChangeTrustOp                   *ChangeTrustOp                   `protobuf:"bytes,8,opt,name=change_trust_op,proto3" json:"change_trust_op,omitempty"`
+	AllowTrustOp                    *AllowTrustOp                    `protobuf:"bytes,9,opt,name=allow_trust_op,proto3" json:"allow_trust_op,omitempty"`
+	MuxedAccount                    *MuxedAccount                    `protobuf:"bytes,10,opt,name=muxed_account,proto3" json:"muxed_account,omitempty"`
+	ManageDataOp                    *ManageDataOp                    `protobuf:"bytes,11,opt,name=manage_data_op,proto3" json:"manage_data_op,omitempty"`
+	BumpSequenceOp                  *BumpSequenceOp                  `protobuf:"bytes,12,opt,name=bump_sequence_op,proto3" json:"bump_sequence_op,omitempty"`
+	ManageBuyOfferOp                *ManageBuyOfferOp                `protobuf:"bytes,13,opt,name=manage_buy_offer_op,proto3" json:"manage_buy_offer_op,omitempty"`
+	PathPaymentStrictSendOp         *PathPaymentStrictSendOp         `protobuf:"bytes,14,opt,name=path_payment_strict_send_op,proto3" json:"path_payment_strict_send_op,omitempty"`
+	CreateClaimableBalanceOp        *CreateClaimableBalanceOp        `protobuf:"bytes,15,opt,name=create_claimable_balance_op,proto3" json:"create_claimable_balance_op,omitempty"`
+	ClaimClaimableBalanceOp         *ClaimClaimableBalanceOp         `protobuf:"bytes,16,opt,name=claim_claimable_balance_op,proto3" json:"claim_claimable_balance_op,omitempty"`
+	BeginSponsoringFutureReservesOp *BeginSponsoringFutureReservesOp `protobuf:"bytes,17,opt,name=begin_sponsoring_future_reserves_op,proto3" json:"begin_sponsoring_future_reserves_op,omitempty"`
+	RevokeSponsorshipOp             *RevokeSponsorshipOp             `protobuf:"bytes,18,opt,name=revoke_sponsorship_op,proto3" json:"revoke_sponsorship_op,omitempty"`
+	ClawbackOp                      *ClawbackOp                      `protobuf:"bytes,19,opt,name=clawback_op,proto3" json:"clawback_op,omitempty"`
+	ClawbackClaimableBalanceOp      *ClawbackClaimableBalanceOp      `protobuf:"bytes,20,opt,name=clawback_claimable_balance_op,proto3" json:"clawback_claimable_balance_op,omitempty"`
+	SetTrustLineFlagsOp             *SetTrustLineFlagsOp             `protobuf:"bytes,21,opt,name=set_trust_line_flags_op,proto3" json:"set_trust_line_flags_op,omitempty"`
+	LiquidityPoolDepositOp          *LiquidityPoolDepositOp          `protobuf:"bytes,22,opt,name=liquidity_pool_deposit_op,proto3" json:"liquidity_pool_deposit_op,omitempty"`
+	LiquidityPoolWithdrawOp         *LiquidityPoolWithdrawOp         `protobuf:"bytes,23,opt,name=liquidity_pool_withdraw_op,proto3" json:"liquidity_pool_withdraw_op,omitempty"`
+	InvokeHostFunctionOp            *InvokeHostFunctionOp            `protobuf:"bytes,24,opt,name=invoke_host_function_op,proto3" json:"invoke_host_function_op,omitempty"`
+	ExtendFootprintTtlOp            *ExtendFootprintTtlOp            `protobuf:"bytes,25,opt,name=extend_footprint_ttl_op,proto3" json:"extend_footprint_ttl_op,omitempty"`
+	RestoreFootprintOp              *RestoreFootprintOp              `protobuf:"bytes,26,opt,name=restore_footprint_op,proto3" json:"restore_footprint_op,omitempty"`
+}
+
+func (x *OperationBody) Reset() {
+	*x = OperationBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationBody) ProtoMessage() {}
+
+func (x *OperationBody) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationBody.ProtoReflect.Descriptor instead.
+func (*OperationBody) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *OperationBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OperationBody) GetCreateAccountOp() *CreateAccountOp {
+	if x != nil {
+		return x.CreateAccountOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetPaymentOp() *PaymentOp {
+	if x != nil {
+		return x.PaymentOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetPathPaymentStrictReceiveOp() *PathPaymentStrictReceiveOp {
+	if x != nil {
+		return x.PathPaymentStrictReceiveOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetManageSellOfferOp() *ManageSellOfferOp {
+	if x != nil {
+		return x.ManageSellOfferOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetCreatePassiveSellOfferOp() *CreatePassiveSellOfferOp {
+	if x != nil {
+		return x.CreatePassiveSellOfferOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetSetOptionsOp() *SetOptionsOp {
+	if x != nil {
+		return x.SetOptionsOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetChangeTrustOp() *ChangeTrustOp {
+	if x != nil {
+		return x.ChangeTrustOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetAllowTrustOp() *AllowTrustOp {
+	if x != nil {
+		return x.AllowTrustOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetMuxedAccount() *MuxedAccount {
+	if x != nil {
+		return x.MuxedAccount
+	}
+	return nil
+}
+
+func (x *OperationBody) GetManageDataOp() *ManageDataOp {
+	if x != nil {
+		return x.ManageDataOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetBumpSequenceOp() *BumpSequenceOp {
+	if x != nil {
+		return x.BumpSequenceOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetManageBuyOfferOp() *ManageBuyOfferOp {
+	if x != nil {
+		return x.ManageBuyOfferOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetPathPaymentStrictSendOp() *PathPaymentStrictSendOp {
+	if x != nil {
+		return x.PathPaymentStrictSendOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetCreateClaimableBalanceOp() *CreateClaimableBalanceOp {
+	if x != nil {
+		return x.CreateClaimableBalanceOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetClaimClaimableBalanceOp() *ClaimClaimableBalanceOp {
+	if x != nil {
+		return x.ClaimClaimableBalanceOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetBeginSponsoringFutureReservesOp() *BeginSponsoringFutureReservesOp {
+	if x != nil {
+		return x.BeginSponsoringFutureReservesOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetRevokeSponsorshipOp() *RevokeSponsorshipOp {
+	if x != nil {
+		return x.RevokeSponsorshipOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetClawbackOp() *ClawbackOp {
+	if x != nil {
+		return x.ClawbackOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetClawbackClaimableBalanceOp() *ClawbackClaimableBalanceOp {
+	if x != nil {
+		return x.ClawbackClaimableBalanceOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetSetTrustLineFlagsOp() *SetTrustLineFlagsOp {
+	if x != nil {
+		return x.SetTrustLineFlagsOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetLiquidityPoolDepositOp() *LiquidityPoolDepositOp {
+	if x != nil {
+		return x.LiquidityPoolDepositOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetLiquidityPoolWithdrawOp() *LiquidityPoolWithdrawOp {
+	if x != nil {
+		return x.LiquidityPoolWithdrawOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetInvokeHostFunctionOp() *InvokeHostFunctionOp {
+	if x != nil {
+		return x.InvokeHostFunctionOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetExtendFootprintTtlOp() *ExtendFootprintTtlOp {
+	if x != nil {
+		return x.ExtendFootprintTtlOp
+	}
+	return nil
+}
+
+func (x *OperationBody) GetRestoreFootprintOp() *RestoreFootprintOp {
+	if x != nil {
+		return x.RestoreFootprintOp
+	}
+	return nil
+}
+
+type OperationMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*LedgerEntryChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *OperationMeta) Reset() {
+	*x = OperationMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationMeta) ProtoMessage() {}
+
+func (x *OperationMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationMeta.ProtoReflect.Descriptor instead.
+func (*OperationMeta) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *OperationMeta) GetChanges() []*LedgerEntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type OperationMetaV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext     *ExtensionPoint      `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	Changes []*LedgerEntryChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Events  []*ContractEvent     `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *OperationMetaV2) Reset() {
+	*x = OperationMetaV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationMetaV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationMetaV2) ProtoMessage() {}
+
+func (x *OperationMetaV2) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationMetaV2.ProtoReflect.Descriptor instead.
+func (*OperationMetaV2) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *OperationMetaV2) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *OperationMetaV2) GetChanges() []*LedgerEntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *OperationMetaV2) GetEvents() []*ContractEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type OperationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string             `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Tr       *OperationResultTr `protobuf:"bytes,3,opt,name=tr,proto3" json:"tr,omitempty"`
+}
+
+func (x *OperationResult) Reset() {
+	*x = OperationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationResult) ProtoMessage() {}
+
+func (x *OperationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationResult.ProtoReflect.Descriptor instead.
+func (*OperationResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *OperationResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *OperationResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *OperationResult) GetTr() *OperationResultTr {
+	if x != nil {
+		return x.Tr
+	}
+	return nil
+}
+
+type OperationResultTr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                                string                               `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	CreateAccountResult                 *CreateAccountResult                 `protobuf:"bytes,2,opt,name=create_account_result,proto3" json:"create_account_result,omitempty"`
+	PaymentResult                       *PaymentResult                       `protobuf:"bytes,3,opt,name=payment_result,proto3" json:"payment_result,omitempty"`
+	PathPaymentStrictReceiveResult      *PathPaymentStrictReceiveResult      `protobuf:"bytes,4,opt,name=path_payment_strict_receive_result,proto3" json:"path_payment_strict_receive_result,omitempty"`
+	ManageSellOfferResult               *ManageSellOfferResult               `protobuf:"bytes,5,opt,name=manage_sell_offer_result,proto3" json:"manage_sell_offer_result,omitempty"`
+	CreatePassiveSellOfferResult        *ManageSellOfferResult               `protobuf:"bytes,6,opt,name=create_passive_sell_offer_result,proto3" json:"create_passive_sell_offer_result,omitempty"`
+	SetOptionsResult                    *SetOptionsResult                    `protobuf:"bytes,7,opt,name=set_options_result,proto3" json:"set_options_result,omitempty"`
+	ChangeTrustResult                   *ChangeTrustResult                   `protobuf:"bytes,8,opt,name=change_trust_result,proto3" json:"change_trust_result,omitempty"`
+	AllowTrustResult                    *AllowTrustResult                    `protobuf:"bytes,9,opt,name=allow_trust_result,proto3" json:"allow_trust_result,omitempty"`
+	AccountMergeResult                  *AccountMergeResult                  `protobuf:"bytes,10,opt,name=account_merge_result,proto3" json:"account_merge_result,omitempty"`
+	InflationResult                     *InflationResult                     `protobuf:"bytes,11,opt,name=inflation_result,proto3" json:"inflation_result,omitempty"`
+	ManageDataResult                    *ManageDataResult                    `protobuf:"bytes,12,opt,name=manage_data_result,proto3" json:"manage_data_result,omitempty"`
+	BumpSeqResult                       *BumpSequenceResult                  `protobuf:"bytes,13,opt,name=bump_seq_result,proto3" json:"bump_seq_result,omitempty"`
+	ManageBuyOfferResult                *ManageBuyOfferResult                `protobuf:"bytes,14,opt,name=manage_buy_offer_result,proto3" json:"manage_buy_offer_result,omitempty"`
+	PathPaymentStrictSendResult         *PathPaymentStrictSendResult         `protobuf:"bytes,15,opt,name=path_payment_strict_send_result,proto3" json:"path_payment_strict_send_result,omitempty"`
+	CreateClaimableBalanceResult        *CreateClaimableBalanceResult        `protobuf:"bytes,16,opt,name=create_claimable_balance_result,proto3" json:"create_claimable_balance_result,omitempty"`
+	ClaimClaimableBalanceResult         *ClaimClaimableBalanceResult         `protobuf:"bytes,17,opt,name=claim_claimable_balance_result,proto3" json:"claim_claimable_balance_result,omitempty"`
+	BeginSponsoringFutureReservesResult *BeginSponsoringFutureReservesResult `protobuf:"bytes,18,opt,name=begin_sponsoring_future_reserves_result,proto3" json:"begin_sponsoring_future_reserves_result,omitempty"`
+	EndSponsoringFutureReservesResult   *EndSponsoringFutureReservesResult   `protobuf:"bytes,19,opt,name=end_sponsoring_future_reserves_result,proto3" json:"end_sponsoring_future_reserves_result,omitempty"`
+	RevokeSponsorshipResult             *RevokeSponsorshipResult             `protobuf:"bytes,20,opt,name=revoke_sponsorship_result,proto3" json:"revoke_sponsorship_result,omitempty"`
+	ClawbackResult                      *ClawbackResult                      `protobuf:"bytes,21,opt,name=clawback_result,proto3" json:"clawback_result,omitempty"`
+	ClawbackClaimableBalanceResult      *ClawbackClaimableBalanceResult      `protobuf:"bytes,22,opt,name=clawback_claimable_balance_result,proto3" json:"clawback_claimable_balance_result,omitempty"`
+	SetTrustLineFlagsResult             *SetTrustLineFlagsResult             `protobuf:"bytes,23,opt,name=set_trust_line_flags_result,proto3" json:"set_trust_line_flags_result,omitempty"`
+	LiquidityPoolDepositResult          *LiquidityPoolDepositResult          `protobuf:"bytes,24,opt,name=liquidity_pool_deposit_result,proto3" json:"liquidity_pool_deposit_result,omitempty"`
+	LiquidityPoolWithdrawResult         *LiquidityPoolWithdrawResult         `protobuf:"bytes,25,opt,name=liquidity_pool_withdraw_result,proto3" json:"liquidity_pool_withdraw_result,omitempty"`
+	InvokeHostFunctionResult            *InvokeHostFunctionResult            `protobuf:"bytes,26,opt,name=invoke_host_function_result,proto3" json:"invoke_host_function_result,omitempty"`
+	ExtendFootprintTtlResult            *ExtendFootprintTtlResult            `protobuf:"bytes,27,opt,name=extend_footprint_ttl_result,proto3" json:"extend_footprint_ttl_result,omitempty"`
+	RestoreFootprintResult              *RestoreFootprintResult              `protobuf:"bytes,28,opt,name=restore_footprint_result,proto3" json:"restore_footprint_result,omitempty"`
+}
+
+func (x *OperationResultTr) Reset() {
+	*x = OperationResultTr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationResultTr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationResultTr) ProtoMessage() {}
+
+func (x *OperationResultTr) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationResultTr.ProtoReflect.Descriptor instead.
+func (*OperationResultTr) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *OperationResultTr) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OperationResultTr) GetCreateAccountResult() *CreateAccountResult {
+	if x != nil {
+		return x.CreateAccountResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetPaymentResult() *PaymentResult {
+	if x != nil {
+		return x.PaymentResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetPathPaymentStrictReceiveResult() *PathPaymentStrictReceiveResult {
+	if x != nil {
+		return x.PathPaymentStrictReceiveResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetManageSellOfferResult() *ManageSellOfferResult {
+	if x != nil {
+		return x.ManageSellOfferResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetCreatePassiveSellOfferResult() *ManageSellOfferResult {
+	if x != nil {
+		return x.CreatePassiveSellOfferResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetSetOptionsResult() *SetOptionsResult {
+	if x != nil {
+		return x.SetOptionsResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetChangeTrustResult() *ChangeTrustResult {
+	if x != nil {
+		return x.ChangeTrustResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetAllowTrustResult() *AllowTrustResult {
+	if x != nil {
+		return x.AllowTrustResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetAccountMergeResult() *AccountMergeResult {
+	if x != nil {
+		return x.AccountMergeResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetInflationResult() *InflationResult {
+	if x != nil {
+		return x.InflationResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetManageDataResult() *ManageDataResult {
+	if x != nil {
+		return x.ManageDataResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetBumpSeqResult() *BumpSequenceResult {
+	if x != nil {
+		return x.BumpSeqResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetManageBuyOfferResult() *ManageBuyOfferResult {
+	if x != nil {
+		return x.ManageBuyOfferResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetPathPaymentStrictSendResult() *PathPaymentStrictSendResult {
+	if x != nil {
+		return x.PathPaymentStrictSendResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetCreateClaimableBalanceResult() *CreateClaimableBalanceResult {
+	if x != nil {
+		return x.CreateClaimableBalanceResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetClaimClaimableBalanceResult() *ClaimClaimableBalanceResult {
+	if x != nil {
+		return x.ClaimClaimableBalanceResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetBeginSponsoringFutureReservesResult() *BeginSponsoringFutureReservesResult {
+	if x != nil {
+		return x.BeginSponsoringFutureReservesResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetEndSponsoringFutureReservesResult() *EndSponsoringFutureReservesResult {
+	if x != nil {
+		return x.EndSponsoringFutureReservesResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetRevokeSponsorshipResult() *RevokeSponsorshipResult {
+	if x != nil {
+		return x.RevokeSponsorshipResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetClawbackResult() *ClawbackResult {
+	if x != nil {
+		return x.ClawbackResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetClawbackClaimableBalanceResult() *ClawbackClaimableBalanceResult {
+	if x != nil {
+		return x.ClawbackClaimableBalanceResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetSetTrustLineFlagsResult() *SetTrustLineFlagsResult {
+	if x != nil {
+		return x.SetTrustLineFlagsResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetLiquidityPoolDepositResult() *LiquidityPoolDepositResult {
+	if x != nil {
+		return x.LiquidityPoolDepositResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetLiquidityPoolWithdrawResult() *LiquidityPoolWithdrawResult {
+	if x != nil {
+		return x.LiquidityPoolWithdrawResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetInvokeHostFunctionResult() *InvokeHostFunctionResult {
+	if x != nil {
+		return x.InvokeHostFunctionResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetExtendFootprintTtlResult() *ExtendFootprintTtlResult {
+	if x != nil {
+		return x.ExtendFootprintTtlResult
+	}
+	return nil
+}
+
+func (x *OperationResultTr) GetRestoreFootprintResult() *RestoreFootprintResult {
+	if x != nil {
+		return x.RestoreFootprintResult
+	}
+	return nil
+}
+
+type PathPaymentStrictReceiveOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendAsset   *Asset        `protobuf:"bytes,1,opt,name=send_asset,proto3" json:"send_asset,omitempty"`
+	SendMax     int64         `protobuf:"varint,2,opt,name=send_max,proto3" json:"send_max,omitempty"`
+	Destination *MuxedAccount `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	DestAsset   *Asset        `protobuf:"bytes,4,opt,name=dest_asset,proto3" json:"dest_asset,omitempty"`
+	DestAmount  int64         `protobuf:"varint,5,opt,name=dest_amount,proto3" json:"dest_amount,omitempty"`
+	Path        []*Asset      `protobuf:"bytes,6,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *PathPaymentStrictReceiveOp) Reset() {
+	*x = PathPaymentStrictReceiveOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPaymentStrictReceiveOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPaymentStrictReceiveOp) ProtoMessage() {}
+
+func (x *PathPaymentStrictReceiveOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPaymentStrictReceiveOp.ProtoReflect.Descriptor instead.
+func (*PathPaymentStrictReceiveOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *PathPaymentStrictReceiveOp) GetSendAsset() *Asset {
+	if x != nil {
+		return x.SendAsset
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictReceiveOp) GetSendMax() int64 {
+	if x != nil {
+		return x.SendMax
+	}
+	return 0
+}
+
+func (x *PathPaymentStrictReceiveOp) GetDestination() *MuxedAccount {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictReceiveOp) GetDestAsset() *Asset {
+	if x != nil {
+		return x.DestAsset
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictReceiveOp) GetDestAmount() int64 {
+	if x != nil {
+		return x.DestAmount
+	}
+	return 0
+}
+
+func (x *PathPaymentStrictReceiveOp) GetPath() []*Asset {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type PathPaymentStrictReceiveResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32                                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string                                 `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Success  *PathPaymentStrictReceiveResultSuccess `protobuf:"bytes,3,opt,name=success,proto3" json:"success,omitempty"`
+	NoIssuer *Asset                                 `protobuf:"bytes,4,opt,name=no_issuer,proto3" json:"no_issuer,omitempty"`
+}
+
+func (x *PathPaymentStrictReceiveResult) Reset() {
+	*x = PathPaymentStrictReceiveResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPaymentStrictReceiveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPaymentStrictReceiveResult) ProtoMessage() {}
+
+func (x *PathPaymentStrictReceiveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPaymentStrictReceiveResult.ProtoReflect.Descriptor instead.
+func (*PathPaymentStrictReceiveResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *PathPaymentStrictReceiveResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PathPaymentStrictReceiveResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *PathPaymentStrictReceiveResult) GetSuccess() *PathPaymentStrictReceiveResultSuccess {
+	if x != nil {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictReceiveResult) GetNoIssuer() *Asset {
+	if x != nil {
+		return x.NoIssuer
+	}
+	return nil
+}
+
+type PathPaymentStrictReceiveResultSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*ClaimAtom         `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	Last   *SimplePaymentResult `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *PathPaymentStrictReceiveResultSuccess) Reset() {
+	*x = PathPaymentStrictReceiveResultSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPaymentStrictReceiveResultSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPaymentStrictReceiveResultSuccess) ProtoMessage() {}
+
+func (x *PathPaymentStrictReceiveResultSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPaymentStrictReceiveResultSuccess.ProtoReflect.Descriptor instead.
+func (*PathPaymentStrictReceiveResultSuccess) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *PathPaymentStrictReceiveResultSuccess) GetOffers() []*ClaimAtom {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictReceiveResultSuccess) GetLast() *SimplePaymentResult {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+type PathPaymentStrictSendOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendAsset   *Asset        `protobuf:"bytes,1,opt,name=send_asset,proto3" json:"send_asset,omitempty"`
+	SendAmount  int64         `protobuf:"varint,2,opt,name=send_amount,proto3" json:"send_amount,omitempty"`
+	Destination *MuxedAccount `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	DestAsset   *Asset        `protobuf:"bytes,4,opt,name=dest_asset,proto3" json:"dest_asset,omitempty"`
+	DestMin     int64         `protobuf:"varint,5,opt,name=dest_min,proto3" json:"dest_min,omitempty"`
+	Path        []*Asset      `protobuf:"bytes,6,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *PathPaymentStrictSendOp) Reset() {
+	*x = PathPaymentStrictSendOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPaymentStrictSendOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPaymentStrictSendOp) ProtoMessage() {}
+
+func (x *PathPaymentStrictSendOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPaymentStrictSendOp.ProtoReflect.Descriptor instead.
+func (*PathPaymentStrictSendOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *PathPaymentStrictSendOp) GetSendAsset() *Asset {
+	if x != nil {
+		return x.SendAsset
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictSendOp) GetSendAmount() int64 {
+	if x != nil {
+		return x.SendAmount
+	}
+	return 0
+}
+
+func (x *PathPaymentStrictSendOp) GetDestination() *MuxedAccount {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictSendOp) GetDestAsset() *Asset {
+	if x != nil {
+		return x.DestAsset
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictSendOp) GetDestMin() int64 {
+	if x != nil {
+		return x.DestMin
+	}
+	return 0
+}
+
+func (x *PathPaymentStrictSendOp) GetPath() []*Asset {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type PathPaymentStrictSendResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32                               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string                              `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	Success  *PathPaymentStrictSendResultSuccess `protobuf:"bytes,3,opt,name=success,proto3" json:"success,omitempty"`
+	NoIssuer *Asset                              `protobuf:"bytes,4,opt,name=no_issuer,proto3" json:"no_issuer,omitempty"`
+}
+
+func (x *PathPaymentStrictSendResult) Reset() {
+	*x = PathPaymentStrictSendResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPaymentStrictSendResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPaymentStrictSendResult) ProtoMessage() {}
+
+func (x *PathPaymentStrictSendResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPaymentStrictSendResult.ProtoReflect.Descriptor instead.
+func (*PathPaymentStrictSendResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *PathPaymentStrictSendResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PathPaymentStrictSendResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *PathPaymentStrictSendResult) GetSuccess() *PathPaymentStrictSendResultSuccess {
+	if x != nil {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictSendResult) GetNoIssuer() *Asset {
+	if x != nil {
+		return x.NoIssuer
+	}
+	return nil
+}
+
+type PathPaymentStrictSendResultSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*ClaimAtom         `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	Last   *SimplePaymentResult `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *PathPaymentStrictSendResultSuccess) Reset() {
+	*x = PathPaymentStrictSendResultSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPaymentStrictSendResultSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPaymentStrictSendResultSuccess) ProtoMessage() {}
+
+func (x *PathPaymentStrictSendResultSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPaymentStrictSendResultSuccess.ProtoReflect.Descriptor instead.
+func (*PathPaymentStrictSendResultSuccess) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *PathPaymentStrictSendResultSuccess) GetOffers() []*ClaimAtom {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *PathPaymentStrictSendResultSuccess) GetLast() *SimplePaymentResult {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+type PaymentOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination *MuxedAccount `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Asset       *Asset        `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount      int64         `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PaymentOp) Reset() {
+	*x = PaymentOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentOp) ProtoMessage() {}
+
+func (x *PaymentOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentOp.ProtoReflect.Descriptor instead.
+func (*PaymentOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *PaymentOp) GetDestination() *MuxedAccount {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *PaymentOp) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *PaymentOp) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PaymentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *PaymentResult) Reset() {
+	*x = PaymentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentResult) ProtoMessage() {}
+
+func (x *PaymentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentResult.ProtoReflect.Descriptor instead.
+func (*PaymentResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *PaymentResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PaymentResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type Preconditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeBounds *TimeBounds      `protobuf:"bytes,1,opt,name=time_bounds,proto3" json:"time_bounds,omitempty"`
+	V2         *PreconditionsV2 `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
+}
+
+func (x *Preconditions) Reset() {
+	*x = Preconditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preconditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preconditions) ProtoMessage() {}
+
+func (x *Preconditions) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preconditions.ProtoReflect.Descriptor instead.
+func (*Preconditions) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *Preconditions) GetTimeBounds() *TimeBounds {
+	if x != nil {
+		return x.TimeBounds
+	}
+	return nil
+}
+
+func (x *Preconditions) GetV2() *PreconditionsV2 {
+	if x != nil {
+		return x.V2
+	}
+	return nil
+}
+
+type PreconditionsV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeBounds      *TimeBounds   `protobuf:"bytes,1,opt,name=time_bounds,proto3" json:"time_bounds,omitempty"`
+	LedgerBounds    *LedgerBounds `protobuf:"bytes,2,opt,name=ledger_bounds,proto3" json:"ledger_bounds,omitempty"`
+	MinSeqNum       *int64        `protobuf:"varint,3,opt,name=min_seq_num,proto3,oneof" json:"min_seq_num,omitempty"`
+	MinSeqAge       uint64        `protobuf:"varint,4,opt,name=min_seq_age,proto3" json:"min_seq_age,omitempty"`
+	MinSeqLedgerGap uint32        `protobuf:"varint,5,opt,name=min_seq_ledger_gap,proto3" json:"min_seq_ledger_gap,omitempty"`
+	ExtraSigners    []*SignerKey  `protobuf:"bytes,6,rep,name=extra_signers,proto3" json:"extra_signers,omitempty"`
+}
+
+func (x *PreconditionsV2) Reset() {
+	*x = PreconditionsV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreconditionsV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreconditionsV2) ProtoMessage() {}
+
+func (x *PreconditionsV2) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreconditionsV2.ProtoReflect.Descriptor instead.
+func (*PreconditionsV2) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *PreconditionsV2) GetTimeBounds() *TimeBounds {
+	if x != nil {
+		return x.TimeBounds
+	}
+	return nil
+}
+
+func (x *PreconditionsV2) GetLedgerBounds() *LedgerBounds {
+	if x != nil {
+		return x.LedgerBounds
+	}
+	return nil
+}
+
+func (x *PreconditionsV2) GetMinSeqNum() int64 {
+	if x != nil && x.MinSeqNum != nil {
+		return *x.MinSeqNum
+	}
+	return 0
+}
+
+func (x *PreconditionsV2) GetMinSeqAge() uint64 {
+	if x != nil {
+		return x.MinSeqAge
+	}
+	return 0
+}
+
+func (x *PreconditionsV2) GetMinSeqLedgerGap() uint32 {
+	if x != nil {
+		return x.MinSeqLedgerGap
+	}
+	return 0
+}
+
+func (x *PreconditionsV2) GetExtraSigners() []*SignerKey {
+	if x != nil {
+		return x.ExtraSigners
+	}
+	return nil
+}
+
+type Price struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	N int32 `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	D int32 `protobuf:"varint,2,opt,name=d,proto3" json:"d,omitempty"`
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *Price) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *Price) GetD() int32 {
+	if x != nil {
+		return x.D
+	}
+	return 0
+}
+
+type RestoreFootprintOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *RestoreFootprintOp) Reset() {
+	*x = RestoreFootprintOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreFootprintOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFootprintOp) ProtoMessage() {}
+
+func (x *RestoreFootprintOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFootprintOp.ProtoReflect.Descriptor instead.
+func (*RestoreFootprintOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RestoreFootprintOp) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type RestoreFootprintResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *RestoreFootprintResult) Reset() {
+	*x = RestoreFootprintResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreFootprintResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFootprintResult) ProtoMessage() {}
+
+func (x *RestoreFootprintResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFootprintResult.ProtoReflect.Descriptor instead.
+func (*RestoreFootprintResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *RestoreFootprintResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RestoreFootprintResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type RevokeSponsorshipOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LedgerKey *LedgerKey                 `protobuf:"bytes,1,opt,name=ledger_key,proto3" json:"ledger_key,omitempty"`
+	Signer    *RevokeSponsorshipOpSigner `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *RevokeSponsorshipOp) Reset() {
+	*x = RevokeSponsorshipOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSponsorshipOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSponsorshipOp) ProtoMessage() {}
+
+func (x *RevokeSponsorshipOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSponsorshipOp.ProtoReflect.Descriptor instead.
+func (*RevokeSponsorshipOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *RevokeSponsorshipOp) GetLedgerKey() *LedgerKey {
+	if x != nil {
+		return x.LedgerKey
+	}
+	return nil
+}
+
+func (x *RevokeSponsorshipOp) GetSigner() *RevokeSponsorshipOpSigner {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+type RevokeSponsorshipOpSigner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *AccountId `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	SignerKey *SignerKey `protobuf:"bytes,2,opt,name=signer_key,proto3" json:"signer_key,omitempty"`
+}
+
+func (x *RevokeSponsorshipOpSigner) Reset() {
+	*x = RevokeSponsorshipOpSigner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSponsorshipOpSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSponsorshipOpSigner) ProtoMessage() {}
+
+func (x *RevokeSponsorshipOpSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSponsorshipOpSigner.ProtoReflect.Descriptor instead.
+func (*RevokeSponsorshipOpSigner) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *RevokeSponsorshipOpSigner) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *RevokeSponsorshipOpSigner) GetSignerKey() *SignerKey {
+	if x != nil {
+		return x.SignerKey
+	}
+	return nil
+}
+
+type RevokeSponsorshipResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *RevokeSponsorshipResult) Reset() {
+	*x = RevokeSponsorshipResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSponsorshipResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSponsorshipResult) ProtoMessage() {}
+
+func (x *RevokeSponsorshipResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSponsorshipResult.ProtoReflect.Descriptor instead.
+func (*RevokeSponsorshipResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RevokeSponsorshipResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RevokeSponsorshipResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ScAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId  *string `protobuf:"bytes,1,opt,name=account_id,proto3,oneof" json:"account_id,omitempty"`
+	ContractId *string `protobuf:"bytes,2,opt,name=contract_id,proto3,oneof" json:"contract_id,omitempty"`
+}
+
+func (x *ScAddress) Reset() {
+	*x = ScAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScAddress) ProtoMessage() {}
+
+func (x *ScAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScAddress.ProtoReflect.Descriptor instead.
+func (*ScAddress) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ScAddress) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *ScAddress) GetContractId() string {
+	if x != nil && x.ContractId != nil {
+		return *x.ContractId
+	}
+	return ""
+}
+
+type ScContractInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Executable *ContractExecutable `protobuf:"bytes,1,opt,name=executable,proto3" json:"executable,omitempty"`
+	Storage    []*ScMapEntry       `protobuf:"bytes,2,rep,name=storage,proto3" json:"storage,omitempty"`
+}
+
+func (x *ScContractInstance) Reset() {
+	*x = ScContractInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScContractInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScContractInstance) ProtoMessage() {}
+
+func (x *ScContractInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScContractInstance.ProtoReflect.Descriptor instead.
+func (*ScContractInstance) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ScContractInstance) GetExecutable() *ContractExecutable {
+	if x != nil {
+		return x.Executable
+	}
+	return nil
+}
+
+func (x *ScContractInstance) GetStorage() []*ScMapEntry {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+type ScError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ContractCode      *uint32 `protobuf:"varint,1,opt,name=contract_code,proto3,oneof" json:"contract_code,omitempty"`
+	ContractErrorName string  `protobuf:"bytes,4,opt,name=contract_error_name,proto3" json:"contract_error_name,omitempty"`
+	Code              *int32  `protobuf:"varint,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	CodeName          string  `protobuf:"bytes,5,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *ScError) Reset() {
+	*x = ScError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScError) ProtoMessage() {}
+
+func (x *ScError) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScError.ProtoReflect.Descriptor instead.
+func (*ScError) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ScError) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScError) GetContractCode() uint32 {
+	if x != nil && x.ContractCode != nil {
+		return *x.ContractCode
+	}
+	return 0
+}
+
+func (x *ScError) GetContractErrorName() string {
+	if x != nil {
+		return x.ContractErrorName
+	}
+	return ""
+}
+
+func (x *ScError) GetCode() int32 {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return 0
+}
+
+func (x *ScError) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type ScMapEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *ScVal `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Val *ScVal `protobuf:"bytes,2,opt,name=val,proto3" json:"val,omitempty"`
+}
+
+func (x *ScMapEntry) Reset() {
+	*x = ScMapEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScMapEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScMapEntry) ProtoMessage() {}
+
+func (x *ScMapEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScMapEntry.ProtoReflect.Descriptor instead.
+func (*ScMapEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ScMapEntry) GetKey() *ScVal {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ScMapEntry) GetVal() *ScVal {
+	if x != nil {
+		return x.Val
+	}
+	return nil
+}
+
+type ScNonceKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce int64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *ScNonceKey) Reset() {
+	*x = ScNonceKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScNonceKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScNonceKey) ProtoMessage() {}
+
+func (x *ScNonceKey) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScNonceKey.ProtoReflect.Descriptor instead.
+func (*ScNonceKey) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ScNonceKey) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type ScVal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	B         *bool               `protobuf:"varint,1,opt,name=b,proto3,oneof" json:"b,omitempty"`
+	Error     *ScError            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	U32       *uint32             `protobuf:"varint,3,opt,name=u32,proto3,oneof" json:"u32,omitempty"`
+	I32       *int32              `protobuf:"varint,4,opt,name=i32,proto3,oneof" json:"i32,omitempty"`
+	U64       *uint64             `protobuf:"varint,5,opt,name=u64,proto3,oneof" json:"u64,omitempty"`
+	I64       *int64              `protobuf:"varint,6,opt,name=i64,proto3,oneof" json:"i64,omitempty"`
+	Timepoint *uint64             `protobuf:"varint,7,opt,name=timepoint,proto3,oneof" json:"timepoint,omitempty"`
+	Duration  *uint64             `protobuf:"varint,8,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	U128      *UInt128Parts       `protobuf:"bytes,9,opt,name=u128,proto3" json:"u128,omitempty"`
+	I128      *Int128Parts        `protobuf:"bytes,10,opt,name=i128,proto3" json:"i128,omitempty"`
+	U256      *UInt256Parts       `protobuf:"bytes,11,opt,name=u256,proto3" json:"u256,omitempty"`
+	I256      *Int256Parts        `protobuf:"bytes,12,opt,name=i256,proto3" json:"i256,omitempty"`
+	Bytes     []byte              `protobuf:"bytes,13,opt,name=bytes,proto3,oneof" json:"bytes,omitempty"`
+	Str       *string             `protobuf:"bytes,14,opt,name=str,proto3,oneof" json:"str,omitempty"`
+	Sym       *string             `protobuf:"bytes,15,opt,name=sym,proto3,oneof" json:"sym,omitempty"`
+	Vec       []*ScVal            `protobuf:"bytes,16,rep,name=vec,proto3" json:"vec,omitempty"`
+	Map       []*ScMapEntry       `protobuf:"bytes,17,rep,name=map,proto3" json:"map,omitempty"`
+	Address   *ScAddress          `protobuf:"bytes,18,opt,name=address,proto3" json:"address,omitempty"`
+	NonceKey  *ScNonceKey         `protobuf:"bytes,19,opt,name=nonce_key,proto3" json:"nonce_key,omitempty"`
+	Instance  *ScContractInstance `protobuf:"bytes,20,opt,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *ScVal) Reset() {
+	*x = ScVal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScVal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScVal) ProtoMessage() {}
+
+func (x *ScVal) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScVal.ProtoReflect.Descriptor instead.
+func (*ScVal) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ScVal) GetB() bool {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return false
+}
+
+func (x *ScVal) GetError() *ScError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ScVal) GetU32() uint32 {
+	if x != nil && x.U32 != nil {
+		return *x.U32
+	}
+	return 0
+}
+
+func (x *ScVal) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return 0
+}
+
+func (x *ScVal) GetU64() uint64 {
+	if x != nil && x.U64 != nil {
+		return *x.U64
+	}
+	return 0
+}
+
+func (x *ScVal) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return 0
+}
+
+func (x *ScVal) GetTimepoint() uint64 {
+	if x != nil && x.Timepoint != nil {
+		return *x.Timepoint
+	}
+	return 0
+}
+
+func (x *ScVal) GetDuration() uint64 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *ScVal) GetU128() *UInt128Parts {
+	if x != nil {
+		return x.U128
+	}
+	return nil
+}
+
+func (x *ScVal) GetI128() *Int128Parts {
+	if x != nil {
+		return x.I128
+	}
+	return nil
+}
+
+func (x *ScVal) GetU256() *UInt256Parts {
+	if x != nil {
+		return x.U256
+	}
+	return nil
+}
+
+func (x *ScVal) GetI256() *Int256Parts {
+	if x != nil {
+		return x.I256
+	}
+	return nil
+}
+
+func (x *ScVal) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *ScVal) GetStr() string {
+	if x != nil && x.Str != nil {
+		return *x.Str
+	}
+	return ""
+}
+
+func (x *ScVal) GetSym() string {
+	if x != nil && x.Sym != nil {
+		return *x.Sym
+	}
+	return ""
+}
+
+func (x *ScVal) GetVec() []*ScVal {
+	if x != nil {
+		return x.Vec
+	}
+	return nil
+}
+
+func (x *ScVal) GetMap() []*ScMapEntry {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *ScVal) GetAddress() *ScAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ScVal) GetNonceKey() *ScNonceKey {
+	if x != nil {
+		return x.NonceKey
+	}
+	return nil
+}
+
+func (x *ScVal) GetInstance() *ScContractInstance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type SetOptionsOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InflationDest  *AccountId `protobuf:"bytes,1,opt,name=inflation_dest,proto3" json:"inflation_dest,omitempty"`
+	ClearFlags     *uint32    `protobuf:"varint,2,opt,name=clear_flags,proto3,oneof" json:"clear_flags,omitempty"`
+	ClearFlagNames []string   `protobuf:"bytes,3,rep,name=clear_flag_names,proto3" json:"clear_flag_names,omitempty"`
+	SetFlags       *uint32    `protobuf:"varint,4,opt,name=set_flags,proto3,oneof" json:"set_flags,omitempty"`
+	SetFlagNames   []string   `protobuf:"bytes,5,rep,name=set_flag_names,proto3" json:"set_flag_names,omitempty"`
+	MasterWeight   *uint32    `protobuf:"varint,6,opt,name=master_weight,proto3,oneof" json:"master_weight,omitempty"`
+	LowThreshold   *uint32    `protobuf:"varint,7,opt,name=low_threshold,proto3,oneof" json:"low_threshold,omitempty"`
+	MedThreshold   *uint32    `protobuf:"varint,8,opt,name=med_threshold,proto3,oneof" json:"med_threshold,omitempty"`
+	HighThreshold  *uint32    `protobuf:"varint,9,opt,name=high_threshold,proto3,oneof" json:"high_threshold,omitempty"`
+	HomeDomain     *string    `protobuf:"bytes,10,opt,name=home_domain,proto3,oneof" json:"home_domain,omitempty"`
+	Signer         *Signer    `protobuf:"bytes,11,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *SetOptionsOp) Reset() {
+	*x = SetOptionsOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOptionsOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOptionsOp) ProtoMessage() {}
+
+func (x *SetOptionsOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOptionsOp.ProtoReflect.Descriptor instead.
+func (*SetOptionsOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SetOptionsOp) GetInflationDest() *AccountId {
+	if x != nil {
+		return x.InflationDest
+	}
+	return nil
+}
+
+func (x *SetOptionsOp) GetClearFlags() uint32 {
+	if x != nil && x.ClearFlags != nil {
+		return *x.ClearFlags
+	}
+	return 0
+}
+
+func (x *SetOptionsOp) GetClearFlagNames() []string {
+	if x != nil {
+		return x.ClearFlagNames
+	}
+	return nil
+}
+
+func (x *SetOptionsOp) GetSetFlags() uint32 {
+	if x != nil && x.SetFlags != nil {
+		return *x.SetFlags
+	}
+	return 0
+}
+
+func (x *SetOptionsOp) GetSetFlagNames() []string {
+	if x != nil {
+		return x.SetFlagNames
+	}
+	return nil
+}
+
+func (x *SetOptionsOp) GetMasterWeight() uint32 {
+	if x != nil && x.MasterWeight != nil {
+		return *x.MasterWeight
+	}
+	return 0
+}
+
+func (x *SetOptionsOp) GetLowThreshold() uint32 {
+	if x != nil && x.LowThreshold != nil {
+		return *x.LowThreshold
+	}
+	return 0
+}
+
+func (x *SetOptionsOp) GetMedThreshold() uint32 {
+	if x != nil && x.MedThreshold != nil {
+		return *x.MedThreshold
+	}
+	return 0
+}
+
+func (x *SetOptionsOp) GetHighThreshold() uint32 {
+	if x != nil && x.HighThreshold != nil {
+		return *x.HighThreshold
+	}
+	return 0
+}
+
+func (x *SetOptionsOp) GetHomeDomain() string {
+	if x != nil && x.HomeDomain != nil {
+		return *x.HomeDomain
+	}
+	return ""
+}
+
+func (x *SetOptionsOp) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+type SetOptionsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *SetOptionsResult) Reset() {
+	*x = SetOptionsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOptionsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOptionsResult) ProtoMessage() {}
+
+func (x *SetOptionsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOptionsResult.ProtoReflect.Descriptor instead.
+func (*SetOptionsResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SetOptionsResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetOptionsResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type SetTrustLineFlagsOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trustor        *AccountId `protobuf:"bytes,1,opt,name=trustor,proto3" json:"trustor,omitempty"`
+	Asset          *Asset     `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	ClearFlags     uint32     `protobuf:"varint,3,opt,name=clear_flags,proto3" json:"clear_flags,omitempty"`
+	ClearFlagNames []string   `protobuf:"bytes,4,rep,name=clear_flag_names,proto3" json:"clear_flag_names,omitempty"`
+	SetFlags       uint32     `protobuf:"varint,5,opt,name=set_flags,proto3" json:"set_flags,omitempty"`
+	SetFlagNames   []string   `protobuf:"bytes,6,rep,name=set_flag_names,proto3" json:"set_flag_names,omitempty"`
+}
+
+func (x *SetTrustLineFlagsOp) Reset() {
+	*x = SetTrustLineFlagsOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrustLineFlagsOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrustLineFlagsOp) ProtoMessage() {}
+
+func (x *SetTrustLineFlagsOp) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrustLineFlagsOp.ProtoReflect.Descriptor instead.
+func (*SetTrustLineFlagsOp) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *SetTrustLineFlagsOp) GetTrustor() *AccountId {
+	if x != nil {
+		return x.Trustor
+	}
+	return nil
+}
+
+func (x *SetTrustLineFlagsOp) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *SetTrustLineFlagsOp) GetClearFlags() uint32 {
+	if x != nil {
+		return x.ClearFlags
+	}
+	return 0
+}
+
+func (x *SetTrustLineFlagsOp) GetClearFlagNames() []string {
+	if x != nil {
+		return x.ClearFlagNames
+	}
+	return nil
+}
+
+func (x *SetTrustLineFlagsOp) GetSetFlags() uint32 {
+	if x != nil {
+		return x.SetFlags
+	}
+	return 0
+}
+
+func (x *SetTrustLineFlagsOp) GetSetFlagNames() []string {
+	if x != nil {
+		return x.SetFlagNames
+	}
+	return nil
+}
+
+type SetTrustLineFlagsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName string `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+}
+
+func (x *SetTrustLineFlagsResult) Reset() {
+	*x = SetTrustLineFlagsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrustLineFlagsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrustLineFlagsResult) ProtoMessage() {}
+
+func (x *SetTrustLineFlagsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrustLineFlagsResult.ProtoReflect.Descriptor instead.
+func (*SetTrustLineFlagsResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *SetTrustLineFlagsResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetTrustLineFlagsResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+type Signer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    *SignerKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Weight uint32     `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *Signer) Reset() {
+	*x = Signer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Signer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signer) ProtoMessage() {}
+
+func (x *Signer) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signer.ProtoReflect.Descriptor instead.
+func (*Signer) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *Signer) GetKey() *SignerKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Signer) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type SignerKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *SignerKey) Reset() {
+	*x = SignerKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignerKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignerKey) ProtoMessage() {}
+
+func (x *SignerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignerKey.ProtoReflect.Descriptor instead.
+func (*SignerKey) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *SignerKey) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type SimplePaymentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination *AccountId `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Asset       *Asset     `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount      int64      `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SimplePaymentResult) Reset() {
+	*x = SimplePaymentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimplePaymentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplePaymentResult) ProtoMessage() {}
+
+func (x *SimplePaymentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplePaymentResult.ProtoReflect.Descriptor instead.
+func (*SimplePaymentResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *SimplePaymentResult) GetDestination() *AccountId {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *SimplePaymentResult) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *SimplePaymentResult) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SorobanAddressCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address                   *ScAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce                     int64      `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	SignatureExpirationLedger uint32     `protobuf:"varint,3,opt,name=signature_expiration_ledger,proto3" json:"signature_expiration_ledger,omitempty"`
+	Signature                 *ScVal     `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SorobanAddressCredentials) Reset() {
+	*x = SorobanAddressCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanAddressCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanAddressCredentials) ProtoMessage() {}
+
+func (x *SorobanAddressCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanAddressCredentials.ProtoReflect.Descriptor instead.
+func (*SorobanAddressCredentials) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *SorobanAddressCredentials) GetAddress() *ScAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *SorobanAddressCredentials) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *SorobanAddressCredentials) GetSignatureExpirationLedger() uint32 {
+	if x != nil {
+		return x.SignatureExpirationLedger
+	}
+	return 0
+}
+
+func (x *SorobanAddressCredentials) GetSignature() *ScVal {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SorobanAuthorizationEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials    *SorobanCredentials          `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	RootInvocation *SorobanAuthorizedInvocation `protobuf:"bytes,2,opt,name=root_invocation,proto3" json:"root_invocation,omitempty"`
+}
+
+func (x *SorobanAuthorizationEntry) Reset() {
+	*x = SorobanAuthorizationEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanAuthorizationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanAuthorizationEntry) ProtoMessage() {}
+
+func (x *SorobanAuthorizationEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanAuthorizationEntry.ProtoReflect.Descriptor instead.
+func (*SorobanAuthorizationEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SorobanAuthorizationEntry) GetCredentials() *SorobanCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *SorobanAuthorizationEntry) GetRootInvocation() *SorobanAuthorizedInvocation {
+	if x != nil {
+		return x.RootInvocation
+	}
+	return nil
+}
+
+type SorobanAuthorizedFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractFn           *InvokeContractArgs `protobuf:"bytes,1,opt,name=contract_fn,proto3" json:"contract_fn,omitempty"`
+	CreateContractHostFn *CreateContractArgs `protobuf:"bytes,2,opt,name=create_contract_host_fn,proto3" json:"create_contract_host_fn,omitempty"`
+}
+
+func (x *SorobanAuthorizedFunction) Reset() {
+	*x = SorobanAuthorizedFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanAuthorizedFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanAuthorizedFunction) ProtoMessage() {}
+
+func (x *SorobanAuthorizedFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanAuthorizedFunction.ProtoReflect.Descriptor instead.
+func (*SorobanAuthorizedFunction) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *SorobanAuthorizedFunction) GetContractFn() *InvokeContractArgs {
+	if x != nil {
+		return x.ContractFn
+	}
+	return nil
+}
+
+func (x *SorobanAuthorizedFunction) GetCreateContractHostFn() *CreateContractArgs {
+	if x != nil {
+		return x.CreateContractHostFn
+	}
+	return nil
+}
+
+type SorobanAuthorizedInvocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function       *SorobanAuthorizedFunction     `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	SubInvocations []*SorobanAuthorizedInvocation `protobuf:"bytes,2,rep,name=sub_invocations,proto3" json:"sub_invocations,omitempty"`
+}
+
+func (x *SorobanAuthorizedInvocation) Reset() {
+	*x = SorobanAuthorizedInvocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanAuthorizedInvocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanAuthorizedInvocation) ProtoMessage() {}
+
+func (x *SorobanAuthorizedInvocation) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanAuthorizedInvocation.ProtoReflect.Descriptor instead.
+func (*SorobanAuthorizedInvocation) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *SorobanAuthorizedInvocation) GetFunction() *SorobanAuthorizedFunction {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *SorobanAuthorizedInvocation) GetSubInvocations() []*SorobanAuthorizedInvocation {
+	if x != nil {
+		return x.SubInvocations
+	}
+	return nil
+}
+
+type SorobanCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *SorobanAddressCredentials `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *SorobanCredentials) Reset() {
+	*x = SorobanCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanCredentials) ProtoMessage() {}
+
+func (x *SorobanCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanCredentials.ProtoReflect.Descriptor instead.
+func (*SorobanCredentials) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *SorobanCredentials) GetAddress() *SorobanAddressCredentials {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type SorobanResources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Footprint    *LedgerFootprint `protobuf:"bytes,1,opt,name=footprint,proto3" json:"footprint,omitempty"`
+	Instructions uint32           `protobuf:"varint,2,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	ReadBytes    uint32           `protobuf:"varint,3,opt,name=read_bytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes   uint32           `protobuf:"varint,4,opt,name=write_bytes,proto3" json:"write_bytes,omitempty"`
+}
+
+func (x *SorobanResources) Reset() {
+	*x = SorobanResources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanResources) ProtoMessage() {}
+
+func (x *SorobanResources) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanResources.ProtoReflect.Descriptor instead.
+func (*SorobanResources) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *SorobanResources) GetFootprint() *LedgerFootprint {
+	if x != nil {
+		return x.Footprint
+	}
+	return nil
+}
+
+func (x *SorobanResources) GetInstructions() uint32 {
+	if x != nil {
+		return x.Instructions
+	}
+	return 0
+}
+
+func (x *SorobanResources) GetReadBytes() uint32 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *SorobanResources) GetWriteBytes() uint32 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+type SorobanTransactionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext         *ExtensionPoint   `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	Resources   *SorobanResources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	ResourceFee int64             `protobuf:"varint,3,opt,name=resource_fee,proto3" json:"resource_fee,omitempty"`
+}
+
+func (x *SorobanTransactionData) Reset() {
+	*x = SorobanTransactionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanTransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanTransactionData) ProtoMessage() {}
+
+func (x *SorobanTransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanTransactionData.ProtoReflect.Descriptor instead.
+func (*SorobanTransactionData) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *SorobanTransactionData) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *SorobanTransactionData) GetResources() *SorobanResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *SorobanTransactionData) GetResourceFee() int64 {
+	if x != nil {
+		return x.ResourceFee
+	}
+	return 0
+}
+
+type SorobanTransactionMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext              *SorobanTransactionMetaExt `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	Events           []*ContractEvent           `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	ReturnValue      *ScVal                     `protobuf:"bytes,3,opt,name=return_value,proto3" json:"return_value,omitempty"`
+	DiagnosticEvents []*DiagnosticEvent         `protobuf:"bytes,4,rep,name=diagnostic_events,proto3" json:"diagnostic_events,omitempty"`
+}
+
+func (x *SorobanTransactionMeta) Reset() {
+	*x = SorobanTransactionMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanTransactionMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanTransactionMeta) ProtoMessage() {}
+
+func (x *SorobanTransactionMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanTransactionMeta.ProtoReflect.Descriptor instead.
+func (*SorobanTransactionMeta) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *SorobanTransactionMeta) GetExt() *SorobanTransactionMetaExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *SorobanTransactionMeta) GetEvents() []*ContractEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SorobanTransactionMeta) GetReturnValue() *ScVal {
+	if x != nil {
+		return x.ReturnValue
+	}
+	return nil
+}
+
+func (x *SorobanTransactionMeta) GetDiagnosticEvents() []*DiagnosticEvent {
+	if x != nil {
+		return x.DiagnosticEvents
+	}
+	return nil
+}
+
+type SorobanTransactionMetaExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                        `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V1 *SorobanTransactionMetaExtV1 `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *SorobanTransactionMetaExt) Reset() {
+	*x = SorobanTransactionMetaExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanTransactionMetaExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanTransactionMetaExt) ProtoMessage() {}
+
+func (x *SorobanTransactionMetaExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanTransactionMetaExt.ProtoReflect.Descriptor instead.
+func (*SorobanTransactionMetaExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SorobanTransactionMetaExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *SorobanTransactionMetaExt) GetV1() *SorobanTransactionMetaExtV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type SorobanTransactionMetaExtV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext                                  *ExtensionPoint `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	TotalNonRefundableResourceFeeCharged int64           `protobuf:"varint,2,opt,name=total_non_refundable_resource_fee_charged,proto3" json:"total_non_refundable_resource_fee_charged,omitempty"`
+	TotalRefundableResourceFeeCharged    int64           `protobuf:"varint,3,opt,name=total_refundable_resource_fee_charged,proto3" json:"total_refundable_resource_fee_charged,omitempty"`
+	RentFeeCharged                       int64           `protobuf:"varint,4,opt,name=rent_fee_charged,proto3" json:"rent_fee_charged,omitempty"`
+}
+
+func (x *SorobanTransactionMetaExtV1) Reset() {
+	*x = SorobanTransactionMetaExtV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanTransactionMetaExtV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanTransactionMetaExtV1) ProtoMessage() {}
+
+func (x *SorobanTransactionMetaExtV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanTransactionMetaExtV1.ProtoReflect.Descriptor instead.
+func (*SorobanTransactionMetaExtV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SorobanTransactionMetaExtV1) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *SorobanTransactionMetaExtV1) GetTotalNonRefundableResourceFeeCharged() int64 {
+	if x != nil {
+		return x.TotalNonRefundableResourceFeeCharged
+	}
+	return 0
+}
+
+func (x *SorobanTransactionMetaExtV1) GetTotalRefundableResourceFeeCharged() int64 {
+	if x != nil {
+		return x.TotalRefundableResourceFeeCharged
+	}
+	return 0
+}
+
+func (x *SorobanTransactionMetaExtV1) GetRentFeeCharged() int64 {
+	if x != nil {
+		return x.RentFeeCharged
+	}
+	return 0
+}
+
+type SorobanTransactionMetaV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext         *SorobanTransactionMetaExt `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	ReturnValue *ScVal                     `protobuf:"bytes,2,opt,name=return_value,proto3" json:"return_value,omitempty"`
+}
+
+func (x *SorobanTransactionMetaV2) Reset() {
+	*x = SorobanTransactionMetaV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SorobanTransactionMetaV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SorobanTransactionMetaV2) ProtoMessage() {}
+
+func (x *SorobanTransactionMetaV2) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SorobanTransactionMetaV2.ProtoReflect.Descriptor instead.
+func (*SorobanTransactionMetaV2) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *SorobanTransactionMetaV2) GetExt() *SorobanTransactionMetaExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *SorobanTransactionMetaV2) GetReturnValue() *ScVal {
+	if x != nil {
+		return x.ReturnValue
+	}
+	return nil
+}
+
+type StateArchivalSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxEntryTtl                    uint32 `protobuf:"varint,1,opt,name=max_entry_ttl,proto3" json:"max_entry_ttl,omitempty"`
+	MinTemporaryTtl                uint32 `protobuf:"varint,2,opt,name=min_temporary_ttl,proto3" json:"min_temporary_ttl,omitempty"`
+	MinPersistentTtl               uint32 `protobuf:"varint,3,opt,name=min_persistent_ttl,proto3" json:"min_persistent_ttl,omitempty"`
+	PersistentRentRateDenominator  int64  `protobuf:"varint,4,opt,name=persistent_rent_rate_denominator,proto3" json:"persistent_rent_rate_denominator,omitempty"`
+	TempRentRateDenominator        int64  `protobuf:"varint,5,opt,name=temp_rent_rate_denominator,proto3" json:"temp_rent_rate_denominator,omitempty"`
+	MaxEntriesToArchive            uint32 `protobuf:"varint,6,opt,name=max_entries_to_archive,proto3" json:"max_entries_to_archive,omitempty"`
+	BucketListSizeWindowSampleSize uint32 `protobuf:"varint,7,opt,name=bucket_list_size_window_sample_size,proto3" json:"bucket_list_size_window_sample_size,omitempty"`
+	BucketListWindowSamplePeriod   uint32 `protobuf:"varint,8,opt,name=bucket_list_window_sample_period,proto3" json:"bucket_list_window_sample_period,omitempty"`
+	EvictionScanSize               uint32 `protobuf:"varint,9,opt,name=eviction_scan_size,proto3" json:"eviction_scan_size,omitempty"`
+	StartingEvictionScanLevel      uint32 `protobuf:"varint,10,opt,name=starting_eviction_scan_level,proto3" json:"starting_eviction_scan_level,omitempty"`
+}
+
+func (x *StateArchivalSettings) Reset() {
+	*x = StateArchivalSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateArchivalSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateArchivalSettings) ProtoMessage() {}
+
+func (x *StateArchivalSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateArchivalSettings.ProtoReflect.Descriptor instead.
+func (*StateArchivalSettings) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *StateArchivalSettings) GetMaxEntryTtl() uint32 {
+	if x != nil {
+		return x.MaxEntryTtl
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetMinTemporaryTtl() uint32 {
+	if x != nil {
+		return x.MinTemporaryTtl
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetMinPersistentTtl() uint32 {
+	if x != nil {
+		return x.MinPersistentTtl
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetPersistentRentRateDenominator() int64 {
+	if x != nil {
+		return x.PersistentRentRateDenominator
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetTempRentRateDenominator() int64 {
+	if x != nil {
+		return x.TempRentRateDenominator
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetMaxEntriesToArchive() uint32 {
+	if x != nil {
+		return x.MaxEntriesToArchive
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetBucketListSizeWindowSampleSize() uint32 {
+	if x != nil {
+		return x.BucketListSizeWindowSampleSize
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetBucketListWindowSamplePeriod() uint32 {
+	if x != nil {
+		return x.BucketListWindowSamplePeriod
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetEvictionScanSize() uint32 {
+	if x != nil {
+		return x.EvictionScanSize
+	}
+	return 0
+}
+
+func (x *StateArchivalSettings) GetStartingEvictionScanLevel() uint32 {
+	if x != nil {
+		return x.StartingEvictionScanLevel
+	}
+	return 0
+}
+
+type TimeBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinTime uint64 `protobuf:"varint,1,opt,name=min_time,proto3" json:"min_time,omitempty"`
+	MaxTime uint64 `protobuf:"varint,2,opt,name=max_time,proto3" json:"max_time,omitempty"`
+}
+
+func (x *TimeBounds) Reset() {
+	*x = TimeBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBounds) ProtoMessage() {}
+
+func (x *TimeBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBounds.ProtoReflect.Descriptor instead.
+func (*TimeBounds) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *TimeBounds) GetMinTime() uint64 {
+	if x != nil {
+		return x.MinTime
+	}
+	return 0
+}
+
+func (x *TimeBounds) GetMaxTime() uint64 {
+	if x != nil {
+		return x.MaxTime
+	}
+	return 0
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceAccount *MuxedAccount   `protobuf:"bytes,1,opt,name=source_account,proto3" json:"source_account,omitempty"`
+	Fee           uint32          `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	SeqNum        int64           `protobuf:"varint,3,opt,name=seq_num,proto3" json:"seq_num,omitempty"`
+	Cond          *Preconditions  `protobuf:"bytes,4,opt,name=cond,proto3" json:"cond,omitempty"`
+	Memo          *Memo           `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Operations    []*Operation    `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"`
+	Ext           *TransactionExt `protobuf:"bytes,7,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *Transaction) GetSourceAccount() *MuxedAccount {
+	if x != nil {
+		return x.SourceAccount
+	}
+	return nil
+}
+
+func (x *Transaction) GetFee() uint32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Transaction) GetSeqNum() int64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *Transaction) GetCond() *Preconditions {
+	if x != nil {
+		return x.Cond
+	}
+	return nil
+}
+
+func (x *Transaction) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *Transaction) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *Transaction) GetExt() *TransactionExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type TransactionEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V0      *TransactionV0Envelope      `protobuf:"bytes,1,opt,name=v0,proto3" json:"v0,omitempty"`
+	V1      *TransactionV1Envelope      `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+	Feebump *FeeBumpTransactionEnvelope `protobuf:"bytes,3,opt,name=feebump,proto3" json:"feebump,omitempty"`
+}
+
+func (x *TransactionEnvelope) Reset() {
+	*x = TransactionEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEnvelope) ProtoMessage() {}
+
+func (x *TransactionEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionEnvelope.ProtoReflect.Descriptor instead.
+func (*TransactionEnvelope) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *TransactionEnvelope) GetV0() *TransactionV0Envelope {
+	if x != nil {
+		return x.V0
+	}
+	return nil
+}
+
+func (x *TransactionEnvelope) GetV1() *TransactionV1Envelope {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+func (x *TransactionEnvelope) GetFeebump() *FeeBumpTransactionEnvelope {
+	if x != nil {
+		return x.Feebump
+	}
+	return nil
+}
+
+type TransactionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage     int32          `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	StageName string         `protobuf:"bytes,2,opt,name=stage_name,proto3" json:"stage_name,omitempty"`
+	Event     *ContractEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *TransactionEvent) Reset() {
+	*x = TransactionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEvent) ProtoMessage() {}
+
+func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
+func (*TransactionEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *TransactionEvent) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *TransactionEvent) GetStageName() string {
+	if x != nil {
+		return x.StageName
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetEvent() *ContractEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type TransactionExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V           int32                   `protobuf:"varint,1,opt,name=V,proto3" json:"V,omitempty"`
+	SorobanData *SorobanTransactionData `protobuf:"bytes,2,opt,name=soroban_data,proto3" json:"soroban_data,omitempty"`
+}
+
+func (x *TransactionExt) Reset() {
+	*x = TransactionExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionExt) ProtoMessage() {}
+
+func (x *TransactionExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionExt.ProtoReflect.Descriptor instead.
+func (*TransactionExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *TransactionExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *TransactionExt) GetSorobanData() *SorobanTransactionData {
+	if x != nil {
+		return x.SorobanData
+	}
+	return nil
+}
+
+type TransactionMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V          int32              `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	Operations []*OperationMeta   `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	V1         *TransactionMetaV1 `protobuf:"bytes,3,opt,name=v1,proto3" json:"v1,omitempty"`
+	V2         *TransactionMetaV2 `protobuf:"bytes,4,opt,name=v2,proto3" json:"v2,omitempty"`
+	V3         *TransactionMetaV3 `protobuf:"bytes,5,opt,name=v3,proto3" json:"v3,omitempty"`
+	V4         *TransactionMetaV4 `protobuf:"bytes,6,opt,name=v4,proto3" json:"v4,omitempty"`
+}
+
+func (x *TransactionMeta) Reset() {
+	*x = TransactionMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionMeta) ProtoMessage() {}
+
+func (x *TransactionMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionMeta.ProtoReflect.Descriptor instead.
+func (*TransactionMeta) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *TransactionMeta) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *TransactionMeta) GetOperations() []*OperationMeta {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionMeta) GetV1() *TransactionMetaV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+func (x *TransactionMeta) GetV2() *TransactionMetaV2 {
+	if x != nil {
+		return x.V2
+	}
+	return nil
+}
+
+func (x *TransactionMeta) GetV3() *TransactionMetaV3 {
+	if x != nil {
+		return x.V3
+	}
+	return nil
+}
+
+func (x *TransactionMeta) GetV4() *TransactionMetaV4 {
+	if x != nil {
+		return x.V4
+	}
+	return nil
+}
+
+type TransactionMetaV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxChanges  []*LedgerEntryChange `protobuf:"bytes,1,rep,name=tx_changes,proto3" json:"tx_changes,omitempty"`
+	Operations []*OperationMeta     `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *TransactionMetaV1) Reset() {
+	*x = TransactionMetaV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionMetaV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionMetaV1) ProtoMessage() {}
+
+func (x *TransactionMetaV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionMetaV1.ProtoReflect.Descriptor instead.
+func (*TransactionMetaV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *TransactionMetaV1) GetTxChanges() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChanges
+	}
+	return nil
+}
+
+func (x *TransactionMetaV1) GetOperations() []*OperationMeta {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type TransactionMetaV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxChangesBefore []*LedgerEntryChange `protobuf:"bytes,1,rep,name=tx_changes_before,proto3" json:"tx_changes_before,omitempty"`
+	Operations      []*OperationMeta     `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	TxChangesAfter  []*LedgerEntryChange `protobuf:"bytes,3,rep,name=tx_changes_after,proto3" json:"tx_changes_after,omitempty"`
+}
+
+func (x *TransactionMetaV2) Reset() {
+	*x = TransactionMetaV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionMetaV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionMetaV2) ProtoMessage() {}
+
+func (x *TransactionMetaV2) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionMetaV2.ProtoReflect.Descriptor instead.
+func (*TransactionMetaV2) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *TransactionMetaV2) GetTxChangesBefore() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChangesBefore
+	}
+	return nil
+}
+
+func (x *TransactionMetaV2) GetOperations() []*OperationMeta {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionMetaV2) GetTxChangesAfter() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChangesAfter
+	}
+	return nil
+}
+
+type TransactionMetaV3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext             *ExtensionPoint         `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	TxChangesBefore []*LedgerEntryChange    `protobuf:"bytes,2,rep,name=tx_changes_before,proto3" json:"tx_changes_before,omitempty"`
+	Operations      []*OperationMeta        `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	TxChangesAfter  []*LedgerEntryChange    `protobuf:"bytes,4,rep,name=tx_changes_after,proto3" json:"tx_changes_after,omitempty"`
+	SorobanMeta     *SorobanTransactionMeta `protobuf:"bytes,5,opt,name=soroban_meta,proto3" json:"soroban_meta,omitempty"`
+}
+
+func (x *TransactionMetaV3) Reset() {
+	*x = TransactionMetaV3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionMetaV3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionMetaV3) ProtoMessage() {}
+
+func (x *TransactionMetaV3) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionMetaV3.ProtoReflect.Descriptor instead.
+func (*TransactionMetaV3) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *TransactionMetaV3) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *TransactionMetaV3) GetTxChangesBefore() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChangesBefore
+	}
+	return nil
+}
+
+func (x *TransactionMetaV3) GetOperations() []*OperationMeta {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionMetaV3) GetTxChangesAfter() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChangesAfter
+	}
+	return nil
+}
+
+func (x *TransactionMetaV3) GetSorobanMeta() *SorobanTransactionMeta {
+	if x != nil {
+		return x.SorobanMeta
+	}
+	return nil
+}
+
+type TransactionMetaV4 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ext              *ExtensionPoint           `protobuf:"bytes,1,opt,name=ext,proto3" json:"ext,omitempty"`
+	TxChangesBefore  []*LedgerEntryChange      `protobuf:"bytes,2,rep,name=tx_changes_before,proto3" json:"tx_changes_before,omitempty"`
+	Operations       []*OperationMetaV2        `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	TxChangesAfter   []*LedgerEntryChange      `protobuf:"bytes,4,rep,name=tx_changes_after,proto3" json:"tx_changes_after,omitempty"`
+	SorobanMeta      *SorobanTransactionMetaV2 `protobuf:"bytes,5,opt,name=soroban_meta,proto3" json:"soroban_meta,omitempty"`
+	Events           []*TransactionEvent       `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	DiagnosticEvents []*DiagnosticEvent        `protobuf:"bytes,7,rep,name=diagnostic_events,proto3" json:"diagnostic_events,omitempty"`
+}
+
+func (x *TransactionMetaV4) Reset() {
+	*x = TransactionMetaV4{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionMetaV4) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionMetaV4) ProtoMessage() {}
+
+func (x *TransactionMetaV4) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionMetaV4.ProtoReflect.Descriptor instead.
+func (*TransactionMetaV4) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *TransactionMetaV4) GetExt() *ExtensionPoint {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *TransactionMetaV4) GetTxChangesBefore() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChangesBefore
+	}
+	return nil
+}
+
+func (x *TransactionMetaV4) GetOperations() []*OperationMetaV2 {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionMetaV4) GetTxChangesAfter() []*LedgerEntryChange {
+	if x != nil {
+		return x.TxChangesAfter
+	}
+	return nil
+}
+
+func (x *TransactionMetaV4) GetSorobanMeta() *SorobanTransactionMetaV2 {
+	if x != nil {
+		return x.SorobanMeta
+	}
+	return nil
+}
+
+func (x *TransactionMetaV4) GetEvents() []*TransactionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *TransactionMetaV4) GetDiagnosticEvents() []*DiagnosticEvent {
+	if x != nil {
+		return x.DiagnosticEvents
+	}
+	return nil
+}
+
+type TransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeCharged int64                    `protobuf:"varint,1,opt,name=fee_charged,proto3" json:"fee_charged,omitempty"`
+	Result     *TransactionResultResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Ext        *TransactionResultExt    `protobuf:"bytes,3,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *TransactionResult) Reset() {
+	*x = TransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResult) ProtoMessage() {}
+
+func (x *TransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResult.ProtoReflect.Descriptor instead.
+func (*TransactionResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *TransactionResult) GetFeeCharged() int64 {
+	if x != nil {
+		return x.FeeCharged
+	}
+	return 0
+}
+
+func (x *TransactionResult) GetResult() *TransactionResultResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TransactionResult) GetExt() *TransactionResultExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type TransactionResultExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *TransactionResultExt) Reset() {
+	*x = TransactionResultExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResultExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResultExt) ProtoMessage() {}
+
+func (x *TransactionResultExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResultExt.ProtoReflect.Descriptor instead.
+func (*TransactionResultExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *TransactionResultExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type TransactionResultMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result                   *TransactionResultPair `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	FeeProcessing            []*LedgerEntryChange   `protobuf:"bytes,2,rep,name=fee_processing,proto3" json:"fee_processing,omitempty"`
+	TxApplyProcessing        *TransactionMeta       `protobuf:"bytes,3,opt,name=tx_apply_processing,proto3" json:"tx_apply_processing,omitempty"`
+	PostTxApplyFeeProcessing []*LedgerEntryChange   `protobuf:"bytes,4,rep,name=post_tx_apply_fee_processing,proto3" json:"post_tx_apply_fee_processing,omitempty"`
+}
+
+func (x *TransactionResultMeta) Reset() {
+	*x = TransactionResultMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResultMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResultMeta) ProtoMessage() {}
+
+func (x *TransactionResultMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResultMeta.ProtoReflect.Descriptor instead.
+func (*TransactionResultMeta) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *TransactionResultMeta) GetResult() *TransactionResultPair {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TransactionResultMeta) GetFeeProcessing() []*LedgerEntryChange {
+	if x != nil {
+		return x.FeeProcessing
+	}
+	return nil
+}
+
+func (x *TransactionResultMeta) GetTxApplyProcessing() *TransactionMeta {
+	if x != nil {
+		return x.TxApplyProcessing
+	}
+	return nil
+}
+
+func (x *TransactionResultMeta) GetPostTxApplyFeeProcessing() []*LedgerEntryChange {
+	if x != nil {
+		return x.PostTxApplyFeeProcessing
+	}
+	return nil
+}
+
+type TransactionResultPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionHash string             `protobuf:"bytes,1,opt,name=transaction_hash,proto3" json:"transaction_hash,omitempty"`
+	Result          *TransactionResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *TransactionResultPair) Reset() {
+	*x = TransactionResultPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResultPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResultPair) ProtoMessage() {}
+
+func (x *TransactionResultPair) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResultPair.ProtoReflect.Descriptor instead.
+func (*TransactionResultPair) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *TransactionResultPair) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *TransactionResultPair) GetResult() *TransactionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type TransactionResultResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code            int32                       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeName        string                      `protobuf:"bytes,2,opt,name=code_name,proto3" json:"code_name,omitempty"`
+	InnerResultPair *InnerTransactionResultPair `protobuf:"bytes,3,opt,name=inner_result_pair,proto3" json:"inner_result_pair,omitempty"`
+	Results         []*OperationResult          `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *TransactionResultResult) Reset() {
+	*x = TransactionResultResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResultResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResultResult) ProtoMessage() {}
+
+func (x *TransactionResultResult) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResultResult.ProtoReflect.Descriptor instead.
+func (*TransactionResultResult) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *TransactionResultResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TransactionResultResult) GetCodeName() string {
+	if x != nil {
+		return x.CodeName
+	}
+	return ""
+}
+
+func (x *TransactionResultResult) GetInnerResultPair() *InnerTransactionResultPair {
+	if x != nil {
+		return x.InnerResultPair
+	}
+	return nil
+}
+
+func (x *TransactionResultResult) GetResults() []*OperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TransactionV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceAccountEd25519 string            `protobuf:"bytes,1,opt,name=source_account_ed25519,proto3" json:"source_account_ed25519,omitempty"`
+	Fee                  uint32            `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	SeqNum               int64             `protobuf:"varint,3,opt,name=seq_num,proto3" json:"seq_num,omitempty"`
+	TimeBounds           *TimeBounds       `protobuf:"bytes,4,opt,name=time_bounds,proto3" json:"time_bounds,omitempty"`
+	Memo                 *Memo             `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Operations           []*Operation      `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"`
+	Ext                  *TransactionV0Ext `protobuf:"bytes,7,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *TransactionV0) Reset() {
+	*x = TransactionV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionV0) ProtoMessage() {}
+
+func (x *TransactionV0) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionV0.ProtoReflect.Descriptor instead.
+func (*TransactionV0) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *TransactionV0) GetSourceAccountEd25519() string {
+	if x != nil {
+		return x.SourceAccountEd25519
+	}
+	return ""
+}
+
+func (x *TransactionV0) GetFee() uint32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TransactionV0) GetSeqNum() int64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *TransactionV0) GetTimeBounds() *TimeBounds {
+	if x != nil {
+		return x.TimeBounds
+	}
+	return nil
+}
+
+func (x *TransactionV0) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *TransactionV0) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionV0) GetExt() *TransactionV0Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type TransactionV0Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx         *TransactionV0        `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Signatures []*DecoratedSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *TransactionV0Envelope) Reset() {
+	*x = TransactionV0Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionV0Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionV0Envelope) ProtoMessage() {}
+
+func (x *TransactionV0Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionV0Envelope.ProtoReflect.Descriptor instead.
+func (*TransactionV0Envelope) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *TransactionV0Envelope) GetTx() *TransactionV0 {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *TransactionV0Envelope) GetSignatures() []*DecoratedSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type TransactionV0Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *TransactionV0Ext) Reset() {
+	*x = TransactionV0Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionV0Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionV0Ext) ProtoMessage() {}
+
+func (x *TransactionV0Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionV0Ext.ProtoReflect.Descriptor instead.
+func (*TransactionV0Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *TransactionV0Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type TransactionV1Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx         *Transaction          `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Signatures []*DecoratedSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *TransactionV1Envelope) Reset() {
+	*x = TransactionV1Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionV1Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionV1Envelope) ProtoMessage() {}
+
+func (x *TransactionV1Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionV1Envelope.ProtoReflect.Descriptor instead.
+func (*TransactionV1Envelope) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *TransactionV1Envelope) GetTx() *Transaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *TransactionV1Envelope) GetSignatures() []*DecoratedSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type TransferEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   string       `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string       `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount *Int128Parts `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *TransferEvent) Reset() {
+	*x = TransferEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEvent) ProtoMessage() {}
+
+func (x *TransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
+func (*TransferEvent) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *TransferEvent) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TransferEvent) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransferEvent) GetAmount() *Int128Parts {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type TrustLineAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset           *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	LiquidityPoolId []byte `protobuf:"bytes,2,opt,name=liquidity_pool_id,proto3,oneof" json:"liquidity_pool_id,omitempty"`
+}
+
+func (x *TrustLineAsset) Reset() {
+	*x = TrustLineAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineAsset) ProtoMessage() {}
+
+func (x *TrustLineAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineAsset.ProtoReflect.Descriptor instead.
+func (*TrustLineAsset) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *TrustLineAsset) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *TrustLineAsset) GetLiquidityPoolId() []byte {
+	if x != nil {
+		return x.LiquidityPoolId
+	}
+	return nil
+}
+
+type TrustLineEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId *AccountId         `protobuf:"bytes,1,opt,name=account_id,proto3" json:"account_id,omitempty"`
+	Asset     *TrustLineAsset    `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Balance   int64              `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Limit     int64              `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Flags     uint32             `protobuf:"varint,5,opt,name=flags,proto3" json:"flags,omitempty"`
+	FlagNames []string           `protobuf:"bytes,6,rep,name=flag_names,proto3" json:"flag_names,omitempty"`
+	Ext       *TrustLineEntryExt `protobuf:"bytes,7,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *TrustLineEntry) Reset() {
+	*x = TrustLineEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineEntry) ProtoMessage() {}
+
+func (x *TrustLineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineEntry.ProtoReflect.Descriptor instead.
+func (*TrustLineEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *TrustLineEntry) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *TrustLineEntry) GetAsset() *TrustLineAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *TrustLineEntry) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *TrustLineEntry) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TrustLineEntry) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *TrustLineEntry) GetFlagNames() []string {
+	if x != nil {
+		return x.FlagNames
+	}
+	return nil
+}
+
+func (x *TrustLineEntry) GetExt() *TrustLineEntryExt {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type TrustLineEntryExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32             `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V1 *TrustLineEntryV1 `protobuf:"bytes,2,opt,name=v1,proto3" json:"v1,omitempty"`
+}
+
+func (x *TrustLineEntryExt) Reset() {
+	*x = TrustLineEntryExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineEntryExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineEntryExt) ProtoMessage() {}
+
+func (x *TrustLineEntryExt) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineEntryExt.ProtoReflect.Descriptor instead.
+func (*TrustLineEntryExt) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *TrustLineEntryExt) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *TrustLineEntryExt) GetV1() *TrustLineEntryV1 {
+	if x != nil {
+		return x.V1
+	}
+	return nil
+}
+
+type TrustLineEntryExtensionV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiquidityPoolUseCount int32                         `protobuf:"varint,1,opt,name=liquidity_pool_use_count,proto3" json:"liquidity_pool_use_count,omitempty"`
+	Ext                   *TrustLineEntryExtensionV2Ext `protobuf:"bytes,2,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *TrustLineEntryExtensionV2) Reset() {
+	*x = TrustLineEntryExtensionV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineEntryExtensionV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineEntryExtensionV2) ProtoMessage() {}
+
+func (x *TrustLineEntryExtensionV2) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineEntryExtensionV2.ProtoReflect.Descriptor instead.
+func (*TrustLineEntryExtensionV2) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *TrustLineEntryExtensionV2) GetLiquidityPoolUseCount() int32 {
+	if x != nil {
+		return x.LiquidityPoolUseCount
+	}
+	return 0
+}
+
+func (x *TrustLineEntryExtensionV2) GetExt() *TrustLineEntryExtensionV2Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type TrustLineEntryExtensionV2Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V int32 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *TrustLineEntryExtensionV2Ext) Reset() {
+	*x = TrustLineEntryExtensionV2Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineEntryExtensionV2Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineEntryExtensionV2Ext) ProtoMessage() {}
+
+func (x *TrustLineEntryExtensionV2Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineEntryExtensionV2Ext.ProtoReflect.Descriptor instead.
+func (*TrustLineEntryExtensionV2Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *TrustLineEntryExtensionV2Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+type TrustLineEntryV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Liabilities *Liabilities         `protobuf:"bytes,1,opt,name=liabilities,proto3" json:"liabilities,omitempty"`
+	Ext         *TrustLineEntryV1Ext `protobuf:"bytes,2,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (x *TrustLineEntryV1) Reset() {
+	*x = TrustLineEntryV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineEntryV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineEntryV1) ProtoMessage() {}
+
+func (x *TrustLineEntryV1) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineEntryV1.ProtoReflect.Descriptor instead.
+func (*TrustLineEntryV1) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *TrustLineEntryV1) GetLiabilities() *Liabilities {
+	if x != nil {
+		return x.Liabilities
+	}
+	return nil
+}
+
+func (x *TrustLineEntryV1) GetExt() *TrustLineEntryV1Ext {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+type TrustLineEntryV1Ext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V  int32                      `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	V2 *TrustLineEntryExtensionV2 `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
+}
+
+func (x *TrustLineEntryV1Ext) Reset() {
+	*x = TrustLineEntryV1Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustLineEntryV1Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustLineEntryV1Ext) ProtoMessage() {}
+
+func (x *TrustLineEntryV1Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustLineEntryV1Ext.ProtoReflect.Descriptor instead.
+func (*TrustLineEntryV1Ext) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *TrustLineEntryV1Ext) GetV() int32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *TrustLineEntryV1Ext) GetV2() *TrustLineEntryExtensionV2 {
+	if x != nil {
+		return x.V2
+	}
+	return nil
+}
+
+type TtlEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyHash            string `protobuf:"bytes,1,opt,name=key_hash,proto3" json:"key_hash,omitempty"`
+	LiveUntilLedgerSeq uint32 `protobuf:"varint,2,opt,name=live_until_ledger_seq,proto3" json:"live_until_ledger_seq,omitempty"`
+}
+
+func (x *TtlEntry) Reset() {
+	*x = TtlEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TtlEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TtlEntry) ProtoMessage() {}
+
+func (x *TtlEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TtlEntry.ProtoReflect.Descriptor instead.
+func (*TtlEntry) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *TtlEntry) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+func (x *TtlEntry) GetLiveUntilLedgerSeq() uint32 {
+	if x != nil {
+		return x.LiveUntilLedgerSeq
+	}
+	return 0
+}
+
+type UInt128Parts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hi uint64 `protobuf:"varint,1,opt,name=hi,proto3" json:"hi,omitempty"`
+	Lo uint64 `protobuf:"varint,2,opt,name=lo,proto3" json:"lo,omitempty"`
+}
+
+func (x *UInt128Parts) Reset() {
+	*x = UInt128Parts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UInt128Parts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UInt128Parts) ProtoMessage() {}
+
+func (x *UInt128Parts) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UInt128Parts.ProtoReflect.Descriptor instead.
+func (*UInt128Parts) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *UInt128Parts) GetHi() uint64 {
+	if x != nil {
+		return x.Hi
+	}
+	return 0
+}
+
+func (x *UInt128Parts) GetLo() uint64 {
+	if x != nil {
+		return x.Lo
+	}
+	return 0
+}
+
+type UInt256Parts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hihi uint64 `protobuf:"varint,1,opt,name=hihi,proto3" json:"hihi,omitempty"`
+	Hilo uint64 `protobuf:"varint,2,opt,name=hilo,proto3" json:"hilo,omitempty"`
+	Lohi uint64 `protobuf:"varint,3,opt,name=lohi,proto3" json:"lohi,omitempty"`
+	Lolo uint64 `protobuf:"varint,4,opt,name=lolo,proto3" json:"lolo,omitempty"`
+}
+
+func (x *UInt256Parts) Reset() {
+	*x = UInt256Parts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xdrconverter_v1_types_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UInt256Parts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UInt256Parts) ProtoMessage() {}
+
+func (x *UInt256Parts) ProtoReflect() protoreflect.Message {
+	mi := &file_xdrconverter_v1_types_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UInt256Parts.ProtoReflect.Descriptor instead.
+func (*UInt256Parts) Descriptor() ([]byte, []int) {
+	return file_xdrconverter_v1_types_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *UInt256Parts) GetHihi() uint64 {
+	if x != nil {
+		return x.Hihi
+	}
+	return 0
+}
+
+func (x *UInt256Parts) GetHilo() uint64 {
+	if x != nil {
+		return x.Hilo
+	}
+	return 0
+}
+
+func (x *UInt256Parts) GetLohi() uint64 {
+	if x != nil {
+		return x.Lohi
+	}
+	return 0
+}
+
+func (x *UInt256Parts) GetLolo() uint64 {
+	if x != nil {
+		return x.Lolo
+	}
+	return 0
+}
+
+var File_xdrconverter_v1_types_proto protoreflect.FileDescriptor
+
+var file_xdrconverter_v1_types_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xcb, 0x03, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d,
+	0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73,
+	0x75, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0e,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x78, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x59, 0x0a,
+	0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74,
+	0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12, 0x38,
+	0x0a, 0x02, 0x76, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x31, 0x52, 0x02, 0x76, 0x31, 0x22, 0x98, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x31, 0x12, 0x3e, 0x0a, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x78, 0x74, 0x52, 0x03,
+	0x65, 0x78, 0x74, 0x22, 0x64, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x78,
+	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12,
+	0x38, 0x0a, 0x02, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x32, 0x52, 0x02, 0x76, 0x32, 0x22, 0xf8, 0x01, 0x0a, 0x17, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x32, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6e,
+	0x75, 0x6d, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x15,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x32, 0x45, 0x78, 0x74, 0x52,
+	0x03, 0x65, 0x78, 0x74, 0x22, 0x64, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x32, 0x45,
+	0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76,
+	0x12, 0x38, 0x0a, 0x02, 0x76, 0x33, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x33, 0x52, 0x02, 0x76, 0x33, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x33, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x71,
+	0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73,
+	0x65, 0x71, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9e, 0x01, 0x0a,
+	0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01,
+	0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4f, 0x70, 0x12, 0x34,
+	0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x07, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x05, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x70, 0x31,
+	0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x65, 0x70, 0x31, 0x31, 0x12, 0x2c,
+	0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1f,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x4f, 0x70, 0x12,
+	0x3e, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x22,
+	0x57, 0x0a, 0x23, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75,
+	0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x75, 0x6d,
+	0x70, 0x5f, 0x74, 0x6f, 0x22, 0x46, 0x0a, 0x12, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x09,
+	0x42, 0x75, 0x72, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x34, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x5c, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4f, 0x70, 0x12, 0x35, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x45, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xcc, 0x01,
+	0x0a, 0x09, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x74, 0x6f, 0x6d, 0x12, 0x31, 0x0a, 0x02, 0x76,
+	0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x41, 0x74, 0x6f, 0x6d, 0x56, 0x30, 0x52, 0x02, 0x76, 0x30, 0x12, 0x3f,
+	0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x41,
+	0x74, 0x6f, 0x6d, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x12,
+	0x4b, 0x0a, 0x0e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x41, 0x74, 0x6f, 0x6d, 0x52, 0x0e, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x5e, 0x0a, 0x17,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x1b,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xfe, 0x01,
+	0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x41, 0x74, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x22, 0xa2,
+	0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x74, 0x6f,
+	0x6d, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x6c,
+	0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75,
+	0x67, 0x68, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x41, 0x74, 0x6f, 0x6d, 0x56, 0x30, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x5f, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x73, 0x6f, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x22, 0x9f, 0x03, 0x0a, 0x0e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x6f, 0x72,
+	0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x6e,
+	0x6f, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x62, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x62, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x2f, 0x0a, 0x10, 0x61, 0x62, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x61, 0x62,
+	0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x23, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x62, 0x73, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x72, 0x65, 0x6c, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x15, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x52, 0x0a, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74,
+	0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x6b, 0x0a, 0x18, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78,
+	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12,
+	0x41, 0x0a, 0x02, 0x76, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x52, 0x02,
+	0x76, 0x31, 0x22, 0xa0, 0x01, 0x0a, 0x20, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x46, 0x0a,
+	0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x78, 0x74,
+	0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x33, 0x0a, 0x23, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x13, 0x0a, 0x02, 0x76, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02,
+	0x76, 0x30, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x6b, 0x65, 0x79, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x76, 0x30, 0x22, 0x37, 0x0a, 0x08, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x76, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x56, 0x30, 0x52, 0x02, 0x76, 0x30, 0x22,
+	0x89, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x56, 0x30, 0x12, 0x3c,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x09,
+	0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x61, 0x0a, 0x1a, 0x43,
+	0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52,
+	0x0a, 0x1e, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x34, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b,
+	0x4f, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x31, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x0e, 0x43,
+	0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xb8, 0x01, 0x0a, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x56, 0x30, 0x12, 0x3c, 0x0a, 0x19, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74,
+	0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x31, 0x6b, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x74,
+	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x31, 0x6b, 0x62, 0x22, 0x88, 0x02, 0x0a, 0x1e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x30, 0x12, 0x38, 0x0a,
+	0x17, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x78, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x23, 0x66, 0x65, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x23, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x56, 0x30, 0x12, 0x4c, 0x0a, 0x21, 0x74, 0x78, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x21, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x31, 0x6b, 0x62,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x31, 0x6b, 0x62, 0x22,
+	0x59, 0x0a, 0x25, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x61, 0x6e, 0x65, 0x73, 0x56, 0x30, 0x12, 0x30, 0x0a, 0x13, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x25, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x56, 0x30, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x31, 0x6b, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x66, 0x65, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f,
+	0x31, 0x6b, 0x62, 0x22, 0xbb, 0x07, 0x0a, 0x21, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x43, 0x6f, 0x73, 0x74, 0x56, 0x30, 0x12, 0x46, 0x0a, 0x1e, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x1e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x15, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x1f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x36, 0x0a, 0x16, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x16, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x1a, 0x74, 0x78, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x74,
+	0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x78, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x1b, 0x74, 0x78, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x74, 0x78,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x78, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x66, 0x65, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x36, 0x0a, 0x16, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x31, 0x6b, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x31, 0x6b, 0x62, 0x12, 0x44, 0x0a, 0x1d, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x1d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x1d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x31,
+	0x6b, 0x62, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c,
+	0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x31, 0x6b, 0x62, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x77, 0x12, 0x46, 0x0a, 0x1e, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x31, 0x6b, 0x62, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x1e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x31, 0x6b, 0x62, 0x5f, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x12,
+	0x50, 0x0a, 0x23, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x23, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x22, 0xe2, 0x0b, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d,
+	0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x17, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x56, 0x30, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x14, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x73, 0x74, 0x56, 0x30, 0x52, 0x14, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x12, 0x72, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x56, 0x30, 0x52, 0x18, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x56, 0x30, 0x52,
+	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x5f, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x62, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x56, 0x30, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x74,
+	0x68, 0x12, 0x6f, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x69, 0x6e,
+	0x73, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x69, 0x6e, 0x73,
+	0x6e, 0x73, 0x12, 0x6f, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x1c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x1c, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x1e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x17, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x17, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x6e, 0x0a, 0x16, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f,
+	0x6c, 0x61, 0x6e, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x6e, 0x65,
+	0x73, 0x56, 0x30, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x04, 0x52, 0x17, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4f, 0x0a, 0x11, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x11, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0xbb, 0x03, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x03, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6e, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x5f,
+	0x65, 0x6c, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x03, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65,
+	0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45,
+	0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76,
+	0x12, 0x34, 0x0a, 0x02, 0x76, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x56, 0x31, 0x52, 0x02, 0x76, 0x31, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x31, 0x12, 0x31,
+	0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78,
+	0x74, 0x12, 0x49, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52,
+	0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a,
+	0x16, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x22, 0x9c, 0x02, 0x0a,
+	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x56,
+	0x61, 0x6c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x56, 0x61, 0x6c, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0xe1, 0x03, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6d, 0x69, 0x6e,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x77, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x77, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x2e, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x72, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x45, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x77, 0x61, 0x73, 0x6d,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x73,
+	0x6d, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x49, 0x64, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x69, 0x0a, 0x1d, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x61, 0x6c, 0x74, 0x22, 0x7b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a,
+	0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x52,
+	0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x1c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43,
+	0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x57, 0x0a, 0x14, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x49, 0x64, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x14, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x6c,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x75, 0x79,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0xb6, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x1c, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6f, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x89,
+	0x01, 0x0a, 0x0f, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x21, 0x45, 0x6e,
+	0x64, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x5f,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x12,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x67, 0x0a, 0x14,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x54,
+	0x74, 0x6c, 0x4f, 0x70, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x46,
+	0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x54, 0x74, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x76, 0x22, 0xe7, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x66,
+	0x65, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x78, 0x52, 0x08, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x5f, 0x74, 0x78, 0x12, 0x38, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x96, 0x01,
+	0x0a, 0x1a, 0x46, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75,
+	0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74,
+	0x78, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x15, 0x46, 0x65, 0x65, 0x42, 0x75, 0x6d,
+	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x12,
+	0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x53, 0x0a,
+	0x19, 0x46, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x78, 0x12, 0x36, 0x0a, 0x02, 0x76, 0x31,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x02,
+	0x76, 0x31, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x48, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x12, 0x17, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x77,
+	0x61, 0x73, 0x6d, 0x22, 0x67, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x0f,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x22, 0xbf, 0x01,
+	0x0a, 0x16, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66,
+	0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x6e,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3c, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22,
+	0x29, 0x0a, 0x19, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x49,
+	0x6e, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x1c, 0x49, 0x6e, 0x6e, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x50,
+	0x61, 0x72, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x68, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x6c, 0x6f, 0x22, 0x5d, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x32, 0x35, 0x36, 0x50, 0x61,
+	0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x68, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x68, 0x69, 0x68, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6c, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x69, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x6f, 0x68, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c, 0x6f, 0x68, 0x69, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x6c, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c,
+	0x6f, 0x6c, 0x6f, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x56, 0x61, 0x6c, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x48,
+	0x6f, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x12, 0x43, 0x0a,
+	0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x22, 0x77, 0x0a, 0x18, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x48, 0x6f, 0x73, 0x74,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x0c, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x0b,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x18, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a,
+	0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x22, 0xa7, 0x02, 0x0a, 0x11, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x9f, 0x05, 0x0a, 0x0f, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x37,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x54, 0x0a, 0x11, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x48,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x74, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x57, 0x0a, 0x0e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x12, 0x0c,
+	0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12, 0x37, 0x0a, 0x02,
+	0x76, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x31, 0x52, 0x02, 0x76, 0x31, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31,
+	0x12, 0x40, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x52, 0x0d, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x12, 0x3c, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x22, 0x29, 0x0a, 0x19, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a,
+	0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x87, 0x01, 0x0a, 0x0f,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0xc1, 0x05, 0x0a, 0x09, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x43, 0x0a, 0x0a, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x58, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0e, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x4c, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x54, 0x74, 0x6c, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x4e, 0x0a, 0x10, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x19, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x52,
+	0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x16, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x15, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x74, 0x6c, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x74, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0xe7, 0x01, 0x0a,
+	0x15, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x56, 0x61, 0x6c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x74, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x74, 0x6c, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x69, 0x0a, 0x0d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x46, 0x0a, 0x16, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x0e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x73,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x2a, 0x0a, 0x0c, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x54, 0x74, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x9e, 0x01, 0x0a, 0x26, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x07, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x61, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x4f, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x12, 0x34, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x4e, 0x0a, 0x1a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x12, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x11,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x78, 0x0a, 0x16, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x5e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x22, 0xa2, 0x02, 0x0a, 0x21, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x4f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x62, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x12, 0x42, 0x0a, 0x1c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x63, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x6b, 0x65, 0x79, 0x22, 0xa7, 0x01, 0x0a, 0x17,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x61, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x62, 0x22, 0x4f, 0x0a, 0x1b, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x73,
+	0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a,
+	0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x75, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4c, 0x0a, 0x0c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x18,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x74, 0x6f, 0x6d, 0x52, 0x0e, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x05,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x1d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31,
+	0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x22, 0xdf, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6c, 0x6c,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x75, 0x79,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x79,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x75, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x43, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x17, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x01, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x72, 0x65, 0x74, 0x68, 0x61, 0x73, 0x68,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x72, 0x65, 0x74, 0x68, 0x61, 0x73, 0x68, 0x22, 0x67, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x34, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x31, 0x32, 0x38, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x08,
+	0x6d, 0x75, 0x78, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x08, 0x6d, 0x75, 0x78, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x6d, 0x75, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x5f, 0x6d, 0x75, 0x78, 0x65, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x75,
+	0x78, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x22, 0xf2, 0x02, 0x0a, 0x0a, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x73,
+	0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a,
+	0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6c, 0x61,
+	0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x78, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x1d, 0x0a, 0x0d, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x32, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x93, 0x12, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x52, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x12, 0x73, 0x0a, 0x1e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x52, 0x1e, 0x70, 0x61, 0x74, 0x68,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x12, 0x56, 0x0a, 0x14, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x14, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x6f, 0x70, 0x12, 0x6d, 0x0a, 0x1c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x4f, 0x70, 0x52, 0x1c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6f,
+	0x70, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x6f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x70, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x70, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4f,
+	0x70, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x6f, 0x70, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x6f, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4f, 0x70, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x12, 0x43, 0x0a, 0x0d, 0x6d, 0x75, 0x78,
+	0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0d, 0x6d, 0x75, 0x78, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6f, 0x70,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x4f, 0x70, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x6f, 0x70, 0x12, 0x4b, 0x0a, 0x10, 0x62, 0x75, 0x6d, 0x70, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x70,
+	0x52, 0x10, 0x62, 0x75, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6f, 0x70, 0x12, 0x53, 0x0a, 0x13, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x75, 0x79,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x4f, 0x70, 0x52, 0x13, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x12, 0x6a, 0x0a, 0x1b, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x52, 0x1b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x6f, 0x70, 0x12, 0x6b, 0x0a, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6f, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x4f, 0x70, 0x52, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70,
+	0x12, 0x68, 0x0a, 0x1a, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x52, 0x1a,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x23, 0x62,
+	0x65, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f,
+	0x6f, 0x70, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x4f, 0x70, 0x52, 0x23, 0x62, 0x65, 0x67, 0x69,
+	0x6e, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f, 0x6f, 0x70, 0x12,
+	0x5a, 0x0a, 0x15, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x6f, 0x70, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x4f, 0x70, 0x52, 0x15, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x6f, 0x70, 0x12, 0x3d, 0x0a, 0x0b, 0x63,
+	0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x52, 0x0b, 0x63,
+	0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x70, 0x12, 0x71, 0x0a, 0x1d, 0x63, 0x6c,
+	0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x52, 0x1d,
+	0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x12, 0x5e, 0x0a,
+	0x17, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x5f, 0x6f, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x4f, 0x70, 0x52, 0x17, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x5f, 0x6f, 0x70, 0x12, 0x65, 0x0a,
+	0x19, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6f, 0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4f, 0x70, 0x52, 0x19, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x6f, 0x70, 0x12, 0x68, 0x0a, 0x1a, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x6f, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x4f, 0x70, 0x52, 0x1a, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x70, 0x12, 0x5f,
+	0x0a, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x52, 0x17, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x12,
+	0x5f, 0x0a, 0x17, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x6f, 0x70, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x54, 0x74, 0x6c, 0x4f, 0x70, 0x52, 0x17, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f,
+	0x66, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x6f, 0x70,
+	0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6f, 0x6f, 0x74,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x4f, 0x70, 0x52, 0x14, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6f, 0x6f,
+	0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x22, 0x4d, 0x0a, 0x0d, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x32, 0x12, 0x31, 0x0a, 0x03,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12,
+	0x3c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x36, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x02, 0x74, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x72, 0x52, 0x02, 0x74, 0x72, 0x22, 0x93,
+	0x16, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x54, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x5a, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x15, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x7f, 0x0a, 0x22,
+	0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x22, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x62, 0x0a,
+	0x18, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x18, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x72, 0x0a, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x12, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x54, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51,
+	0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x12, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x57, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x10, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x12, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x62,
+	0x75, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x62, 0x75, 0x6d, 0x70, 0x5f,
+	0x73, 0x65, 0x71, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5f, 0x0a, 0x17, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x42, 0x75, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x17, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x76, 0x0a, 0x1f, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x1f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x77, 0x0a, 0x1f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x1f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x74, 0x0a, 0x1e,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x1e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x8e, 0x01, 0x0a, 0x27, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x70, 0x6f, 0x6e,
+	0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x27, 0x62, 0x65, 0x67, 0x69,
+	0x6e, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x25, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x25, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x66,
+	0x0a, 0x19, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x19, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x0f, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x7d, 0x0a, 0x21, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x21, 0x63,
+	0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x6a, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x4c, 0x69, 0x6e, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x1b, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x71, 0x0a, 0x1d,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x1d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x74, 0x0a, 0x1e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x1e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6b, 0x0a, 0x1b, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x1b, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x6b, 0x0a, 0x1b, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x6f, 0x6f,
+	0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x54, 0x74, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x1b, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x63, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x18, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xb7, 0x02, 0x0a, 0x1a, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x4f, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xda,
+	0x01, 0x0a, 0x1e, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x09, 0x6e, 0x6f, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x25,
+	0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x74, 0x6f,
+	0x6d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x6c, 0x61, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x22, 0xb4, 0x02, 0x0a, 0x17, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x12,
+	0x36, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x12, 0x2a,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xd4, 0x01, 0x0a, 0x1b, 0x50,
+	0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6e,
+	0x6f, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6e, 0x6f, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x22, 0x92, 0x01, 0x0a, 0x22, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x41, 0x74, 0x6f, 0x6d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4f, 0x70, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x78, 0x65,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x80,
+	0x01, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x30, 0x0a, 0x02, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x32, 0x52, 0x02, 0x76,
+	0x32, 0x22, 0xe0, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x56, 0x32, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x0d, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x67,
+	0x61, 0x70, 0x12, 0x40, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71,
+	0x5f, 0x6e, 0x75, 0x6d, 0x22, 0x23, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x64, 0x22, 0x47, 0x0a, 0x12, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x12,
+	0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65,
+	0x78, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x6f,
+	0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x95,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x6b,
+	0x65, 0x79, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x4b, 0x0a, 0x17,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x09, 0x53, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x35, 0x0a,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x07, 0x53, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x60, 0x0a, 0x0a, 0x53, 0x63, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x28, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x56, 0x61, 0x6c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x03, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x56, 0x61, 0x6c,
+	0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x22, 0x0a, 0x0a, 0x53, 0x63, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xe3, 0x06, 0x0a, 0x05, 0x53, 0x63,
+	0x56, 0x61, 0x6c, 0x12, 0x11, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x01, 0x62, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x03, 0x75, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x69, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x03, 0x69, 0x33,
+	0x32, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x03, 0x52, 0x03, 0x75, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x69,
+	0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x03, 0x69, 0x36, 0x34, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x06, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x31, 0x32, 0x38, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x50, 0x61,
+	0x72, 0x74, 0x73, 0x52, 0x04, 0x75, 0x31, 0x32, 0x38, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x31, 0x32,
+	0x38, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x31, 0x32, 0x38,
+	0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x04, 0x69, 0x31, 0x32, 0x38, 0x12, 0x31, 0x0a, 0x04, 0x75,
+	0x32, 0x35, 0x36, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x32, 0x35, 0x36, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x04, 0x75, 0x32, 0x35, 0x36, 0x12, 0x30,
+	0x0a, 0x04, 0x69, 0x32, 0x35, 0x36, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x32, 0x35, 0x36, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x04, 0x69, 0x32, 0x35, 0x36,
+	0x12, 0x19, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x07, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73,
+	0x74, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x03, 0x73, 0x74, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x79, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x03, 0x76, 0x65, 0x63,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x56, 0x61, 0x6c, 0x52, 0x03,
+	0x76, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d,
+	0x61, 0x70, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75,
+	0x33, 0x32, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x33, 0x32, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75,
+	0x36, 0x34, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x36, 0x34, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x73, 0x74, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x79, 0x6d, 0x22,
+	0xed, 0x04, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x70,
+	0x12, 0x42, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x10, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x73, 0x65,
+	0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65,
+	0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6d, 0x65, 0x64, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x04, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x0e, 0x68,
+	0x69, 0x67, 0x68, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x77,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d,
+	0x65, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22,
+	0x44, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x12, 0x34, 0x0a,
+	0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x25, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x19, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x40, 0x0a, 0x1b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x56, 0x61, 0x6c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x19, 0x53, 0x6f, 0x72, 0x6f,
+	0x62, 0x61, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72,
+	0x6f, 0x62, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0f,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x19, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x6e, 0x12, 0x5d, 0x0a, 0x17, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x66, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x52,
+	0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x1b, 0x53, 0x6f, 0x72,
+	0x6f, 0x62, 0x61, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x6e,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72,
+	0x6f, 0x62, 0x61, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x56, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f,
+	0x62, 0x61, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x6e, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x6e, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x12, 0x53, 0x6f, 0x72, 0x6f,
+	0x62, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x44,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x10, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x66, 0x6f, 0x6f,
+	0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x09,
+	0x66, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0xb0, 0x01, 0x0a, 0x16, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x3f, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66,
+	0x65, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x16, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3c, 0x0a,
+	0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72,
+	0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x56, 0x61,
+	0x6c, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x4e, 0x0a, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x67, 0x0a, 0x19, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12, 0x3c, 0x0a, 0x02, 0x76, 0x31,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x45,
+	0x78, 0x74, 0x56, 0x31, 0x52, 0x02, 0x76, 0x31, 0x22, 0xb0, 0x02, 0x0a, 0x1b, 0x53, 0x6f, 0x72,
+	0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x45, 0x78, 0x74, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x5c, 0x0a, 0x29, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x29,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x25, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x25, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x18,
+	0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x32, 0x12, 0x3c, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x78,
+	0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x56, 0x61, 0x6c, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xf1, 0x04, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74,
+	0x74, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x74, 0x6c,
+	0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x74, 0x6c,
+	0x12, 0x4a, 0x0a, 0x20, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x20, 0x70, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x1a,
+	0x74, 0x65, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x1a, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x16,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x12, 0x50, 0x0a, 0x23, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x23, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x4a, 0x0a, 0x20, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x20, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x42, 0x0a, 0x1c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65,
+	0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6e,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x44, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xce, 0x02, 0x0a,
+	0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x78, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x12,
+	0x32, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x63,
+	0x6f, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x3a,
+	0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0xcc, 0x01,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x02, 0x76, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x30, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x02, 0x76, 0x30, 0x12, 0x36, 0x0a,
+	0x02, 0x76, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x52, 0x02, 0x76, 0x31, 0x12, 0x45, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x62, 0x75, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x62, 0x75, 0x6d, 0x70, 0x22, 0x7e, 0x0a, 0x10,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x0e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x12, 0x0c,
+	0x0a, 0x01, 0x56, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x56, 0x12, 0x4b, 0x0a, 0x0c,
+	0x73, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x6f, 0x72,
+	0x6f, 0x62, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x02, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x0c, 0x0a,
+	0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12, 0x3e, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x02, 0x76,
+	0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x31, 0x52, 0x02, 0x76, 0x31, 0x12,
+	0x32, 0x0a, 0x02, 0x76, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x32, 0x52,
+	0x02, 0x76, 0x32, 0x12, 0x32, 0x0a, 0x02, 0x76, 0x33, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x56, 0x33, 0x52, 0x02, 0x76, 0x33, 0x12, 0x32, 0x0a, 0x02, 0x76, 0x34, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x34, 0x52, 0x02, 0x76, 0x34, 0x22, 0x97, 0x01, 0x0a, 0x11,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56,
+	0x31, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x32, 0x12, 0x50, 0x0a, 0x11, 0x74,
+	0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x74, 0x78, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x3e, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a,
+	0x10, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x10, 0x74, 0x78, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xf5, 0x02,
+	0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x56, 0x33, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x50, 0x0a, 0x11, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x78, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x10, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0c, 0x73, 0x6f, 0x72, 0x6f,
+	0x62, 0x61, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x84, 0x04, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x56, 0x34, 0x12, 0x31, 0x0a, 0x03, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x50,
+	0x0a, 0x11, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x74,
+	0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x56, 0x32, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x10, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x6f, 0x62,
+	0x61, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x56, 0x32, 0x52, 0x0c, 0x73, 0x6f, 0x72, 0x6f, 0x62, 0x61, 0x6e, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x11,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a,
+	0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22,
+	0x24, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0xdf, 0x02, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x3e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x4a, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x13, 0x74,
+	0x78, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x13, 0x74, 0x78, 0x5f, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12,
+	0x66, 0x0a, 0x1c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x1c, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x74, 0x78, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x7f, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x2a, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x17, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x11, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x69, 0x72, 0x52, 0x11,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x61, 0x69,
+	0x72, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xce, 0x02,
+	0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x12,
+	0x36, 0x0a, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x71,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x71, 0x5f,
+	0x6e, 0x75, 0x6d, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x3a, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x65, 0x78, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x8c,
+	0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x30, 0x52, 0x02, 0x74, 0x78, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x20, 0x0a,
+	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x30, 0x45, 0x78,
+	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22,
+	0x8a, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x31, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x78, 0x64,
+	0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x0d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x78, 0x64, 0x72, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x12, 0x35, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x03, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x64, 0x72,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x52, 0x03,
+	0x65, 0x78, 0x74, 0x22, 0x54, 0x0a, 0x11, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12, 0x31, 0x0a, 0x02, 0x76, 0x31, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x56, 0x31, 0x52, 0x02, 0x76, 0x31, 0x22, 0x98, 0x01, 0x0a, 0x19, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x32, 0x12, 0x3a, 0x0a, 0x18, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x32, 0x45, 0x78, 0x74, 0x52,
+	0x03, 0x65, 0x78, 0x74, 0x22, 0x2c, 0x0a, 0x1c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x32, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x76, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x31, 0x12, 0x3e, 0x0a, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78,
+	0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x6c, 0x69, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x31, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22,
+	0x5f, 0x0a, 0x13, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x56, 0x31, 0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x76, 0x12, 0x3a, 0x0a, 0x02, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x78, 0x64, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x32, 0x52, 0x02, 0x76, 0x32,
+	0x22, 0x5c, 0x0a, 0x08, 0x54, 0x74, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x69, 0x76, 0x65,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x22, 0x2e,
+	0x0a, 0x0c, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x68, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x68, 0x69, 0x12, 0x0e,
+	0x0a, 0x02, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x6c, 0x6f, 0x22, 0x5e,
+	0x0a, 0x0c, 0x55, 0x49, 0x6e, 0x74, 0x32, 0x35, 0x36, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x69, 0x68, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x69,
+	0x68, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x68, 0x69, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x68, 0x69, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c, 0x6f, 0x68, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f,
+	0x6c, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c, 0x6f, 0x6c, 0x6f, 0x42, 0x2a,
+	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x6f, 0x2f, 0x78, 0x64, 0x72, 0x2d, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_xdrconverter_v1_types_proto_rawDescOnce sync.Once
+	file_xdrconverter_v1_types_proto_rawDescData = file_xdrconverter_v1_types_proto_rawDesc
+)
+
+func file_xdrconverter_v1_types_proto_rawDescGZIP() []byte {
+	file_xdrconverter_v1_types_proto_rawDescOnce.Do(func() {
+		file_xdrconverter_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_xdrconverter_v1_types_proto_rawDescData)
+	})
+	return file_xdrconverter_v1_types_proto_rawDescData
+}
+
+var file_xdrconverter_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 206)
+var file_xdrconverter_v1_types_proto_goTypes = []any{
+	(*AccountEntry)(nil),                           // 0: xdrconverter.v1.AccountEntry
+	(*AccountEntryExt)(nil),                        // 1: xdrconverter.v1.AccountEntryExt
+	(*AccountEntryExtensionV1)(nil),                // 2: xdrconverter.v1.AccountEntryExtensionV1
+	(*AccountEntryExtensionV1Ext)(nil),             // 3: xdrconverter.v1.AccountEntryExtensionV1Ext
+	(*AccountEntryExtensionV2)(nil),                // 4: xdrconverter.v1.AccountEntryExtensionV2
+	(*AccountEntryExtensionV2Ext)(nil),             // 5: xdrconverter.v1.AccountEntryExtensionV2Ext
+	(*AccountEntryExtensionV3)(nil),                // 6: xdrconverter.v1.AccountEntryExtensionV3
+	(*AccountId)(nil),                              // 7: xdrconverter.v1.AccountId
+	(*AccountMergeResult)(nil),                     // 8: xdrconverter.v1.AccountMergeResult
+	(*AllowTrustOp)(nil),                           // 9: xdrconverter.v1.AllowTrustOp
+	(*AllowTrustResult)(nil),                       // 10: xdrconverter.v1.AllowTrustResult
+	(*Asset)(nil),                                  // 11: xdrconverter.v1.Asset
+	(*BeginSponsoringFutureReservesOp)(nil),        // 12: xdrconverter.v1.BeginSponsoringFutureReservesOp
+	(*BeginSponsoringFutureReservesResult)(nil),    // 13: xdrconverter.v1.BeginSponsoringFutureReservesResult
+	(*BumpSequenceOp)(nil),                         // 14: xdrconverter.v1.BumpSequenceOp
+	(*BumpSequenceResult)(nil),                     // 15: xdrconverter.v1.BumpSequenceResult
+	(*BurnEvent)(nil),                              // 16: xdrconverter.v1.BurnEvent
+	(*ChangeTrustAsset)(nil),                       // 17: xdrconverter.v1.ChangeTrustAsset
+	(*ChangeTrustOp)(nil),                          // 18: xdrconverter.v1.ChangeTrustOp
+	(*ChangeTrustResult)(nil),                      // 19: xdrconverter.v1.ChangeTrustResult
+	(*ClaimAtom)(nil),                              // 20: xdrconverter.v1.ClaimAtom
+	(*ClaimClaimableBalanceOp)(nil),                // 21: xdrconverter.v1.ClaimClaimableBalanceOp
+	(*ClaimClaimableBalanceResult)(nil),            // 22: xdrconverter.v1.ClaimClaimableBalanceResult
+	(*ClaimLiquidityAtom)(nil),                     // 23: xdrconverter.v1.ClaimLiquidityAtom
+	(*ClaimOfferAtom)(nil),                         // 24: xdrconverter.v1.ClaimOfferAtom
+	(*ClaimOfferAtomV0)(nil),                       // 25: xdrconverter.v1.ClaimOfferAtomV0
+	(*ClaimPredicate)(nil),                         // 26: xdrconverter.v1.ClaimPredicate
+	(*ClaimableBalanceEntry)(nil),                  // 27: xdrconverter.v1.ClaimableBalanceEntry
+	(*ClaimableBalanceEntryExt)(nil),               // 28: xdrconverter.v1.ClaimableBalanceEntryExt
+	(*ClaimableBalanceEntryExtensionV1)(nil),       // 29: xdrconverter.v1.ClaimableBalanceEntryExtensionV1
+	(*ClaimableBalanceEntryExtensionV1Ext)(nil),    // 30: xdrconverter.v1.ClaimableBalanceEntryExtensionV1Ext
+	(*ClaimableBalanceId)(nil),                     // 31: xdrconverter.v1.ClaimableBalanceId
+	(*Claimant)(nil),                               // 32: xdrconverter.v1.Claimant
+	(*ClaimantV0)(nil),                             // 33: xdrconverter.v1.ClaimantV0
+	(*ClawbackClaimableBalanceOp)(nil),             // 34: xdrconverter.v1.ClawbackClaimableBalanceOp
+	(*ClawbackClaimableBalanceResult)(nil),         // 35: xdrconverter.v1.ClawbackClaimableBalanceResult
+	(*ClawbackEvent)(nil),                          // 36: xdrconverter.v1.ClawbackEvent
+	(*ClawbackOp)(nil),                             // 37: xdrconverter.v1.ClawbackOp
+	(*ClawbackResult)(nil),                         // 38: xdrconverter.v1.ClawbackResult
+	(*ConfigSettingContractBandwidthV0)(nil),       // 39: xdrconverter.v1.ConfigSettingContractBandwidthV0
+	(*ConfigSettingContractComputeV0)(nil),         // 40: xdrconverter.v1.ConfigSettingContractComputeV0
+	(*ConfigSettingContractEventsV0)(nil),          // 41: xdrconverter.v1.ConfigSettingContractEventsV0
+	(*ConfigSettingContractExecutionLanesV0)(nil),  // 42: xdrconverter.v1.ConfigSettingContractExecutionLanesV0
+	(*ConfigSettingContractHistoricalDataV0)(nil),  // 43: xdrconverter.v1.ConfigSettingContractHistoricalDataV0
+	(*ConfigSettingContractLedgerCostV0)(nil),      // 44: xdrconverter.v1.ConfigSettingContractLedgerCostV0
+	(*ConfigSettingEntry)(nil),                     // 45: xdrconverter.v1.ConfigSettingEntry
+	(*ContractCodeCostInputs)(nil),                 // 46: xdrconverter.v1.ContractCodeCostInputs
+	(*ContractCodeEntry)(nil),                      // 47: xdrconverter.v1.ContractCodeEntry
+	(*ContractCodeEntryExt)(nil),                   // 48: xdrconverter.v1.ContractCodeEntryExt
+	(*ContractCodeEntryV1)(nil),                    // 49: xdrconverter.v1.ContractCodeEntryV1
+	(*ContractCostParamEntry)(nil),                 // 50: xdrconverter.v1.ContractCostParamEntry
+	(*ContractDataEntry)(nil),                      // 51: xdrconverter.v1.ContractDataEntry
+	(*ContractEvent)(nil),                          // 52: xdrconverter.v1.ContractEvent
+	(*ContractExecutable)(nil),                     // 53: xdrconverter.v1.ContractExecutable
+	(*ContractIdPreimage)(nil),                     // 54: xdrconverter.v1.ContractIdPreimage
+	(*ContractIdPreimageFromAddress)(nil),          // 55: xdrconverter.v1.ContractIdPreimageFromAddress
+	(*CreateAccountOp)(nil),                        // 56: xdrconverter.v1.CreateAccountOp
+	(*CreateAccountResult)(nil),                    // 57: xdrconverter.v1.CreateAccountResult
+	(*CreateClaimableBalanceOp)(nil),               // 58: xdrconverter.v1.CreateClaimableBalanceOp
+	(*CreateClaimableBalanceResult)(nil),           // 59: xdrconverter.v1.CreateClaimableBalanceResult
+	(*CreateContractArgs)(nil),                     // 60: xdrconverter.v1.CreateContractArgs
+	(*CreatePassiveSellOfferOp)(nil),               // 61: xdrconverter.v1.CreatePassiveSellOfferOp
+	(*DataEntry)(nil),                              // 62: xdrconverter.v1.DataEntry
+	(*DataEntryExt)(nil),                           // 63: xdrconverter.v1.DataEntryExt
+	(*DecoratedSignature)(nil),                     // 64: xdrconverter.v1.DecoratedSignature
+	(*DiagnosticEvent)(nil),                        // 65: xdrconverter.v1.DiagnosticEvent
+	(*EndSponsoringFutureReservesResult)(nil),      // 66: xdrconverter.v1.EndSponsoringFutureReservesResult
+	(*EvictionIterator)(nil),                       // 67: xdrconverter.v1.EvictionIterator
+	(*ExtendFootprintTtlOp)(nil),                   // 68: xdrconverter.v1.ExtendFootprintTtlOp
+	(*ExtendFootprintTtlResult)(nil),               // 69: xdrconverter.v1.ExtendFootprintTtlResult
+	(*ExtensionPoint)(nil),                         // 70: xdrconverter.v1.ExtensionPoint
+	(*FeeBumpTransaction)(nil),                     // 71: xdrconverter.v1.FeeBumpTransaction
+	(*FeeBumpTransactionEnvelope)(nil),             // 72: xdrconverter.v1.FeeBumpTransactionEnvelope
+	(*FeeBumpTransactionExt)(nil),                  // 73: xdrconverter.v1.FeeBumpTransactionExt
+	(*FeeBumpTransactionInnerTx)(nil),              // 74: xdrconverter.v1.FeeBumpTransactionInnerTx
+	(*HostFunction)(nil),                           // 75: xdrconverter.v1.HostFunction
+	(*InflationPayout)(nil),                        // 76: xdrconverter.v1.InflationPayout
+	(*InflationResult)(nil),                        // 77: xdrconverter.v1.InflationResult
+	(*InnerTransactionResult)(nil),                 // 78: xdrconverter.v1.InnerTransactionResult
+	(*InnerTransactionResultExt)(nil),              // 79: xdrconverter.v1.InnerTransactionResultExt
+	(*InnerTransactionResultPair)(nil),             // 80: xdrconverter.v1.InnerTransactionResultPair
+	(*InnerTransactionResultResult)(nil),           // 81: xdrconverter.v1.InnerTransactionResultResult
+	(*Int128Parts)(nil),                            // 82: xdrconverter.v1.Int128Parts
+	(*Int256Parts)(nil),                            // 83: xdrconverter.v1.Int256Parts
+	(*InvokeContractArgs)(nil),                     // 84: xdrconverter.v1.InvokeContractArgs
+	(*InvokeHostFunctionOp)(nil),                   // 85: xdrconverter.v1.InvokeHostFunctionOp
+	(*InvokeHostFunctionResult)(nil),               // 86: xdrconverter.v1.InvokeHostFunctionResult
+	(*LedgerBounds)(nil),                           // 87: xdrconverter.v1.LedgerBounds
+	(*LedgerEntry)(nil),                            // 88: xdrconverter.v1.LedgerEntry
+	(*LedgerEntryChange)(nil),                      // 89: xdrconverter.v1.LedgerEntryChange
+	(*LedgerEntryData)(nil),                        // 90: xdrconverter.v1.LedgerEntryData
+	(*LedgerEntryExt)(nil),                         // 91: xdrconverter.v1.LedgerEntryExt
+	(*LedgerEntryExtensionV1)(nil),                 // 92: xdrconverter.v1.LedgerEntryExtensionV1
+	(*LedgerEntryExtensionV1Ext)(nil),              // 93: xdrconverter.v1.LedgerEntryExtensionV1Ext
+	(*LedgerFootprint)(nil),                        // 94: xdrconverter.v1.LedgerFootprint
+	(*LedgerKey)(nil),                              // 95: xdrconverter.v1.LedgerKey
+	(*LedgerKeyAccount)(nil),                       // 96: xdrconverter.v1.LedgerKeyAccount
+	(*LedgerKeyClaimableBalance)(nil),              // 97: xdrconverter.v1.LedgerKeyClaimableBalance
+	(*LedgerKeyConfigSetting)(nil),                 // 98: xdrconverter.v1.LedgerKeyConfigSetting
+	(*LedgerKeyContractCode)(nil),                  // 99: xdrconverter.v1.LedgerKeyContractCode
+	(*LedgerKeyContractData)(nil),                  // 100: xdrconverter.v1.LedgerKeyContractData
+	(*LedgerKeyData)(nil),                          // 101: xdrconverter.v1.LedgerKeyData
+	(*LedgerKeyLiquidityPool)(nil),                 // 102: xdrconverter.v1.LedgerKeyLiquidityPool
+	(*LedgerKeyOffer)(nil),                         // 103: xdrconverter.v1.LedgerKeyOffer
+	(*LedgerKeyTrustLine)(nil),                     // 104: xdrconverter.v1.LedgerKeyTrustLine
+	(*LedgerKeyTtl)(nil),                           // 105: xdrconverter.v1.LedgerKeyTtl
+	(*Liabilities)(nil),                            // 106: xdrconverter.v1.Liabilities
+	(*LiquidityPoolConstantProductParameters)(nil), // 107: xdrconverter.v1.LiquidityPoolConstantProductParameters
+	(*LiquidityPoolDepositOp)(nil),                 // 108: xdrconverter.v1.LiquidityPoolDepositOp
+	(*LiquidityPoolDepositResult)(nil),             // 109: xdrconverter.v1.LiquidityPoolDepositResult
+	(*LiquidityPoolEntry)(nil),                     // 110: xdrconverter.v1.LiquidityPoolEntry
+	(*LiquidityPoolEntryBody)(nil),                 // 111: xdrconverter.v1.LiquidityPoolEntryBody
+	(*LiquidityPoolEntryConstantProduct)(nil),      // 112: xdrconverter.v1.LiquidityPoolEntryConstantProduct
+	(*LiquidityPoolParameters)(nil),                // 113: xdrconverter.v1.LiquidityPoolParameters
+	(*LiquidityPoolWithdrawOp)(nil),                // 114: xdrconverter.v1.LiquidityPoolWithdrawOp
+	(*LiquidityPoolWithdrawResult)(nil),            // 115: xdrconverter.v1.LiquidityPoolWithdrawResult
+	(*ManageBuyOfferOp)(nil),                       // 116: xdrconverter.v1.ManageBuyOfferOp
+	(*ManageBuyOfferResult)(nil),                   // 117: xdrconverter.v1.ManageBuyOfferResult
+	(*ManageDataOp)(nil),                           // 118: xdrconverter.v1.ManageDataOp
+	(*ManageDataResult)(nil),                       // 119: xdrconverter.v1.ManageDataResult
+	(*ManageOfferSuccessResult)(nil),               // 120: xdrconverter.v1.ManageOfferSuccessResult
+	(*ManageOfferSuccessResultOffer)(nil),          // 121: xdrconverter.v1.ManageOfferSuccessResultOffer
+	(*ManageSellOfferOp)(nil),                      // 122: xdrconverter.v1.ManageSellOfferOp
+	(*ManageSellOfferResult)(nil),                  // 123: xdrconverter.v1.ManageSellOfferResult
+	(*Memo)(nil),                                   // 124: xdrconverter.v1.Memo
+	(*MintEvent)(nil),                              // 125: xdrconverter.v1.MintEvent
+	(*MuxedAccount)(nil),                           // 126: xdrconverter.v1.MuxedAccount
+	(*OfferEntry)(nil),                             // 127: xdrconverter.v1.OfferEntry
+	(*OfferEntryExt)(nil),                          // 128: xdrconverter.v1.OfferEntryExt
+	(*Operation)(nil),                              // 129: xdrconverter.v1.Operation
+	(*OperationBody)(nil),                          // 130: xdrconverter.v1.OperationBody
+	(*OperationMeta)(nil),                          // 131: xdrconverter.v1.OperationMeta
+	(*OperationMetaV2)(nil),                        // 132: xdrconverter.v1.OperationMetaV2
+	(*OperationResult)(nil),                        // 133: xdrconverter.v1.OperationResult
+	(*OperationResultTr)(nil),                      // 134: xdrconverter.v1.OperationResultTr
+	(*PathPaymentStrictReceiveOp)(nil),             // 135: xdrconverter.v1.PathPaymentStrictReceiveOp
+	(*PathPaymentStrictReceiveResult)(nil),         // 136: xdrconverter.v1.PathPaymentStrictReceiveResult
+	(*PathPaymentStrictReceiveResultSuccess)(nil),  // 137: xdrconverter.v1.PathPaymentStrictReceiveResultSuccess
+	(*PathPaymentStrictSendOp)(nil),                // 138: xdrconverter.v1.PathPaymentStrictSendOp
+	(*PathPaymentStrictSendResult)(nil),            // 139: xdrconverter.v1.PathPaymentStrictSendResult
+	(*PathPaymentStrictSendResultSuccess)(nil),     // 140: xdrconverter.v1.PathPaymentStrictSendResultSuccess
+	(*PaymentOp)(nil),                              // 141: xdrconverter.v1.PaymentOp
+	(*PaymentResult)(nil),                          // 142: xdrconverter.v1.PaymentResult
+	(*Preconditions)(nil),                          // 143: xdrconverter.v1.Preconditions
+	(*PreconditionsV2)(nil),                        // 144: xdrconverter.v1.PreconditionsV2
+	(*Price)(nil),                                  // 145: xdrconverter.v1.Price
+	(*RestoreFootprintOp)(nil),                     // 146: xdrconverter.v1.RestoreFootprintOp
+	(*RestoreFootprintResult)(nil),                 // 147: xdrconverter.v1.RestoreFootprintResult
+	(*RevokeSponsorshipOp)(nil),                    // 148: xdrconverter.v1.RevokeSponsorshipOp
+	(*RevokeSponsorshipOpSigner)(nil),              // 149: xdrconverter.v1.RevokeSponsorshipOpSigner
+	(*RevokeSponsorshipResult)(nil),                // 150: xdrconverter.v1.RevokeSponsorshipResult
+	(*ScAddress)(nil),                              // 151: xdrconverter.v1.ScAddress
+	(*ScContractInstance)(nil),                     // 152: xdrconverter.v1.ScContractInstance
+	(*ScError)(nil),                                // 153: xdrconverter.v1.ScError
+	(*ScMapEntry)(nil),                             // 154: xdrconverter.v1.ScMapEntry
+	(*ScNonceKey)(nil),                             // 155: xdrconverter.v1.ScNonceKey
+	(*ScVal)(nil),                                  // 156: xdrconverter.v1.ScVal
+	(*SetOptionsOp)(nil),                           // 157: xdrconverter.v1.SetOptionsOp
+	(*SetOptionsResult)(nil),                       // 158: xdrconverter.v1.SetOptionsResult
+	(*SetTrustLineFlagsOp)(nil),                    // 159: xdrconverter.v1.SetTrustLineFlagsOp
+	(*SetTrustLineFlagsResult)(nil),                // 160: xdrconverter.v1.SetTrustLineFlagsResult
+	(*Signer)(nil),                                 // 161: xdrconverter.v1.Signer
+	(*SignerKey)(nil),                              // 162: xdrconverter.v1.SignerKey
+	(*SimplePaymentResult)(nil),                    // 163: xdrconverter.v1.SimplePaymentResult
+	(*SorobanAddressCredentials)(nil),              // 164: xdrconverter.v1.SorobanAddressCredentials
+	(*SorobanAuthorizationEntry)(nil),              // 165: xdrconverter.v1.SorobanAuthorizationEntry
+	(*SorobanAuthorizedFunction)(nil),              // 166: xdrconverter.v1.SorobanAuthorizedFunction
+	(*SorobanAuthorizedInvocation)(nil),            // 167: xdrconverter.v1.SorobanAuthorizedInvocation
+	(*SorobanCredentials)(nil),                     // 168: xdrconverter.v1.SorobanCredentials
+	(*SorobanResources)(nil),                       // 169: xdrconverter.v1.SorobanResources
+	(*SorobanTransactionData)(nil),                 // 170: xdrconverter.v1.SorobanTransactionData
+	(*SorobanTransactionMeta)(nil),                 // 171: xdrconverter.v1.SorobanTransactionMeta
+	(*SorobanTransactionMetaExt)(nil),              // 172: xdrconverter.v1.SorobanTransactionMetaExt
+	(*SorobanTransactionMetaExtV1)(nil),            // 173: xdrconverter.v1.SorobanTransactionMetaExtV1
+	(*SorobanTransactionMetaV2)(nil),               // 174: xdrconverter.v1.SorobanTransactionMetaV2
+	(*StateArchivalSettings)(nil),                  // 175: xdrconverter.v1.StateArchivalSettings
+	(*TimeBounds)(nil),                             // 176: xdrconverter.v1.TimeBounds
+	(*Transaction)(nil),                            // 177: xdrconverter.v1.Transaction
+	(*TransactionEnvelope)(nil),                    // 178: xdrconverter.v1.TransactionEnvelope
+	(*TransactionEvent)(nil),                       // 179: xdrconverter.v1.TransactionEvent
+	(*TransactionExt)(nil),                         // 180: xdrconverter.v1.TransactionExt
+	(*TransactionMeta)(nil),                        // 181: xdrconverter.v1.TransactionMeta
+	(*TransactionMetaV1)(nil),                      // 182: xdrconverter.v1.TransactionMetaV1
+	(*TransactionMetaV2)(nil),                      // 183: xdrconverter.v1.TransactionMetaV2
+	(*TransactionMetaV3)(nil),                      // 184: xdrconverter.v1.TransactionMetaV3
+	(*TransactionMetaV4)(nil),                      // 185: xdrconverter.v1.TransactionMetaV4
+	(*TransactionResult)(nil),                      // 186: xdrconverter.v1.TransactionResult
+	(*TransactionResultExt)(nil),                   // 187: xdrconverter.v1.TransactionResultExt
+	(*TransactionResultMeta)(nil),                  // 188: xdrconverter.v1.TransactionResultMeta
+	(*TransactionResultPair)(nil),                  // 189: xdrconverter.v1.TransactionResultPair
+	(*TransactionResultResult)(nil),                // 190: xdrconverter.v1.TransactionResultResult
+	(*TransactionV0)(nil),                          // 191: xdrconverter.v1.TransactionV0
+	(*TransactionV0Envelope)(nil),                  // 192: xdrconverter.v1.TransactionV0Envelope
+	(*TransactionV0Ext)(nil),                       // 193: xdrconverter.v1.TransactionV0Ext
+	(*TransactionV1Envelope)(nil),                  // 194: xdrconverter.v1.TransactionV1Envelope
+	(*TransferEvent)(nil),                          // 195: xdrconverter.v1.TransferEvent
+	(*TrustLineAsset)(nil),                         // 196: xdrconverter.v1.TrustLineAsset
+	(*TrustLineEntry)(nil),                         // 197: xdrconverter.v1.TrustLineEntry
+	(*TrustLineEntryExt)(nil),                      // 198: xdrconverter.v1.TrustLineEntryExt
+	(*TrustLineEntryExtensionV2)(nil),              // 199: xdrconverter.v1.TrustLineEntryExtensionV2
+	(*TrustLineEntryExtensionV2Ext)(nil),           // 200: xdrconverter.v1.TrustLineEntryExtensionV2Ext
+	(*TrustLineEntryV1)(nil),                       // 201: xdrconverter.v1.TrustLineEntryV1
+	(*TrustLineEntryV1Ext)(nil),                    // 202: xdrconverter.v1.TrustLineEntryV1Ext
+	(*TtlEntry)(nil),                               // 203: xdrconverter.v1.TtlEntry
+	(*UInt128Parts)(nil),                           // 204: xdrconverter.v1.UInt128Parts
+	(*UInt256Parts)(nil),                           // 205: xdrconverter.v1.UInt256Parts
+	(*timestamppb.Timestamp)(nil),                  // 206: google.protobuf.Timestamp
+}
+var file_xdrconverter_v1_types_proto_depIdxs = []int32{
+	7,   // 0: xdrconverter.v1.AccountEntry.account_id:type_name -> xdrconverter.v1.AccountId
+	7,   // 1: xdrconverter.v1.AccountEntry.inflation_dest:type_name -> xdrconverter.v1.AccountId
+	161, // 2: xdrconverter.v1.AccountEntry.signers:type_name -> xdrconverter.v1.Signer
+	1,   // 3: xdrconverter.v1.AccountEntry.ext:type_name -> xdrconverter.v1.AccountEntryExt
+	2,   // 4: xdrconverter.v1.AccountEntryExt.v1:type_name -> xdrconverter.v1.AccountEntryExtensionV1
+	106, // 5: xdrconverter.v1.AccountEntryExtensionV1.liabilities:type_name -> xdrconverter.v1.Liabilities
+	3,   // 6: xdrconverter.v1.AccountEntryExtensionV1.ext:type_name -> xdrconverter.v1.AccountEntryExtensionV1Ext
+	4,   // 7: xdrconverter.v1.AccountEntryExtensionV1Ext.v2:type_name -> xdrconverter.v1.AccountEntryExtensionV2
+	7,   // 8: xdrconverter.v1.AccountEntryExtensionV2.signer_sponsoring_ids:type_name -> xdrconverter.v1.AccountId
+	5,   // 9: xdrconverter.v1.AccountEntryExtensionV2.ext:type_name -> xdrconverter.v1.AccountEntryExtensionV2Ext
+	6,   // 10: xdrconverter.v1.AccountEntryExtensionV2Ext.v3:type_name -> xdrconverter.v1.AccountEntryExtensionV3
+	70,  // 11: xdrconverter.v1.AccountEntryExtensionV3.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	7,   // 12: xdrconverter.v1.AllowTrustOp.trustor:type_name -> xdrconverter.v1.AccountId
+	7,   // 13: xdrconverter.v1.Asset.issuer:type_name -> xdrconverter.v1.AccountId
+	7,   // 14: xdrconverter.v1.BeginSponsoringFutureReservesOp.sponsored_id:type_name -> xdrconverter.v1.AccountId
+	82,  // 15: xdrconverter.v1.BurnEvent.amount:type_name -> xdrconverter.v1.Int128Parts
+	11,  // 16: xdrconverter.v1.ChangeTrustAsset.asset:type_name -> xdrconverter.v1.Asset
+	113, // 17: xdrconverter.v1.ChangeTrustAsset.liquidity_pool:type_name -> xdrconverter.v1.LiquidityPoolParameters
+	17,  // 18: xdrconverter.v1.ChangeTrustOp.line:type_name -> xdrconverter.v1.ChangeTrustAsset
+	25,  // 19: xdrconverter.v1.ClaimAtom.v0:type_name -> xdrconverter.v1.ClaimOfferAtomV0
+	24,  // 20: xdrconverter.v1.ClaimAtom.order_book:type_name -> xdrconverter.v1.ClaimOfferAtom
+	23,  // 21: xdrconverter.v1.ClaimAtom.liquidity_pool:type_name -> xdrconverter.v1.ClaimLiquidityAtom
+	31,  // 22: xdrconverter.v1.ClaimClaimableBalanceOp.balance_id:type_name -> xdrconverter.v1.ClaimableBalanceId
+	11,  // 23: xdrconverter.v1.ClaimLiquidityAtom.asset_sold:type_name -> xdrconverter.v1.Asset
+	11,  // 24: xdrconverter.v1.ClaimLiquidityAtom.asset_bought:type_name -> xdrconverter.v1.Asset
+	7,   // 25: xdrconverter.v1.ClaimOfferAtom.seller_id:type_name -> xdrconverter.v1.AccountId
+	11,  // 26: xdrconverter.v1.ClaimOfferAtom.asset_sold:type_name -> xdrconverter.v1.Asset
+	11,  // 27: xdrconverter.v1.ClaimOfferAtom.asset_bought:type_name -> xdrconverter.v1.Asset
+	11,  // 28: xdrconverter.v1.ClaimOfferAtomV0.asset_sold:type_name -> xdrconverter.v1.Asset
+	11,  // 29: xdrconverter.v1.ClaimOfferAtomV0.asset_bought:type_name -> xdrconverter.v1.Asset
+	26,  // 30: xdrconverter.v1.ClaimPredicate.and_predicates:type_name -> xdrconverter.v1.ClaimPredicate
+	26,  // 31: xdrconverter.v1.ClaimPredicate.or_predicates:type_name -> xdrconverter.v1.ClaimPredicate
+	26,  // 32: xdrconverter.v1.ClaimPredicate.not_predicates:type_name -> xdrconverter.v1.ClaimPredicate
+	206, // 33: xdrconverter.v1.ClaimPredicate.abs_before:type_name -> google.protobuf.Timestamp
+	31,  // 34: xdrconverter.v1.ClaimableBalanceEntry.balance_id:type_name -> xdrconverter.v1.ClaimableBalanceId
+	32,  // 35: xdrconverter.v1.ClaimableBalanceEntry.claimants:type_name -> xdrconverter.v1.Claimant
+	11,  // 36: xdrconverter.v1.ClaimableBalanceEntry.asset:type_name -> xdrconverter.v1.Asset
+	28,  // 37: xdrconverter.v1.ClaimableBalanceEntry.ext:type_name -> xdrconverter.v1.ClaimableBalanceEntryExt
+	29,  // 38: xdrconverter.v1.ClaimableBalanceEntryExt.v1:type_name -> xdrconverter.v1.ClaimableBalanceEntryExtensionV1
+	30,  // 39: xdrconverter.v1.ClaimableBalanceEntryExtensionV1.ext:type_name -> xdrconverter.v1.ClaimableBalanceEntryExtensionV1Ext
+	33,  // 40: xdrconverter.v1.Claimant.v0:type_name -> xdrconverter.v1.ClaimantV0
+	7,   // 41: xdrconverter.v1.ClaimantV0.destination:type_name -> xdrconverter.v1.AccountId
+	26,  // 42: xdrconverter.v1.ClaimantV0.predicate:type_name -> xdrconverter.v1.ClaimPredicate
+	31,  // 43: xdrconverter.v1.ClawbackClaimableBalanceOp.balance_id:type_name -> xdrconverter.v1.ClaimableBalanceId
+	82,  // 44: xdrconverter.v1.ClawbackEvent.amount:type_name -> xdrconverter.v1.Int128Parts
+	11,  // 45: xdrconverter.v1.ClawbackOp.asset:type_name -> xdrconverter.v1.Asset
+	126, // 46: xdrconverter.v1.ClawbackOp.from:type_name -> xdrconverter.v1.MuxedAccount
+	40,  // 47: xdrconverter.v1.ConfigSettingEntry.contract_compute:type_name -> xdrconverter.v1.ConfigSettingContractComputeV0
+	44,  // 48: xdrconverter.v1.ConfigSettingEntry.contract_ledger_cost:type_name -> xdrconverter.v1.ConfigSettingContractLedgerCostV0
+	43,  // 49: xdrconverter.v1.ConfigSettingEntry.contract_historical_data:type_name -> xdrconverter.v1.ConfigSettingContractHistoricalDataV0
+	41,  // 50: xdrconverter.v1.ConfigSettingEntry.contract_events:type_name -> xdrconverter.v1.ConfigSettingContractEventsV0
+	39,  // 51: xdrconverter.v1.ConfigSettingEntry.contract_bandwith:type_name -> xdrconverter.v1.ConfigSettingContractBandwidthV0
+	50,  // 52: xdrconverter.v1.ConfigSettingEntry.contract_cost_params_cpu_insns:type_name -> xdrconverter.v1.ContractCostParamEntry
+	50,  // 53: xdrconverter.v1.ConfigSettingEntry.contract_cost_params_mem_bytes:type_name -> xdrconverter.v1.ContractCostParamEntry
+	175, // 54: xdrconverter.v1.ConfigSettingEntry.state_archival_settings:type_name -> xdrconverter.v1.StateArchivalSettings
+	42,  // 55: xdrconverter.v1.ConfigSettingEntry.contract_execute_lanes:type_name -> xdrconverter.v1.ConfigSettingContractExecutionLanesV0
+	67,  // 56: xdrconverter.v1.ConfigSettingEntry.eviction_iterator:type_name -> xdrconverter.v1.EvictionIterator
+	70,  // 57: xdrconverter.v1.ContractCodeCostInputs.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	48,  // 58: xdrconverter.v1.ContractCodeEntry.ext:type_name -> xdrconverter.v1.ContractCodeEntryExt
+	49,  // 59: xdrconverter.v1.ContractCodeEntryExt.v1:type_name -> xdrconverter.v1.ContractCodeEntryV1
+	70,  // 60: xdrconverter.v1.ContractCodeEntryV1.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	46,  // 61: xdrconverter.v1.ContractCodeEntryV1.cost_inputs:type_name -> xdrconverter.v1.ContractCodeCostInputs
+	70,  // 62: xdrconverter.v1.ContractCostParamEntry.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	70,  // 63: xdrconverter.v1.ContractDataEntry.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	151, // 64: xdrconverter.v1.ContractDataEntry.contract:type_name -> xdrconverter.v1.ScAddress
+	156, // 65: xdrconverter.v1.ContractDataEntry.key:type_name -> xdrconverter.v1.ScVal
+	156, // 66: xdrconverter.v1.ContractDataEntry.val:type_name -> xdrconverter.v1.ScVal
+	70,  // 67: xdrconverter.v1.ContractEvent.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	195, // 68: xdrconverter.v1.ContractEvent.transfer:type_name -> xdrconverter.v1.TransferEvent
+	125, // 69: xdrconverter.v1.ContractEvent.mint:type_name -> xdrconverter.v1.MintEvent
+	36,  // 70: xdrconverter.v1.ContractEvent.claw_back:type_name -> xdrconverter.v1.ClawbackEvent
+	16,  // 71: xdrconverter.v1.ContractEvent.burn:type_name -> xdrconverter.v1.BurnEvent
+	55,  // 72: xdrconverter.v1.ContractIdPreimage.from_address:type_name -> xdrconverter.v1.ContractIdPreimageFromAddress
+	11,  // 73: xdrconverter.v1.ContractIdPreimage.from_asset:type_name -> xdrconverter.v1.Asset
+	151, // 74: xdrconverter.v1.ContractIdPreimageFromAddress.address:type_name -> xdrconverter.v1.ScAddress
+	7,   // 75: xdrconverter.v1.CreateAccountOp.destination:type_name -> xdrconverter.v1.AccountId
+	11,  // 76: xdrconverter.v1.CreateClaimableBalanceOp.asset:type_name -> xdrconverter.v1.Asset
+	32,  // 77: xdrconverter.v1.CreateClaimableBalanceOp.claimants:type_name -> xdrconverter.v1.Claimant
+	31,  // 78: xdrconverter.v1.CreateClaimableBalanceOp.balance_id:type_name -> xdrconverter.v1.ClaimableBalanceId
+	31,  // 79: xdrconverter.v1.CreateClaimableBalanceResult.balance_id:type_name -> xdrconverter.v1.ClaimableBalanceId
+	54,  // 80: xdrconverter.v1.CreateContractArgs.contract_id_preimage:type_name -> xdrconverter.v1.ContractIdPreimage
+	53,  // 81: xdrconverter.v1.CreateContractArgs.executable:type_name -> xdrconverter.v1.ContractExecutable
+	11,  // 82: xdrconverter.v1.CreatePassiveSellOfferOp.selling:type_name -> xdrconverter.v1.Asset
+	11,  // 83: xdrconverter.v1.CreatePassiveSellOfferOp.buying:type_name -> xdrconverter.v1.Asset
+	145, // 84: xdrconverter.v1.CreatePassiveSellOfferOp.price:type_name -> xdrconverter.v1.Price
+	7,   // 85: xdrconverter.v1.DataEntry.account_id:type_name -> xdrconverter.v1.AccountId
+	63,  // 86: xdrconverter.v1.DataEntry.ext:type_name -> xdrconverter.v1.DataEntryExt
+	52,  // 87: xdrconverter.v1.DiagnosticEvent.event:type_name -> xdrconverter.v1.ContractEvent
+	70,  // 88: xdrconverter.v1.ExtendFootprintTtlOp.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	126, // 89: xdrconverter.v1.FeeBumpTransaction.fee_source:type_name -> xdrconverter.v1.MuxedAccount
+	74,  // 90: xdrconverter.v1.FeeBumpTransaction.inner_tx:type_name -> xdrconverter.v1.FeeBumpTransactionInnerTx
+	73,  // 91: xdrconverter.v1.FeeBumpTransaction.ext:type_name -> xdrconverter.v1.FeeBumpTransactionExt
+	71,  // 92: xdrconverter.v1.FeeBumpTransactionEnvelope.tx:type_name -> xdrconverter.v1.FeeBumpTransaction
+	64,  // 93: xdrconverter.v1.FeeBumpTransactionEnvelope.signatures:type_name -> xdrconverter.v1.DecoratedSignature
+	194, // 94: xdrconverter.v1.FeeBumpTransactionInnerTx.v1:type_name -> xdrconverter.v1.TransactionV1Envelope
+	84,  // 95: xdrconverter.v1.HostFunction.invoke_contract:type_name -> xdrconverter.v1.InvokeContractArgs
+	60,  // 96: xdrconverter.v1.HostFunction.create_contract:type_name -> xdrconverter.v1.CreateContractArgs
+	7,   // 97: xdrconverter.v1.InflationPayout.destination:type_name -> xdrconverter.v1.AccountId
+	76,  // 98: xdrconverter.v1.InflationResult.payouts:type_name -> xdrconverter.v1.InflationPayout
+	81,  // 99: xdrconverter.v1.InnerTransactionResult.result:type_name -> xdrconverter.v1.InnerTransactionResultResult
+	79,  // 100: xdrconverter.v1.InnerTransactionResult.ext:type_name -> xdrconverter.v1.InnerTransactionResultExt
+	78,  // 101: xdrconverter.v1.InnerTransactionResultPair.result:type_name -> xdrconverter.v1.InnerTransactionResult
+	133, // 102: xdrconverter.v1.InnerTransactionResultResult.results:type_name -> xdrconverter.v1.OperationResult
+	151, // 103: xdrconverter.v1.InvokeContractArgs.contract_address:type_name -> xdrconverter.v1.ScAddress
+	156, // 104: xdrconverter.v1.InvokeContractArgs.args:type_name -> xdrconverter.v1.ScVal
+	75,  // 105: xdrconverter.v1.InvokeHostFunctionOp.host_function:type_name -> xdrconverter.v1.HostFunction
+	165, // 106: xdrconverter.v1.InvokeHostFunctionOp.auth:type_name -> xdrconverter.v1.SorobanAuthorizationEntry
+	90,  // 107: xdrconverter.v1.LedgerEntry.data:type_name -> xdrconverter.v1.LedgerEntryData
+	91,  // 108: xdrconverter.v1.LedgerEntry.ext:type_name -> xdrconverter.v1.LedgerEntryExt
+	88,  // 109: xdrconverter.v1.LedgerEntryChange.created:type_name -> xdrconverter.v1.LedgerEntry
+	88,  // 110: xdrconverter.v1.LedgerEntryChange.updated:type_name -> xdrconverter.v1.LedgerEntry
+	95,  // 111: xdrconverter.v1.LedgerEntryChange.removed:type_name -> xdrconverter.v1.LedgerKey
+	88,  // 112: xdrconverter.v1.LedgerEntryChange.state:type_name -> xdrconverter.v1.LedgerEntry
+	88,  // 113: xdrconverter.v1.LedgerEntryChange.restored:type_name -> xdrconverter.v1.LedgerEntry
+	0,   // 114: xdrconverter.v1.LedgerEntryData.account:type_name -> xdrconverter.v1.AccountEntry
+	197, // 115: xdrconverter.v1.LedgerEntryData.trust_line:type_name -> xdrconverter.v1.TrustLineEntry
+	127, // 116: xdrconverter.v1.LedgerEntryData.offer:type_name -> xdrconverter.v1.OfferEntry
+	62,  // 117: xdrconverter.v1.LedgerEntryData.data:type_name -> xdrconverter.v1.DataEntry
+	27,  // 118: xdrconverter.v1.LedgerEntryData.claimable_balance:type_name -> xdrconverter.v1.ClaimableBalanceEntry
+	110, // 119: xdrconverter.v1.LedgerEntryData.liquidity_pool:type_name -> xdrconverter.v1.LiquidityPoolEntry
+	51,  // 120: xdrconverter.v1.LedgerEntryData.contract_data:type_name -> xdrconverter.v1.ContractDataEntry
+	47,  // 121: xdrconverter.v1.LedgerEntryData.contract_code:type_name -> xdrconverter.v1.ContractCodeEntry
+	45,  // 122: xdrconverter.v1.LedgerEntryData.config_setting:type_name -> xdrconverter.v1.ConfigSettingEntry
+	203, // 123: xdrconverter.v1.LedgerEntryData.ttl:type_name -> xdrconverter.v1.TtlEntry
+	92,  // 124: xdrconverter.v1.LedgerEntryExt.v1:type_name -> xdrconverter.v1.LedgerEntryExtensionV1
+	7,   // 125: xdrconverter.v1.LedgerEntryExtensionV1.sponsoring_id:type_name -> xdrconverter.v1.AccountId
+	93,  // 126: xdrconverter.v1.LedgerEntryExtensionV1.ext:type_name -> xdrconverter.v1.LedgerEntryExtensionV1Ext
+	95,  // 127: xdrconverter.v1.LedgerFootprint.read_only:type_name -> xdrconverter.v1.LedgerKey
+	95,  // 128: xdrconverter.v1.LedgerFootprint.read_write:type_name -> xdrconverter.v1.LedgerKey
+	96,  // 129: xdrconverter.v1.LedgerKey.account:type_name -> xdrconverter.v1.LedgerKeyAccount
+	104, // 130: xdrconverter.v1.LedgerKey.trust_line:type_name -> xdrconverter.v1.LedgerKeyTrustLine
+	103, // 131: xdrconverter.v1.LedgerKey.offer:type_name -> xdrconverter.v1.LedgerKeyOffer
+	101, // 132: xdrconverter.v1.LedgerKey.data:type_name -> xdrconverter.v1.LedgerKeyData
+	97,  // 133: xdrconverter.v1.LedgerKey.claimable_balance:type_name -> xdrconverter.v1.LedgerKeyClaimableBalance
+	102, // 134: xdrconverter.v1.LedgerKey.liquidity_pool:type_name -> xdrconverter.v1.LedgerKeyLiquidityPool
+	100, // 135: xdrconverter.v1.LedgerKey.contract_data:type_name -> xdrconverter.v1.LedgerKeyContractData
+	99,  // 136: xdrconverter.v1.LedgerKey.contract_code:type_name -> xdrconverter.v1.LedgerKeyContractCode
+	98,  // 137: xdrconverter.v1.LedgerKey.config_setting:type_name -> xdrconverter.v1.LedgerKeyConfigSetting
+	105, // 138: xdrconverter.v1.LedgerKey.ttl:type_name -> xdrconverter.v1.LedgerKeyTtl
+	7,   // 139: xdrconverter.v1.LedgerKeyAccount.account_id:type_name -> xdrconverter.v1.AccountId
+	31,  // 140: xdrconverter.v1.LedgerKeyClaimableBalance.balance_id:type_name -> xdrconverter.v1.ClaimableBalanceId
+	151, // 141: xdrconverter.v1.LedgerKeyContractData.contract:type_name -> xdrconverter.v1.ScAddress
+	156, // 142: xdrconverter.v1.LedgerKeyContractData.key:type_name -> xdrconverter.v1.ScVal
+	7,   // 143: xdrconverter.v1.LedgerKeyData.account_id:type_name -> xdrconverter.v1.AccountId
+	7,   // 144: xdrconverter.v1.LedgerKeyOffer.seller_id:type_name -> xdrconverter.v1.AccountId
+	7,   // 145: xdrconverter.v1.LedgerKeyTrustLine.account_id:type_name -> xdrconverter.v1.AccountId
+	196, // 146: xdrconverter.v1.LedgerKeyTrustLine.asset:type_name -> xdrconverter.v1.TrustLineAsset
+	11,  // 147: xdrconverter.v1.LiquidityPoolConstantProductParameters.asset_a:type_name -> xdrconverter.v1.Asset
+	11,  // 148: xdrconverter.v1.LiquidityPoolConstantProductParameters.asset_b:type_name -> xdrconverter.v1.Asset
+	145, // 149: xdrconverter.v1.LiquidityPoolDepositOp.min_price:type_name -> xdrconverter.v1.Price
+	145, // 150: xdrconverter.v1.LiquidityPoolDepositOp.max_price:type_name -> xdrconverter.v1.Price
+	111, // 151: xdrconverter.v1.LiquidityPoolEntry.body:type_name -> xdrconverter.v1.LiquidityPoolEntryBody
+	112, // 152: xdrconverter.v1.LiquidityPoolEntryBody.constant_product:type_name -> xdrconverter.v1.LiquidityPoolEntryConstantProduct
+	107, // 153: xdrconverter.v1.LiquidityPoolEntryConstantProduct.params:type_name -> xdrconverter.v1.LiquidityPoolConstantProductParameters
+	107, // 154: xdrconverter.v1.LiquidityPoolParameters.constant_product:type_name -> xdrconverter.v1.LiquidityPoolConstantProductParameters
+	11,  // 155: xdrconverter.v1.ManageBuyOfferOp.selling:type_name -> xdrconverter.v1.Asset
+	11,  // 156: xdrconverter.v1.ManageBuyOfferOp.buying:type_name -> xdrconverter.v1.Asset
+	145, // 157: xdrconverter.v1.ManageBuyOfferOp.price:type_name -> xdrconverter.v1.Price
+	120, // 158: xdrconverter.v1.ManageBuyOfferResult.success:type_name -> xdrconverter.v1.ManageOfferSuccessResult
+	20,  // 159: xdrconverter.v1.ManageOfferSuccessResult.offers_claimed:type_name -> xdrconverter.v1.ClaimAtom
+	121, // 160: xdrconverter.v1.ManageOfferSuccessResult.offer:type_name -> xdrconverter.v1.ManageOfferSuccessResultOffer
+	127, // 161: xdrconverter.v1.ManageOfferSuccessResultOffer.offer:type_name -> xdrconverter.v1.OfferEntry
+	11,  // 162: xdrconverter.v1.ManageSellOfferOp.selling:type_name -> xdrconverter.v1.Asset
+	11,  // 163: xdrconverter.v1.ManageSellOfferOp.buying:type_name -> xdrconverter.v1.Asset
+	145, // 164: xdrconverter.v1.ManageSellOfferOp.price:type_name -> xdrconverter.v1.Price
+	120, // 165: xdrconverter.v1.ManageSellOfferResult.success:type_name -> xdrconverter.v1.ManageOfferSuccessResult
+	82,  // 166: xdrconverter.v1.MintEvent.amount:type_name -> xdrconverter.v1.Int128Parts
+	7,   // 167: xdrconverter.v1.OfferEntry.seller_id:type_name -> xdrconverter.v1.AccountId
+	11,  // 168: xdrconverter.v1.OfferEntry.selling:type_name -> xdrconverter.v1.Asset
+	11,  // 169: xdrconverter.v1.OfferEntry.buying:type_name -> xdrconverter.v1.Asset
+	145, // 170: xdrconverter.v1.OfferEntry.price:type_name -> xdrconverter.v1.Price
+	128, // 171: xdrconverter.v1.OfferEntry.ext:type_name -> xdrconverter.v1.OfferEntryExt
+	126, // 172: xdrconverter.v1.Operation.source_account:type_name -> xdrconverter.v1.MuxedAccount
+	130, // 173: xdrconverter.v1.Operation.body:type_name -> xdrconverter.v1.OperationBody
+	56,  // 174: xdrconverter.v1.OperationBody.create_account_op:type_name -> xdrconverter.v1.CreateAccountOp
+	141, // 175: xdrconverter.v1.OperationBody.payment_op:type_name -> xdrconverter.v1.PaymentOp
+	135, // 176: xdrconverter.v1.OperationBody.path_payment_strict_receive_op:type_name -> xdrconverter.v1.PathPaymentStrictReceiveOp
+	122, // 177: xdrconverter.v1.OperationBody.manage_sell_offer_op:type_name -> xdrconverter.v1.ManageSellOfferOp
+	61,  // 178: xdrconverter.v1.OperationBody.create_passive_sell_offer_op:type_name -> xdrconverter.v1.CreatePassiveSellOfferOp
+	157, // 179: xdrconverter.v1.OperationBody.set_options_op:type_name -> xdrconverter.v1.SetOptionsOp
+	18,  // 180: xdrconverter.v1.OperationBody.change_trust_op:type_name -> xdrconverter.v1.ChangeTrustOp
+	9,   // 181: xdrconverter.v1.OperationBody.allow_trust_op:type_name -> xdrconverter.v1.AllowTrustOp
+	126, // 182: xdrconverter.v1.OperationBody.muxed_account:type_name -> xdrconverter.v1.MuxedAccount
+	118, // 183: xdrconverter.v1.OperationBody.manage_data_op:type_name -> xdrconverter.v1.ManageDataOp
+	14,  // 184: xdrconverter.v1.OperationBody.bump_sequence_op:type_name -> xdrconverter.v1.BumpSequenceOp
+	116, // 185: xdrconverter.v1.OperationBody.manage_buy_offer_op:type_name -> xdrconverter.v1.ManageBuyOfferOp
+	138, // 186: xdrconverter.v1.OperationBody.path_payment_strict_send_op:type_name -> xdrconverter.v1.PathPaymentStrictSendOp
+	58,  // 187: xdrconverter.v1.OperationBody.create_claimable_balance_op:type_name -> xdrconverter.v1.CreateClaimableBalanceOp
+	21,  // 188: xdrconverter.v1.OperationBody.claim_claimable_balance_op:type_name -> xdrconverter.v1.ClaimClaimableBalanceOp
+	12,  // 189: xdrconverter.v1.OperationBody.begin_sponsoring_future_reserves_op:type_name -> xdrconverter.v1.BeginSponsoringFutureReservesOp
+	148, // 190: xdrconverter.v1.OperationBody.revoke_sponsorship_op:type_name -> xdrconverter.v1.RevokeSponsorshipOp
+	37,  // 191: xdrconverter.v1.OperationBody.clawback_op:type_name -> xdrconverter.v1.ClawbackOp
+	34,  // 192: xdrconverter.v1.OperationBody.clawback_claimable_balance_op:type_name -> xdrconverter.v1.ClawbackClaimableBalanceOp
+	159, // 193: xdrconverter.v1.OperationBody.set_trust_line_flags_op:type_name -> xdrconverter.v1.SetTrustLineFlagsOp
+	108, // 194: xdrconverter.v1.OperationBody.liquidity_pool_deposit_op:type_name -> xdrconverter.v1.LiquidityPoolDepositOp
+	114, // 195: xdrconverter.v1.OperationBody.liquidity_pool_withdraw_op:type_name -> xdrconverter.v1.LiquidityPoolWithdrawOp
+	85,  // 196: xdrconverter.v1.OperationBody.invoke_host_function_op:type_name -> xdrconverter.v1.InvokeHostFunctionOp
+	68,  // 197: xdrconverter.v1.OperationBody.extend_footprint_ttl_op:type_name -> xdrconverter.v1.ExtendFootprintTtlOp
+	146, // 198: xdrconverter.v1.OperationBody.restore_footprint_op:type_name -> xdrconverter.v1.RestoreFootprintOp
+	89,  // 199: xdrconverter.v1.OperationMeta.changes:type_name -> xdrconverter.v1.LedgerEntryChange
+	70,  // 200: xdrconverter.v1.OperationMetaV2.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	89,  // 201: xdrconverter.v1.OperationMetaV2.changes:type_name -> xdrconverter.v1.LedgerEntryChange
+	52,  // 202: xdrconverter.v1.OperationMetaV2.events:type_name -> xdrconverter.v1.ContractEvent
+	134, // 203: xdrconverter.v1.OperationResult.tr:type_name -> xdrconverter.v1.OperationResultTr
+	57,  // 204: xdrconverter.v1.OperationResultTr.create_account_result:type_name -> xdrconverter.v1.CreateAccountResult
+	142, // 205: xdrconverter.v1.OperationResultTr.payment_result:type_name -> xdrconverter.v1.PaymentResult
+	136, // 206: xdrconverter.v1.OperationResultTr.path_payment_strict_receive_result:type_name -> xdrconverter.v1.PathPaymentStrictReceiveResult
+	123, // 207: xdrconverter.v1.OperationResultTr.manage_sell_offer_result:type_name -> xdrconverter.v1.ManageSellOfferResult
+	123, // 208: xdrconverter.v1.OperationResultTr.create_passive_sell_offer_result:type_name -> xdrconverter.v1.ManageSellOfferResult
+	158, // 209: xdrconverter.v1.OperationResultTr.set_options_result:type_name -> xdrconverter.v1.SetOptionsResult
+	19,  // 210: xdrconverter.v1.OperationResultTr.change_trust_result:type_name -> xdrconverter.v1.ChangeTrustResult
+	10,  // 211: xdrconverter.v1.OperationResultTr.allow_trust_result:type_name -> xdrconverter.v1.AllowTrustResult
+	8,   // 212: xdrconverter.v1.OperationResultTr.account_merge_result:type_name -> xdrconverter.v1.AccountMergeResult
+	77,  // 213: xdrconverter.v1.OperationResultTr.inflation_result:type_name -> xdrconverter.v1.InflationResult
+	119, // 214: xdrconverter.v1.OperationResultTr.manage_data_result:type_name -> xdrconverter.v1.ManageDataResult
+	15,  // 215: xdrconverter.v1.OperationResultTr.bump_seq_result:type_name -> xdrconverter.v1.BumpSequenceResult
+	117, // 216: xdrconverter.v1.OperationResultTr.manage_buy_offer_result:type_name -> xdrconverter.v1.ManageBuyOfferResult
+	139, // 217: xdrconverter.v1.OperationResultTr.path_payment_strict_send_result:type_name -> xdrconverter.v1.PathPaymentStrictSendResult
+	59,  // 218: xdrconverter.v1.OperationResultTr.create_claimable_balance_result:type_name -> xdrconverter.v1.CreateClaimableBalanceResult
+	22,  // 219: xdrconverter.v1.OperationResultTr.claim_claimable_balance_result:type_name -> xdrconverter.v1.ClaimClaimableBalanceResult
+	13,  // 220: xdrconverter.v1.OperationResultTr.begin_sponsoring_future_reserves_result:type_name -> xdrconverter.v1.BeginSponsoringFutureReservesResult
+	66,  // 221: xdrconverter.v1.OperationResultTr.end_sponsoring_future_reserves_result:type_name -> xdrconverter.v1.EndSponsoringFutureReservesResult
+	150, // 222: xdrconverter.v1.OperationResultTr.revoke_sponsorship_result:type_name -> xdrconverter.v1.RevokeSponsorshipResult
+	38,  // 223: xdrconverter.v1.OperationResultTr.clawback_result:type_name -> xdrconverter.v1.ClawbackResult
+	35,  // 224: xdrconverter.v1.OperationResultTr.clawback_claimable_balance_result:type_name -> xdrconverter.v1.ClawbackClaimableBalanceResult
+	160, // 225: xdrconverter.v1.OperationResultTr.set_trust_line_flags_result:type_name -> xdrconverter.v1.SetTrustLineFlagsResult
+	109, // 226: xdrconverter.v1.OperationResultTr.liquidity_pool_deposit_result:type_name -> xdrconverter.v1.LiquidityPoolDepositResult
+	115, // 227: xdrconverter.v1.OperationResultTr.liquidity_pool_withdraw_result:type_name -> xdrconverter.v1.LiquidityPoolWithdrawResult
+	86,  // 228: xdrconverter.v1.OperationResultTr.invoke_host_function_result:type_name -> xdrconverter.v1.InvokeHostFunctionResult
+	69,  // 229: xdrconverter.v1.OperationResultTr.extend_footprint_ttl_result:type_name -> xdrconverter.v1.ExtendFootprintTtlResult
+	147, // 230: xdrconverter.v1.OperationResultTr.restore_footprint_result:type_name -> xdrconverter.v1.RestoreFootprintResult
+	11,  // 231: xdrconverter.v1.PathPaymentStrictReceiveOp.send_asset:type_name -> xdrconverter.v1.Asset
+	126, // 232: xdrconverter.v1.PathPaymentStrictReceiveOp.destination:type_name -> xdrconverter.v1.MuxedAccount
+	11,  // 233: xdrconverter.v1.PathPaymentStrictReceiveOp.dest_asset:type_name -> xdrconverter.v1.Asset
+	11,  // 234: xdrconverter.v1.PathPaymentStrictReceiveOp.path:type_name -> xdrconverter.v1.Asset
+	137, // 235: xdrconverter.v1.PathPaymentStrictReceiveResult.success:type_name -> xdrconverter.v1.PathPaymentStrictReceiveResultSuccess
+	11,  // 236: xdrconverter.v1.PathPaymentStrictReceiveResult.no_issuer:type_name -> xdrconverter.v1.Asset
+	20,  // 237: xdrconverter.v1.PathPaymentStrictReceiveResultSuccess.offers:type_name -> xdrconverter.v1.ClaimAtom
+	163, // 238: xdrconverter.v1.PathPaymentStrictReceiveResultSuccess.last:type_name -> xdrconverter.v1.SimplePaymentResult
+	11,  // 239: xdrconverter.v1.PathPaymentStrictSendOp.send_asset:type_name -> xdrconverter.v1.Asset
+	126, // 240: xdrconverter.v1.PathPaymentStrictSendOp.destination:type_name -> xdrconverter.v1.MuxedAccount
+	11,  // 241: xdrconverter.v1.PathPaymentStrictSendOp.dest_asset:type_name -> xdrconverter.v1.Asset
+	11,  // 242: xdrconverter.v1.PathPaymentStrictSendOp.path:type_name -> xdrconverter.v1.Asset
+	140, // 243: xdrconverter.v1.PathPaymentStrictSendResult.success:type_name -> xdrconverter.v1.PathPaymentStrictSendResultSuccess
+	11,  // 244: xdrconverter.v1.PathPaymentStrictSendResult.no_issuer:type_name -> xdrconverter.v1.Asset
+	20,  // 245: xdrconverter.v1.PathPaymentStrictSendResultSuccess.offers:type_name -> xdrconverter.v1.ClaimAtom
+	163, // 246: xdrconverter.v1.PathPaymentStrictSendResultSuccess.last:type_name -> xdrconverter.v1.SimplePaymentResult
+	126, // 247: xdrconverter.v1.PaymentOp.destination:type_name -> xdrconverter.v1.MuxedAccount
+	11,  // 248: xdrconverter.v1.PaymentOp.asset:type_name -> xdrconverter.v1.Asset
+	176, // 249: xdrconverter.v1.Preconditions.time_bounds:type_name -> xdrconverter.v1.TimeBounds
+	144, // 250: xdrconverter.v1.Preconditions.v2:type_name -> xdrconverter.v1.PreconditionsV2
+	176, // 251: xdrconverter.v1.PreconditionsV2.time_bounds:type_name -> xdrconverter.v1.TimeBounds
+	87,  // 252: xdrconverter.v1.PreconditionsV2.ledger_bounds:type_name -> xdrconverter.v1.LedgerBounds
+	162, // 253: xdrconverter.v1.PreconditionsV2.extra_signers:type_name -> xdrconverter.v1.SignerKey
+	70,  // 254: xdrconverter.v1.RestoreFootprintOp.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	95,  // 255: xdrconverter.v1.RevokeSponsorshipOp.ledger_key:type_name -> xdrconverter.v1.LedgerKey
+	149, // 256: xdrconverter.v1.RevokeSponsorshipOp.signer:type_name -> xdrconverter.v1.RevokeSponsorshipOpSigner
+	7,   // 257: xdrconverter.v1.RevokeSponsorshipOpSigner.account_id:type_name -> xdrconverter.v1.AccountId
+	162, // 258: xdrconverter.v1.RevokeSponsorshipOpSigner.signer_key:type_name -> xdrconverter.v1.SignerKey
+	53,  // 259: xdrconverter.v1.ScContractInstance.executable:type_name -> xdrconverter.v1.ContractExecutable
+	154, // 260: xdrconverter.v1.ScContractInstance.storage:type_name -> xdrconverter.v1.ScMapEntry
+	156, // 261: xdrconverter.v1.ScMapEntry.key:type_name -> xdrconverter.v1.ScVal
+	156, // 262: xdrconverter.v1.ScMapEntry.val:type_name -> xdrconverter.v1.ScVal
+	153, // 263: xdrconverter.v1.ScVal.error:type_name -> xdrconverter.v1.ScError
+	204, // 264: xdrconverter.v1.ScVal.u128:type_name -> xdrconverter.v1.UInt128Parts
+	82,  // 265: xdrconverter.v1.ScVal.i128:type_name -> xdrconverter.v1.Int128Parts
+	205, // 266: xdrconverter.v1.ScVal.u256:type_name -> xdrconverter.v1.UInt256Parts
+	83,  // 267: xdrconverter.v1.ScVal.i256:type_name -> xdrconverter.v1.Int256Parts
+	156, // 268: xdrconverter.v1.ScVal.vec:type_name -> xdrconverter.v1.ScVal
+	154, // 269: xdrconverter.v1.ScVal.map:type_name -> xdrconverter.v1.ScMapEntry
+	151, // 270: xdrconverter.v1.ScVal.address:type_name -> xdrconverter.v1.ScAddress
+	155, // 271: xdrconverter.v1.ScVal.nonce_key:type_name -> xdrconverter.v1.ScNonceKey
+	152, // 272: xdrconverter.v1.ScVal.instance:type_name -> xdrconverter.v1.ScContractInstance
+	7,   // 273: xdrconverter.v1.SetOptionsOp.inflation_dest:type_name -> xdrconverter.v1.AccountId
+	161, // 274: xdrconverter.v1.SetOptionsOp.signer:type_name -> xdrconverter.v1.Signer
+	7,   // 275: xdrconverter.v1.SetTrustLineFlagsOp.trustor:type_name -> xdrconverter.v1.AccountId
+	11,  // 276: xdrconverter.v1.SetTrustLineFlagsOp.asset:type_name -> xdrconverter.v1.Asset
+	162, // 277: xdrconverter.v1.Signer.key:type_name -> xdrconverter.v1.SignerKey
+	7,   // 278: xdrconverter.v1.SimplePaymentResult.destination:type_name -> xdrconverter.v1.AccountId
+	11,  // 279: xdrconverter.v1.SimplePaymentResult.asset:type_name -> xdrconverter.v1.Asset
+	151, // 280: xdrconverter.v1.SorobanAddressCredentials.address:type_name -> xdrconverter.v1.ScAddress
+	156, // 281: xdrconverter.v1.SorobanAddressCredentials.signature:type_name -> xdrconverter.v1.ScVal
+	168, // 282: xdrconverter.v1.SorobanAuthorizationEntry.credentials:type_name -> xdrconverter.v1.SorobanCredentials
+	167, // 283: xdrconverter.v1.SorobanAuthorizationEntry.root_invocation:type_name -> xdrconverter.v1.SorobanAuthorizedInvocation
+	84,  // 284: xdrconverter.v1.SorobanAuthorizedFunction.contract_fn:type_name -> xdrconverter.v1.InvokeContractArgs
+	60,  // 285: xdrconverter.v1.SorobanAuthorizedFunction.create_contract_host_fn:type_name -> xdrconverter.v1.CreateContractArgs
+	166, // 286: xdrconverter.v1.SorobanAuthorizedInvocation.function:type_name -> xdrconverter.v1.SorobanAuthorizedFunction
+	167, // 287: xdrconverter.v1.SorobanAuthorizedInvocation.sub_invocations:type_name -> xdrconverter.v1.SorobanAuthorizedInvocation
+	164, // 288: xdrconverter.v1.SorobanCredentials.address:type_name -> xdrconverter.v1.SorobanAddressCredentials
+	94,  // 289: xdrconverter.v1.SorobanResources.footprint:type_name -> xdrconverter.v1.LedgerFootprint
+	70,  // 290: xdrconverter.v1.SorobanTransactionData.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	169, // 291: xdrconverter.v1.SorobanTransactionData.resources:type_name -> xdrconverter.v1.SorobanResources
+	172, // 292: xdrconverter.v1.SorobanTransactionMeta.ext:type_name -> xdrconverter.v1.SorobanTransactionMetaExt
+	52,  // 293: xdrconverter.v1.SorobanTransactionMeta.events:type_name -> xdrconverter.v1.ContractEvent
+	156, // 294: xdrconverter.v1.SorobanTransactionMeta.return_value:type_name -> xdrconverter.v1.ScVal
+	65,  // 295: xdrconverter.v1.SorobanTransactionMeta.diagnostic_events:type_name -> xdrconverter.v1.DiagnosticEvent
+	173, // 296: xdrconverter.v1.SorobanTransactionMetaExt.v1:type_name -> xdrconverter.v1.SorobanTransactionMetaExtV1
+	70,  // 297: xdrconverter.v1.SorobanTransactionMetaExtV1.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	172, // 298: xdrconverter.v1.SorobanTransactionMetaV2.ext:type_name -> xdrconverter.v1.SorobanTransactionMetaExt
+	156, // 299: xdrconverter.v1.SorobanTransactionMetaV2.return_value:type_name -> xdrconverter.v1.ScVal
+	126, // 300: xdrconverter.v1.Transaction.source_account:type_name -> xdrconverter.v1.MuxedAccount
+	143, // 301: xdrconverter.v1.Transaction.cond:type_name -> xdrconverter.v1.Preconditions
+	124, // 302: xdrconverter.v1.Transaction.memo:type_name -> xdrconverter.v1.Memo
+	129, // 303: xdrconverter.v1.Transaction.operations:type_name -> xdrconverter.v1.Operation
+	180, // 304: xdrconverter.v1.Transaction.ext:type_name -> xdrconverter.v1.TransactionExt
+	192, // 305: xdrconverter.v1.TransactionEnvelope.v0:type_name -> xdrconverter.v1.TransactionV0Envelope
+	194, // 306: xdrconverter.v1.TransactionEnvelope.v1:type_name -> xdrconverter.v1.TransactionV1Envelope
+	72,  // 307: xdrconverter.v1.TransactionEnvelope.feebump:type_name -> xdrconverter.v1.FeeBumpTransactionEnvelope
+	52,  // 308: xdrconverter.v1.TransactionEvent.event:type_name -> xdrconverter.v1.ContractEvent
+	170, // 309: xdrconverter.v1.TransactionExt.soroban_data:type_name -> xdrconverter.v1.SorobanTransactionData
+	131, // 310: xdrconverter.v1.TransactionMeta.operations:type_name -> xdrconverter.v1.OperationMeta
+	182, // 311: xdrconverter.v1.TransactionMeta.v1:type_name -> xdrconverter.v1.TransactionMetaV1
+	183, // 312: xdrconverter.v1.TransactionMeta.v2:type_name -> xdrconverter.v1.TransactionMetaV2
+	184, // 313: xdrconverter.v1.TransactionMeta.v3:type_name -> xdrconverter.v1.TransactionMetaV3
+	185, // 314: xdrconverter.v1.TransactionMeta.v4:type_name -> xdrconverter.v1.TransactionMetaV4
+	89,  // 315: xdrconverter.v1.TransactionMetaV1.tx_changes:type_name -> xdrconverter.v1.LedgerEntryChange
+	131, // 316: xdrconverter.v1.TransactionMetaV1.operations:type_name -> xdrconverter.v1.OperationMeta
+	89,  // 317: xdrconverter.v1.TransactionMetaV2.tx_changes_before:type_name -> xdrconverter.v1.LedgerEntryChange
+	131, // 318: xdrconverter.v1.TransactionMetaV2.operations:type_name -> xdrconverter.v1.OperationMeta
+	89,  // 319: xdrconverter.v1.TransactionMetaV2.tx_changes_after:type_name -> xdrconverter.v1.LedgerEntryChange
+	70,  // 320: xdrconverter.v1.TransactionMetaV3.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	89,  // 321: xdrconverter.v1.TransactionMetaV3.tx_changes_before:type_name -> xdrconverter.v1.LedgerEntryChange
+	131, // 322: xdrconverter.v1.TransactionMetaV3.operations:type_name -> xdrconverter.v1.OperationMeta
+	89,  // 323: xdrconverter.v1.TransactionMetaV3.tx_changes_after:type_name -> xdrconverter.v1.LedgerEntryChange
+	171, // 324: xdrconverter.v1.TransactionMetaV3.soroban_meta:type_name -> xdrconverter.v1.SorobanTransactionMeta
+	70,  // 325: xdrconverter.v1.TransactionMetaV4.ext:type_name -> xdrconverter.v1.ExtensionPoint
+	89,  // 326: xdrconverter.v1.TransactionMetaV4.tx_changes_before:type_name -> xdrconverter.v1.LedgerEntryChange
+	132, // 327: xdrconverter.v1.TransactionMetaV4.operations:type_name -> xdrconverter.v1.OperationMetaV2
+	89,  // 328: xdrconverter.v1.TransactionMetaV4.tx_changes_after:type_name -> xdrconverter.v1.LedgerEntryChange
+	174, // 329: xdrconverter.v1.TransactionMetaV4.soroban_meta:type_name -> xdrconverter.v1.SorobanTransactionMetaV2
+	179, // 330: xdrconverter.v1.TransactionMetaV4.events:type_name -> xdrconverter.v1.TransactionEvent
+	65,  // 331: xdrconverter.v1.TransactionMetaV4.diagnostic_events:type_name -> xdrconverter.v1.DiagnosticEvent
+	190, // 332: xdrconverter.v1.TransactionResult.result:type_name -> xdrconverter.v1.TransactionResultResult
+	187, // 333: xdrconverter.v1.TransactionResult.ext:type_name -> xdrconverter.v1.TransactionResultExt
+	189, // 334: xdrconverter.v1.TransactionResultMeta.result:type_name -> xdrconverter.v1.TransactionResultPair
+	89,  // 335: xdrconverter.v1.TransactionResultMeta.fee_processing:type_name -> xdrconverter.v1.LedgerEntryChange
+	181, // 336: xdrconverter.v1.TransactionResultMeta.tx_apply_processing:type_name -> xdrconverter.v1.TransactionMeta
+	89,  // 337: xdrconverter.v1.TransactionResultMeta.post_tx_apply_fee_processing:type_name -> xdrconverter.v1.LedgerEntryChange
+	186, // 338: xdrconverter.v1.TransactionResultPair.result:type_name -> xdrconverter.v1.TransactionResult
+	80,  // 339: xdrconverter.v1.TransactionResultResult.inner_result_pair:type_name -> xdrconverter.v1.InnerTransactionResultPair
+	133, // 340: xdrconverter.v1.TransactionResultResult.results:type_name -> xdrconverter.v1.OperationResult
+	176, // 341: xdrconverter.v1.TransactionV0.time_bounds:type_name -> xdrconverter.v1.TimeBounds
+	124, // 342: xdrconverter.v1.TransactionV0.memo:type_name -> xdrconverter.v1.Memo
+	129, // 343: xdrconverter.v1.TransactionV0.operations:type_name -> xdrconverter.v1.Operation
+	193, // 344: xdrconverter.v1.TransactionV0.ext:type_name -> xdrconverter.v1.TransactionV0Ext
+	191, // 345: xdrconverter.v1.TransactionV0Envelope.tx:type_name -> xdrconverter.v1.TransactionV0
+	64,  // 346: xdrconverter.v1.TransactionV0Envelope.signatures:type_name -> xdrconverter.v1.DecoratedSignature
+	177, // 347: xdrconverter.v1.TransactionV1Envelope.tx:type_name -> xdrconverter.v1.Transaction
+	64,  // 348: xdrconverter.v1.TransactionV1Envelope.signatures:type_name -> xdrconverter.v1.DecoratedSignature
+	82,  // 349: xdrconverter.v1.TransferEvent.amount:type_name -> xdrconverter.v1.Int128Parts
+	11,  // 350: xdrconverter.v1.TrustLineAsset.asset:type_name -> xdrconverter.v1.Asset
+	7,   // 351: xdrconverter.v1.TrustLineEntry.account_id:type_name -> xdrconverter.v1.AccountId
+	196, // 352: xdrconverter.v1.TrustLineEntry.asset:type_name -> xdrconverter.v1.TrustLineAsset
+	198, // 353: xdrconverter.v1.TrustLineEntry.ext:type_name -> xdrconverter.v1.TrustLineEntryExt
+	201, // 354: xdrconverter.v1.TrustLineEntryExt.v1:type_name -> xdrconverter.v1.TrustLineEntryV1
+	200, // 355: xdrconverter.v1.TrustLineEntryExtensionV2.ext:type_name -> xdrconverter.v1.TrustLineEntryExtensionV2Ext
+	106, // 356: xdrconverter.v1.TrustLineEntryV1.liabilities:type_name -> xdrconverter.v1.Liabilities
+	202, // 357: xdrconverter.v1.TrustLineEntryV1.ext:type_name -> xdrconverter.v1.TrustLineEntryV1Ext
+	199, // 358: xdrconverter.v1.TrustLineEntryV1Ext.v2:type_name -> xdrconverter.v1.TrustLineEntryExtensionV2
+	359, // [359:359] is the sub-list for method output_type
+	359, // [359:359] is the sub-list for method input_type
+	359, // [359:359] is the sub-list for extension type_name
+	359, // [359:359] is the sub-list for extension extendee
+	0,   // [0:359] is the sub-list for field type_name
+}
+
+func init() { file_xdrconverter_v1_types_proto_init() }
+func file_xdrconverter_v1_types_proto_init() {
+	if File_xdrconverter_v1_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xdrconverter_v1_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntryExtensionV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntryExtensionV1Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntryExtensionV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntryExtensionV2Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEntryExtensionV3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountMergeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*AllowTrustOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*AllowTrustResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Asset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BeginSponsoringFutureReservesOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*BeginSponsoringFutureReservesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*BumpSequenceOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*BumpSequenceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*BurnEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeTrustAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeTrustOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeTrustResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimAtom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimClaimableBalanceOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimClaimableBalanceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimLiquidityAtom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimOfferAtom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimOfferAtomV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimPredicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimableBalanceEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimableBalanceEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimableBalanceEntryExtensionV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimableBalanceEntryExtensionV1Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimableBalanceId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*Claimant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*ClaimantV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ClawbackClaimableBalanceOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ClawbackClaimableBalanceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ClawbackEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ClawbackOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ClawbackResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingContractBandwidthV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingContractComputeV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingContractEventsV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingContractExecutionLanesV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingContractHistoricalDataV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingContractLedgerCostV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSettingEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractCodeCostInputs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractCodeEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractCodeEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractCodeEntryV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractCostParamEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractDataEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractExecutable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractIdPreimage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractIdPreimageFromAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAccountOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAccountResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateClaimableBalanceOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateClaimableBalanceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateContractArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePassiveSellOfferOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*DataEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*DataEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*DecoratedSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*DiagnosticEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*EndSponsoringFutureReservesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*EvictionIterator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtendFootprintTtlOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtendFootprintTtlResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtensionPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*FeeBumpTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*FeeBumpTransactionEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*FeeBumpTransactionExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*FeeBumpTransactionInnerTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*HostFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*InflationPayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*InflationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*InnerTransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*InnerTransactionResultExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*InnerTransactionResultPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*InnerTransactionResultResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*Int128Parts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*Int256Parts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeContractArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeHostFunctionOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeHostFunctionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerBounds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntryChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntryExtensionV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntryExtensionV1Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerFootprint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyClaimableBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyConfigSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyContractCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyContractData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyLiquidityPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyOffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyTrustLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerKeyTtl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*Liabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolConstantProductParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolDepositOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolDepositResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolEntryBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolEntryConstantProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolWithdrawOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*LiquidityPoolWithdrawResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageBuyOfferOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageBuyOfferResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageDataOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageDataResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageOfferSuccessResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageOfferSuccessResultOffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageSellOfferOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*ManageSellOfferResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*Memo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*MintEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*MuxedAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationMetaV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationResultTr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*PathPaymentStrictReceiveOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*PathPaymentStrictReceiveResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*PathPaymentStrictReceiveResultSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*PathPaymentStrictSendOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*PathPaymentStrictSendResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*PathPaymentStrictSendResultSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*PaymentOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*PaymentResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*Preconditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*PreconditionsV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[145].Exporter = func(v any, i int) any {
+			switch v := v.(*Price); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[146].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreFootprintOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[147].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreFootprintResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeSponsorshipOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[149].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeSponsorshipOpSigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[150].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeSponsorshipResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[151].Exporter = func(v any, i int) any {
+			switch v := v.(*ScAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[152].Exporter = func(v any, i int) any {
+			switch v := v.(*ScContractInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[153].Exporter = func(v any, i int) any {
+			switch v := v.(*ScError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[154].Exporter = func(v any, i int) any {
+			switch v := v.(*ScMapEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[155].Exporter = func(v any, i int) any {
+			switch v := v.(*ScNonceKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[156].Exporter = func(v any, i int) any {
+			switch v := v.(*ScVal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[157].Exporter = func(v any, i int) any {
+			switch v := v.(*SetOptionsOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[158].Exporter = func(v any, i int) any {
+			switch v := v.(*SetOptionsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[159].Exporter = func(v any, i int) any {
+			switch v := v.(*SetTrustLineFlagsOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[160].Exporter = func(v any, i int) any {
+			switch v := v.(*SetTrustLineFlagsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[161].Exporter = func(v any, i int) any {
+			switch v := v.(*Signer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[162].Exporter = func(v any, i int) any {
+			switch v := v.(*SignerKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[163].Exporter = func(v any, i int) any {
+			switch v := v.(*SimplePaymentResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[164].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanAddressCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[165].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanAuthorizationEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[166].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanAuthorizedFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[167].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanAuthorizedInvocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[168].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[169].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[170].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanTransactionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[171].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanTransactionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[172].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanTransactionMetaExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[173].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanTransactionMetaExtV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[174].Exporter = func(v any, i int) any {
+			switch v := v.(*SorobanTransactionMetaV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[175].Exporter = func(v any, i int) any {
+			switch v := v.(*StateArchivalSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[176].Exporter = func(v any, i int) any {
+			switch v := v.(*TimeBounds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[177].Exporter = func(v any, i int) any {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[178].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[179].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[180].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[181].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[182].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionMetaV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[183].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionMetaV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[184].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionMetaV3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[185].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionMetaV4); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[186].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[187].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionResultExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[188].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionResultMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[189].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionResultPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[190].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionResultResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[191].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[192].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionV0Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[193].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionV0Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[194].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionV1Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[195].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[196].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[197].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[198].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineEntryExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[199].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineEntryExtensionV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[200].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineEntryExtensionV2Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[201].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineEntryV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[202].Exporter = func(v any, i int) any {
+			switch v := v.(*TrustLineEntryV1Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[203].Exporter = func(v any, i int) any {
+			switch v := v.(*TtlEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[204].Exporter = func(v any, i int) any {
+			switch v := v.(*UInt128Parts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xdrconverter_v1_types_proto_msgTypes[205].Exporter = func(v any, i int) any {
+			switch v := v.(*UInt256Parts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_xdrconverter_v1_types_proto_msgTypes[8].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[26].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[31].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[45].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[52].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[53].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[75].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[86].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[124].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[126].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[144].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[151].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[153].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[156].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[157].OneofWrappers = []any{}
+	file_xdrconverter_v1_types_proto_msgTypes[196].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xdrconverter_v1_types_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   206,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xdrconverter_v1_types_proto_goTypes,
+		DependencyIndexes: file_xdrconverter_v1_types_proto_depIdxs,
+		MessageInfos:      file_xdrconverter_v1_types_proto_msgTypes,
+	}.Build()
+	File_xdrconverter_v1_types_proto = out.File
+	file_xdrconverter_v1_types_proto_rawDesc = nil
+	file_xdrconverter_v1_types_proto_goTypes = nil
+	file_xdrconverter_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,22 @@
+// Package schema holds the JSON Schema (draft 2020-12) of the converter
+// output types and the OpenAPI document of the HTTP server, both generated
+// from converter/types.go by cmd/schemagen.
+//
+// go test ./cmd/schemagen, like the command below, fails when the committed
+// files are stale, e.g. after a types.go change without go generate:
+//
+//	go run ./cmd/schemagen -dir schema -check
+package schema
+
+//go:generate go run ../cmd/schemagen -dir .
+
+import _ "embed"
+
+// JSONSchema is xdr-converter.schema.json. Each decode type is defined in
+// $defs under the name of its converter type.
+//
+//go:embed xdr-converter.schema.json
+var JSONSchema []byte
+
+//go:embed openapi.json
+var OpenAPI []byte
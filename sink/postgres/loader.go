@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/flatten"
+	"github.com/jackc/pgx/v5"
+)
+
+// Loader writes batches of rows into the migrated tables. It implements
+// flatten.Writer.
+type Loader struct {
+	db    DB
+	close func() error
+}
+
+func NewLoader(db DB) *Loader {
+	return &Loader{db: db}
+}
+
+// columns are the columns of each table, in the order of the flatten row
+// fields.
+var columns = map[string][]string{
+	flatten.TableTransactions:        flatten.Columns(flatten.TransactionRow{}),
+	flatten.TableOperations:          flatten.Columns(flatten.OperationRow{}),
+	flatten.TableOperationResults:    flatten.Columns(flatten.OperationResultRow{}),
+	flatten.TableEffects:             flatten.Columns(flatten.EffectRow{}),
+	flatten.TableLedgerEntryChanges:  flatten.Columns(flatten.LedgerEntryChangeRow{}),
+	flatten.TableContractEvents:      flatten.Columns(flatten.ContractEventRow{}),
+	flatten.TableContractDataChanges: flatten.Columns(flatten.ContractDataChangeRow{}),
+}
+
+func (l *Loader) Write(rows *flatten.Rows) error {
+	return l.Load(context.Background(), rows)
+}
+
+// Load writes rows in one transaction, replacing what an earlier load of the
+// same transactions wrote.
+func (l *Loader) Load(ctx context.Context, rows *flatten.Rows) error {
+	if len(rows.Transactions) == 0 {
+		return nil
+	}
+
+	rows = dedupe(rows)
+
+	return inTx(ctx, l.db, func(tx Tx) error {
+		for _, table := range flatten.Tables {
+			sql := fmt.Sprintf("CREATE TEMP TABLE %s (LIKE %s) ON COMMIT DROP", stagingTable(table), table)
+			if _, err := tx.Exec(ctx, sql); err != nil {
+				return err
+			}
+		}
+
+		if err := copyRows(ctx, tx, flatten.TableTransactions, rows.Transactions); err != nil {
+			return err
+		}
+		if err := copyRows(ctx, tx, flatten.TableOperations, rows.Operations); err != nil {
+			return err
+		}
+		if err := copyRows(ctx, tx, flatten.TableOperationResults, rows.OperationResults); err != nil {
+			return err
+		}
+		if err := copyRows(ctx, tx, flatten.TableEffects, rows.Effects); err != nil {
+			return err
+		}
+		if err := copyRows(ctx, tx, flatten.TableLedgerEntryChanges, rows.LedgerEntryChanges); err != nil {
+			return err
+		}
+		if err := copyRows(ctx, tx, flatten.TableContractEvents, rows.ContractEvents); err != nil {
+			return err
+		}
+		if err := copyRows(ctx, tx, flatten.TableContractDataChanges, rows.ContractDataChanges); err != nil {
+			return err
+		}
+
+		for _, sql := range mergeStatements() {
+			if _, err := tx.Exec(ctx, sql); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// dedupe returns rows keeping only the last of the rows with the same key,
+// as when a batch holds a transaction twice. The upsert of the staged
+// transactions cannot update a row twice, and the other tables would break
+// their unique keys.
+func dedupe(rows *flatten.Rows) *flatten.Rows {
+	return &flatten.Rows{
+		Transactions: lastByKey(rows.Transactions, func(r flatten.TransactionRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.Hash)
+		}),
+		Operations: lastByKey(rows.Operations, func(r flatten.OperationRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.TransactionHash, r.OpIndex)
+		}),
+		OperationResults: lastByKey(rows.OperationResults, func(r flatten.OperationResultRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.TransactionHash, r.OpIndex)
+		}),
+		Effects: lastByKey(rows.Effects, func(r flatten.EffectRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.TransactionHash, r.OpIndex, r.EffectIndex)
+		}),
+		LedgerEntryChanges: lastByKey(rows.LedgerEntryChanges, func(r flatten.LedgerEntryChangeRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.TransactionHash, r.Stage, opIndex(r.OpIndex), r.ChangeIndex)
+		}),
+		ContractEvents: lastByKey(rows.ContractEvents, func(r flatten.ContractEventRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.TransactionHash, r.EventIndex)
+		}),
+		ContractDataChanges: lastByKey(rows.ContractDataChanges, func(r flatten.ContractDataChangeRow) string {
+			return fmt.Sprintln(r.LedgerSeq, r.TransactionHash, r.Stage, opIndex(r.OpIndex), r.ChangeIndex)
+		}),
+	}
+}
+
+// lastByKey keeps the last row of each key, in the order of those rows.
+func lastByKey[T any](rows []T, key func(T) string) []T {
+	last := make(map[string]int, len(rows))
+	for i, row := range rows {
+		last[key(row)] = i
+	}
+	if len(last) == len(rows) {
+		return rows
+	}
+
+	result := make([]T, 0, len(last))
+	for i, row := range rows {
+		if last[key(row)] == i {
+			result = append(result, row)
+		}
+	}
+
+	return result
+}
+
+// opIndex is the op_index of the unique indexes, -1 for NULL.
+func opIndex(i *int32) int32 {
+	if i == nil {
+		return -1
+	}
+
+	return *i
+}
+
+func (l *Loader) Close() error {
+	if l.close == nil {
+		return nil
+	}
+
+	return l.close()
+}
+
+func stagingTable(table string) string {
+	return "staging_" + table
+}
+
+func copyRows[T any](ctx context.Context, tx Tx, table string, rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	source := pgx.CopyFromSlice(len(rows), func(i int) ([]interface{}, error) {
+		return rowValues(rows[i]), nil
+	})
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{stagingTable(table)}, columns[table], source)
+
+	return err
+}
+
+// rowValues returns the fields of a row; nil pointers are written as NULL.
+func rowValues(row interface{}) []interface{} {
+	v := reflect.ValueOf(row)
+	values := make([]interface{}, v.NumField())
+	for i := range values {
+		values[i] = v.Field(i).Interface()
+	}
+
+	return values
+}
+
+// mergeStatements move the staged rows into the tables: the transactions
+// are upserted, and the rows of the other tables belonging to them are
+// deleted and inserted again.
+func mergeStatements() []string {
+	txColumns := columnList(flatten.TableTransactions)
+	var updates []string
+	for _, column := range columns[flatten.TableTransactions] {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%[1]s", pgx.Identifier{column}.Sanitize()))
+	}
+
+	statements := []string{fmt.Sprintf(
+		"INSERT INTO %s (%s) SELECT %[2]s FROM %s ON CONFLICT (ledger_seq, hash) DO UPDATE SET %s",
+		flatten.TableTransactions, txColumns, stagingTable(flatten.TableTransactions), strings.Join(updates, ", "),
+	)}
+
+	for _, table := range flatten.Tables {
+		if table == flatten.TableTransactions {
+			continue
+		}
+
+		statements = append(statements,
+			fmt.Sprintf(
+				"DELETE FROM %s t USING %s s WHERE t.ledger_seq = s.ledger_seq AND t.transaction_hash = s.hash",
+				table, stagingTable(flatten.TableTransactions),
+			),
+			fmt.Sprintf("INSERT INTO %s (%s) SELECT %[2]s FROM %s", table, columnList(table), stagingTable(table)),
+		)
+	}
+
+	return statements
+}
+
+func columnList(table string) string {
+	var quoted []string
+	for _, column := range columns[table] {
+		quoted = append(quoted, pgx.Identifier{column}.Sanitize())
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/flatten"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeDB records what a Loader or Migrate does in its last transaction.
+type fakeDB struct {
+	tx *fakeTx
+	// failOn fails the first statement containing it.
+	failOn string
+}
+
+func (d *fakeDB) Begin(ctx context.Context) (Tx, error) {
+	d.tx = &fakeTx{copied: map[string][][]interface{}{}, version: d.tx.currentVersion(), failOn: d.failOn}
+	return d.tx, nil
+}
+
+type fakeTx struct {
+	statements []string
+	copied     map[string][][]interface{}
+	failOn     string
+	version    int
+	committed  bool
+	rolledBack bool
+}
+
+func (t *fakeTx) currentVersion() int {
+	if t == nil {
+		return 0
+	}
+
+	return t.version
+}
+
+func (t *fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	t.statements = append(t.statements, sql)
+	if t.failOn != "" && strings.Contains(sql, t.failOn) {
+		return pgconn.CommandTag{}, errors.New("exec failed")
+	}
+
+	return pgconn.CommandTag{}, nil
+}
+
+func (t *fakeTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	t.statements = append(t.statements, sql)
+	return fakeRow{t.version}
+}
+
+func (t *fakeTx) CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, rows pgx.CopyFromSource) (int64, error) {
+	name := table.Sanitize()
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return 0, err
+		}
+		if len(values) != len(columns) {
+			return 0, errors.New("row does not match the columns")
+		}
+		t.copied[name] = append(t.copied[name], values)
+	}
+
+	return int64(len(t.copied[name])), rows.Err()
+}
+
+func (t *fakeTx) Commit(ctx context.Context) error {
+	t.committed = true
+	return nil
+}
+
+func (t *fakeTx) Rollback(ctx context.Context) error {
+	t.rolledBack = true
+	return nil
+}
+
+type fakeRow struct {
+	version int
+}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	*dest[0].(*int) = r.version
+	return nil
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// testRows holds one transaction with an operation and a contract data
+// change, as flatten.Rows.Add would.
+func testRows(hash string, memo string) flatten.Rows {
+	return flatten.Rows{
+		Transactions: []flatten.TransactionRow{{LedgerSeq: 10, Hash: hash, Memo: strPtr(memo)}},
+		Operations:   []flatten.OperationRow{{LedgerSeq: 10, TransactionHash: hash, Type: "PAYMENT"}},
+		ContractDataChanges: []flatten.ContractDataChangeRow{
+			{LedgerSeq: 10, TransactionHash: hash, Stage: "after", ChangeIndex: 0},
+		},
+	}
+}
+
+func appendRows(rows ...flatten.Rows) *flatten.Rows {
+	var result flatten.Rows
+	for _, r := range rows {
+		result.Transactions = append(result.Transactions, r.Transactions...)
+		result.Operations = append(result.Operations, r.Operations...)
+		result.ContractDataChanges = append(result.ContractDataChanges, r.ContractDataChanges...)
+	}
+
+	return &result
+}
+
+func TestLoad(t *testing.T) {
+	db := &fakeDB{}
+	rows := appendRows(testRows("aa", "first"), testRows("bb", "other"))
+	if err := NewLoader(db).Load(context.Background(), rows); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := db.tx
+	if !tx.committed || tx.rolledBack {
+		t.Errorf("got committed %v, rolled back %v", tx.committed, tx.rolledBack)
+	}
+	if got := len(tx.copied[`"staging_transactions"`]); got != 2 {
+		t.Errorf("got %d staged transactions, want 2", got)
+	}
+	if got := len(tx.copied[`"staging_operations"`]); got != 2 {
+		t.Errorf("got %d staged operations, want 2", got)
+	}
+	if _, ok := tx.copied[`"staging_effects"`]; ok {
+		t.Error("copied empty effects")
+	}
+
+	// Staging tables come first and the upsert of the transactions before
+	// the replacement of their rows.
+	var creates, upsert, deletes int
+	for i, sql := range tx.statements {
+		switch {
+		case strings.HasPrefix(sql, "CREATE TEMP TABLE"):
+			creates++
+			if upsert != 0 {
+				t.Errorf("staging table created after the merge: %s", sql)
+			}
+		case strings.Contains(sql, "ON CONFLICT (ledger_seq, hash) DO UPDATE"):
+			upsert = i
+		case strings.HasPrefix(sql, "DELETE FROM"):
+			deletes++
+			if upsert == 0 {
+				t.Errorf("rows deleted before the transactions upsert: %s", sql)
+			}
+		}
+	}
+	if creates != len(flatten.Tables) || deletes != len(flatten.Tables)-1 {
+		t.Errorf("got %d creates and %d deletes", creates, deletes)
+	}
+}
+
+func TestLoadDuplicateTransaction(t *testing.T) {
+	db := &fakeDB{}
+	// The same transaction twice in one batch, as when a range of ledgers
+	// is loaded again before the batch is flushed.
+	rows := appendRows(testRows("aa", "first"), testRows("bb", "other"), testRows("aa", "second"))
+	if err := NewLoader(db).Load(context.Background(), rows); err != nil {
+		t.Fatal(err)
+	}
+
+	staged := db.tx.copied[`"staging_transactions"`]
+	if len(staged) != 2 {
+		t.Fatalf("got %d staged transactions, want 2", len(staged))
+	}
+	hashIndex, memoIndex := -1, -1
+	for i, column := range columns[flatten.TableTransactions] {
+		switch column {
+		case "hash":
+			hashIndex = i
+		case "memo":
+			memoIndex = i
+		}
+	}
+	if got := staged[0][hashIndex]; got != "bb" {
+		t.Errorf("got hash %v first, want bb", got)
+	}
+	if got := staged[1][memoIndex].(*string); *got != "second" {
+		t.Errorf("got memo %q for aa, want the last one", *got)
+	}
+
+	for _, table := range []string{`"staging_operations"`, `"staging_contract_data_changes"`} {
+		if got := len(db.tx.copied[table]); got != 2 {
+			t.Errorf("got %d rows in %s, want 2", got, table)
+		}
+	}
+	if got := len(rows.Transactions); got != 3 {
+		t.Errorf("Load changed the rows it was given: %d transactions", got)
+	}
+}
+
+func TestLoadRollback(t *testing.T) {
+	db := &fakeDB{failOn: "INSERT INTO operations"}
+	if err := NewLoader(db).Load(context.Background(), appendRows(testRows("aa", "first"))); err == nil {
+		t.Fatal("got no error")
+	}
+	if tx := db.tx; tx.committed || !tx.rolledBack {
+		t.Errorf("got committed %v, rolled back %v", tx.committed, tx.rolledBack)
+	}
+
+	// Nothing to load opens no transaction.
+	db.tx = nil
+	if err := NewLoader(db).Load(context.Background(), &flatten.Rows{}); err != nil || db.tx != nil {
+		t.Errorf("got %v, transaction %v for an empty batch", err, db.tx)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) < 2 {
+		t.Fatalf("got %d migrations", len(migrations))
+	}
+	for i, m := range migrations {
+		if m.version != i+1 {
+			t.Errorf("migration %s has version %d, want %d", m.name, m.version, i+1)
+		}
+	}
+
+	for _, current := range []int{0, 1, len(migrations)} {
+		db := &fakeDB{tx: &fakeTx{version: current}}
+		if err := Migrate(context.Background(), db); err != nil {
+			t.Fatal(err)
+		}
+		if !db.tx.committed {
+			t.Errorf("version %d: not committed", current)
+		}
+
+		var applied int
+		for _, sql := range db.tx.statements {
+			if strings.HasPrefix(sql, "INSERT INTO schema_migrations") {
+				applied++
+			}
+		}
+		if want := len(migrations) - current; applied != want {
+			t.Errorf("version %d: applied %d migrations, want %d", current, applied, want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+-- Tables mirror the row types of the flatten package. Rows of a transaction
+-- are keyed by its ledger and hash, the unit the loader replaces.
+
+CREATE TABLE transactions (
+    id                   bigint  NOT NULL,
+    ledger_seq           bigint  NOT NULL,
+    close_time           bigint  NOT NULL,
+    application_order    integer NOT NULL,
+    hash                 text    NOT NULL,
+    envelope_type        text    NOT NULL,
+    source_account       text    NOT NULL,
+    source_account_muxed text,
+    fee_account          text,
+    inner_hash           text,
+    max_fee              bigint  NOT NULL,
+    fee_charged          bigint  NOT NULL,
+    seq_num              bigint  NOT NULL,
+    memo_type            text    NOT NULL,
+    memo                 text,
+    operation_count      integer NOT NULL,
+    successful           boolean NOT NULL,
+    result_code          integer NOT NULL,
+    result_code_name     text    NOT NULL,
+    resource_fee         bigint,
+    instructions         bigint,
+    read_bytes           bigint,
+    write_bytes          bigint,
+    PRIMARY KEY (ledger_seq, hash)
+);
+
+CREATE INDEX transactions_hash ON transactions (hash);
+CREATE INDEX transactions_source_account ON transactions (source_account);
+
+CREATE TABLE operations (
+    id                   bigint  NOT NULL,
+    transaction_id       bigint  NOT NULL,
+    ledger_seq           bigint  NOT NULL,
+    transaction_hash     text    NOT NULL,
+    op_index             integer NOT NULL,
+    type                 text    NOT NULL,
+    source_account       text    NOT NULL,
+    source_account_muxed text,
+    destination          text,
+    "from"               text,
+    asset                text,
+    amount               bigint,
+    source_asset         text,
+    source_amount        bigint,
+    selling_asset        text,
+    buying_asset         text,
+    price_n              integer,
+    price_d              integer,
+    offer_id             bigint,
+    liquidity_pool_id    text,
+    balance_id           text,
+    contract_id          text,
+    function_name        text,
+    body_json            jsonb   NOT NULL,
+    PRIMARY KEY (ledger_seq, transaction_hash, op_index)
+);
+
+CREATE INDEX operations_id ON operations (id);
+CREATE INDEX operations_source_account ON operations (source_account);
+CREATE INDEX operations_contract_id ON operations (contract_id) WHERE contract_id IS NOT NULL;
+
+CREATE TABLE operation_results (
+    operation_id     bigint  NOT NULL,
+    ledger_seq       bigint  NOT NULL,
+    transaction_hash text    NOT NULL,
+    op_index         integer NOT NULL,
+    type             text    NOT NULL,
+    code             integer NOT NULL,
+    code_name        text    NOT NULL,
+    result_code      integer,
+    result_code_name text,
+    successful       boolean NOT NULL,
+    result_json      jsonb,
+    PRIMARY KEY (ledger_seq, transaction_hash, op_index)
+);
+
+CREATE TABLE effects (
+    operation_id     bigint  NOT NULL,
+    ledger_seq       bigint  NOT NULL,
+    transaction_hash text    NOT NULL,
+    op_index         integer NOT NULL,
+    effect_index     integer NOT NULL,
+    type             text    NOT NULL,
+    account          text    NOT NULL,
+    asset            text,
+    amount           bigint,
+    PRIMARY KEY (ledger_seq, transaction_hash, op_index, effect_index)
+);
+
+CREATE INDEX effects_account ON effects (account);
+
+-- op_index is only set for the operation stage, so it cannot be part of a
+-- primary key.
+CREATE TABLE ledger_entry_changes (
+    ledger_seq               bigint  NOT NULL,
+    transaction_hash         text    NOT NULL,
+    stage                    text    NOT NULL,
+    op_index                 integer,
+    change_index             integer NOT NULL,
+    change_type              text    NOT NULL,
+    entry_type               text    NOT NULL,
+    last_modified_ledger_seq bigint,
+    key_json                 jsonb,
+    entry_json               jsonb
+);
+
+CREATE UNIQUE INDEX ledger_entry_changes_key
+    ON ledger_entry_changes (ledger_seq, transaction_hash, stage, coalesce(op_index, -1), change_index);
+
+-- amount is the decimal form of an i128, cast it to numeric to aggregate.
+CREATE TABLE contract_events (
+    ledger_seq       bigint  NOT NULL,
+    transaction_hash text    NOT NULL,
+    event_index      integer NOT NULL,
+    contract_id      text,
+    type             text    NOT NULL,
+    topic            text,
+    "from"           text,
+    "to"             text,
+    admin            text,
+    amount           text,
+    topics_json      jsonb   NOT NULL,
+    data_json        jsonb   NOT NULL,
+    PRIMARY KEY (ledger_seq, transaction_hash, event_index)
+);
+
+CREATE INDEX contract_events_contract_id ON contract_events (contract_id) WHERE contract_id IS NOT NULL;
+
+CREATE TABLE contract_data_changes (
+    ledger_seq       bigint  NOT NULL,
+    transaction_hash text    NOT NULL,
+    stage            text    NOT NULL,
+    op_index         integer,
+    change_index     integer NOT NULL,
+    change_type      text    NOT NULL,
+    contract_id      text    NOT NULL,
+    durability       text    NOT NULL,
+    key_json         jsonb   NOT NULL,
+    value_json       jsonb
+);
+
+CREATE UNIQUE INDEX contract_data_changes_key
+    ON contract_data_changes (ledger_seq, transaction_hash, stage, coalesce(op_index, -1), change_index);
+CREATE INDEX contract_data_changes_contract_id ON contract_data_changes (contract_id);
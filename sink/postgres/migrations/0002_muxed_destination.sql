@@ -0,0 +1,21 @@
+-- destination and "from" hold the account ID of a muxed account, like
+-- source_account, and its M address moves to the new _muxed columns. The
+-- account ID of rows already loaded is taken from the operation body.
+
+ALTER TABLE operations
+    ADD COLUMN destination_muxed text,
+    ADD COLUMN from_muxed        text;
+
+UPDATE operations
+SET destination_muxed = destination,
+    destination = jsonb_path_query_first(
+        body_json, '$.** ? (@.address == $m).account_id', jsonb_build_object('m', destination)
+    ) #>> '{}'
+WHERE destination LIKE 'M%';
+
+UPDATE operations
+SET from_muxed = "from",
+    "from" = jsonb_path_query_first(
+        body_json, '$.** ? (@.address == $m).account_id', jsonb_build_object('m', "from")
+    ) #>> '{}'
+WHERE "from" LIKE 'M%';
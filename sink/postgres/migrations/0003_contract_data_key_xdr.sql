@@ -0,0 +1,7 @@
+-- key_xdr is the base64 XDR of a contract data key, to look entries up
+-- independently of the converter settings key_json was written with. Rows
+-- loaded before have none until their ledgers are loaded again.
+
+ALTER TABLE contract_data_changes ADD COLUMN key_xdr text;
+
+CREATE INDEX contract_data_changes_key_xdr ON contract_data_changes (contract_id, key_xdr);
@@ -0,0 +1,85 @@
+// Package postgres loads the tables of the flatten package into Postgres.
+//
+// Migrate creates the schema, see migrations/. A Loader copies each batch of
+// rows into temporary staging tables with COPY and moves them into the
+// tables in one transaction: transactions are upserted on their ledger and
+// hash, and the rows belonging to them in the other tables are replaced, so
+// loading the same ledgers again is a no-op.
+//
+// The package only talks to the database through DB and Tx, which a
+// pgx.Conn or pgxpool.Pool satisfies through FromPgx and tests can fake.
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Tx is the part of pgx.Tx used by the package.
+type Tx interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, rows pgx.CopyFromSource) (int64, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+type DB interface {
+	Begin(ctx context.Context) (Tx, error)
+}
+
+// PgxBeginner is satisfied by *pgx.Conn and *pgxpool.Pool.
+type PgxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+func FromPgx(db PgxBeginner) DB {
+	return pgxDB{db: db}
+}
+
+type pgxDB struct {
+	db PgxBeginner
+}
+
+func (d pgxDB) Begin(ctx context.Context) (Tx, error) {
+	return d.db.Begin(ctx)
+}
+
+// Open connects to the database at dsn, migrates it and returns a Loader
+// that closes the connection on Close.
+func Open(ctx context.Context, dsn string) (*Loader, error) {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	db := FromPgx(conn)
+	if err := Migrate(ctx, db); err != nil {
+		conn.Close(ctx)
+		return nil, err
+	}
+
+	l := NewLoader(db)
+	l.close = func() error {
+		return conn.Close(context.Background())
+	}
+
+	return l, nil
+}
+
+// inTx runs fn in a transaction, committing if it succeeds.
+func inTx(ctx context.Context, db DB, fn func(Tx) error) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
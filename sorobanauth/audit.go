@@ -0,0 +1,304 @@
+// Package sorobanauth audits the authorization entries of Soroban
+// transactions: who signs them, for which calls and contract creations, and
+// whether they fit the host function the transaction actually calls.
+package sorobanauth
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	CredentialsSourceAccount = "source_account"
+	CredentialsAddress       = "address"
+)
+
+// Audit is the flat view of the auth entries of an InvokeHostFunction
+// operation.
+type Audit struct {
+	OpIndex int          `json:"op_index"`
+	Entries []EntryAudit `json:"entries"`
+}
+
+// EntryAudit is one auth entry. Signer is the authorizing address, which for
+// source account credentials is the operation's source account. Nonce and
+// SignatureExpirationLedger are only set for address credentials.
+//
+// Calls and ContractCreations list every node of the invocation tree in
+// depth-first order. Mismatches lists the ways the tree cannot fit the host
+// function of the operation, see Analyze.
+type EntryAudit struct {
+	Index                     int                  `json:"index"`
+	Signer                    string               `json:"signer"`
+	CredentialType            string               `json:"credential_type"`
+	Nonce                     *int64               `json:"nonce,omitempty"`
+	SignatureExpirationLedger *uint32              `json:"signature_expiration_ledger,omitempty"`
+	Calls                     []AuthorizedCall     `json:"calls,omitempty"`
+	ContractCreations         []AuthorizedCreation `json:"contract_creations,omitempty"`
+	Mismatches                []string             `json:"mismatches,omitempty"`
+}
+
+// AuthorizedCall is a contract call an auth entry authorizes. Path is the
+// invocations from the root of the tree down to and including this one, as
+// contract:function or create_contract:contract.
+type AuthorizedCall struct {
+	Path         []string          `json:"path"`
+	ContractId   string            `json:"contract_id"`
+	FunctionName string            `json:"function_name"`
+	Args         []converter.ScVal `json:"args,omitempty"`
+}
+
+// AuthorizedCreation is a contract creation an auth entry authorizes.
+type AuthorizedCreation struct {
+	Path           []string                     `json:"path"`
+	CreateContract converter.CreateContractArgs `json:"create_contract"`
+}
+
+// AnalyzeTransaction audits every InvokeHostFunction operation of a
+// transaction, or of the inner transaction of a fee bump, on the network
+// identified by passphrase.
+func AnalyzeTransaction(e xdr.TransactionEnvelope, passphrase string) ([]Audit, error) {
+	var result []Audit
+	for i, op := range e.Operations() {
+		if op.Body.Type != xdr.OperationTypeInvokeHostFunction {
+			continue
+		}
+
+		source := e.SourceAccount()
+		if op.SourceAccount != nil {
+			source = *op.SourceAccount
+		}
+
+		entries, err := Analyze(*op.Body.InvokeHostFunctionOp, source, passphrase)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error analyzing operation %d", i)
+		}
+		result = append(result, Audit{OpIndex: i, Entries: entries})
+	}
+
+	return result, nil
+}
+
+// Analyze audits the auth entries of an InvokeHostFunction operation with
+// the given source account. passphrase identifies the network, which the ids
+// of created contracts are derived on.
+//
+// An entry is flagged as mismatched when its tree cannot be authorized by
+// calling the host function:
+//   - uploading wasm needs no authorization at all;
+//   - a contract creation must be authorized by a root invocation creating
+//     the same contract;
+//   - for a contract call, an invocation of the called contract must be the
+//     root invocation with the same function and args, since contracts
+//     cannot be re-entered. Roots calling other contracts are authorized
+//     further down the call tree and cannot be checked without running it.
+func Analyze(op xdr.InvokeHostFunctionOp, source xdr.MuxedAccount, passphrase string) ([]EntryAudit, error) {
+	var result []EntryAudit
+	for i, entry := range op.Auth {
+		audit, err := analyzeEntry(i, entry, source, passphrase)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error analyzing auth entry %d", i)
+		}
+
+		audit.Mismatches, err = mismatches(op.HostFunction, entry.RootInvocation, passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, audit)
+	}
+
+	return result, nil
+}
+
+func analyzeEntry(index int, e xdr.SorobanAuthorizationEntry, source xdr.MuxedAccount, passphrase string) (EntryAudit, error) {
+	result := EntryAudit{Index: index}
+
+	switch e.Credentials.Type {
+	case xdr.SorobanCredentialsTypeSorobanCredentialsSourceAccount:
+		result.CredentialType = CredentialsSourceAccount
+		result.Signer = source.ToAccountId().Address()
+	case xdr.SorobanCredentialsTypeSorobanCredentialsAddress:
+		credentials := e.Credentials.Address
+		signer, err := credentials.Address.String()
+		if err != nil {
+			return result, err
+		}
+
+		nonce := int64(credentials.Nonce)
+		expiration := uint32(credentials.SignatureExpirationLedger)
+
+		result.CredentialType = CredentialsAddress
+		result.Signer = signer
+		result.Nonce = &nonce
+		result.SignatureExpirationLedger = &expiration
+	default:
+		return result, errors.Errorf("error invalid SorobanCredentials type %v", e.Credentials.Type)
+	}
+
+	err := walk(e.RootInvocation, nil, passphrase, func(path []string, f xdr.SorobanAuthorizedFunction) error {
+		switch f.Type {
+		case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn:
+			args, err := converter.ConvertInvokeContractArgs(*f.ContractFn)
+			if err != nil {
+				return err
+			}
+			contractId, err := f.ContractFn.ContractAddress.String()
+			if err != nil {
+				return err
+			}
+
+			result.Calls = append(result.Calls, AuthorizedCall{
+				Path:         path,
+				ContractId:   contractId,
+				FunctionName: string(args.FunctionName),
+				Args:         args.Args,
+			})
+		case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn:
+			args, err := converter.ConvertCreateContractArgsWithOptions(*f.CreateContractHostFn, converter.Options{NetworkPassphrase: passphrase})
+			if err != nil {
+				return err
+			}
+
+			result.ContractCreations = append(result.ContractCreations, AuthorizedCreation{
+				Path:           path,
+				CreateContract: args,
+			})
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// walk calls fn with every invocation of the tree rooted at i, parents
+// first, and the path to it.
+func walk(i xdr.SorobanAuthorizedInvocation, parent []string, passphrase string, fn func(path []string, f xdr.SorobanAuthorizedFunction) error) error {
+	label, err := functionLabel(i.Function, passphrase)
+	if err != nil {
+		return err
+	}
+
+	path := append(append([]string{}, parent...), label)
+	if err := fn(path, i.Function); err != nil {
+		return err
+	}
+
+	for _, sub := range i.SubInvocations {
+		if err := walk(sub, path, passphrase, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func functionLabel(f xdr.SorobanAuthorizedFunction, passphrase string) (string, error) {
+	switch f.Type {
+	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn:
+		contract, err := f.ContractFn.ContractAddress.String()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s:%s", contract, f.ContractFn.FunctionName), nil
+	case xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn:
+		contract, err := converter.ContractIdFromPreimage(f.CreateContractHostFn.ContractIdPreimage, passphrase)
+		if err != nil {
+			return "", err
+		}
+		return "create_contract:" + contract, nil
+	}
+
+	return "", errors.Errorf("error invalid SorobanAuthorizedFunction type %v", f.Type)
+}
+
+// mismatches returns why the tree rooted at root cannot be authorized by
+// calling fn, see Analyze.
+func mismatches(fn xdr.HostFunction, root xdr.SorobanAuthorizedInvocation, passphrase string) ([]string, error) {
+	var result []string
+
+	rootLabel, err := functionLabel(root.Function, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	switch fn.Type {
+	case xdr.HostFunctionTypeHostFunctionTypeUploadContractWasm:
+		result = append(result, "uploading contract wasm needs no authorization")
+	case xdr.HostFunctionTypeHostFunctionTypeCreateContract:
+		if root.Function.Type != xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn {
+			result = append(result, fmt.Sprintf("root invocation %s does not create the contract the host function creates", rootLabel))
+			break
+		}
+
+		equal, err := equalXdr(root.Function.CreateContractHostFn, fn.CreateContract)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			result = append(result, fmt.Sprintf("root invocation %s creates a contract with other args than the host function", rootLabel))
+		}
+	case xdr.HostFunctionTypeHostFunctionTypeInvokeContract:
+		called := fn.InvokeContract
+		calledLabel, err := functionLabel(xdr.SorobanAuthorizedFunction{
+			Type:       xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn,
+			ContractFn: called,
+		}, passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		err = walk(root, nil, passphrase, func(path []string, f xdr.SorobanAuthorizedFunction) error {
+			if f.Type != xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn ||
+				!f.ContractFn.ContractAddress.Equals(called.ContractAddress) {
+				return nil
+			}
+
+			label := path[len(path)-1]
+			if len(path) > 1 {
+				result = append(result, fmt.Sprintf("sub-invocation %s re-enters the called contract", label))
+				return nil
+			}
+			if f.ContractFn.FunctionName != called.FunctionName {
+				result = append(result, fmt.Sprintf("root invocation %s is not the called function %s", label, calledLabel))
+				return nil
+			}
+
+			equal, err := equalXdr(f.ContractFn, called)
+			if err != nil {
+				return err
+			}
+			if !equal {
+				result = append(result, fmt.Sprintf("root invocation %s has other args than the call", label))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("error invalid host function type %v", fn.Type)
+	}
+
+	return result, nil
+}
+
+func equalXdr(a, b encoding.BinaryMarshaler) (bool, error) {
+	abz, err := a.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	bbz, err := b.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(abz, bbz), nil
+}
@@ -0,0 +1,224 @@
+package sorobanauth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+)
+
+var (
+	contractA = xdr.ContractId{0xaa}
+	contractB = xdr.ContractId{0xbb}
+)
+
+func contractAddress(id xdr.ContractId) xdr.ScAddress {
+	return xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id}
+}
+
+func accountAddress(b byte) xdr.ScAddress {
+	id := xdr.AccountId{Type: xdr.PublicKeyTypePublicKeyTypeEd25519, Ed25519: &xdr.Uint256{b}}
+	return xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeAccount, AccountId: &id}
+}
+
+func u32(v uint32) xdr.ScVal {
+	u := xdr.Uint32(v)
+	return xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &u}
+}
+
+func invokeArgs(id xdr.ContractId, function string, args ...xdr.ScVal) xdr.InvokeContractArgs {
+	return xdr.InvokeContractArgs{ContractAddress: contractAddress(id), FunctionName: xdr.ScSymbol(function), Args: args}
+}
+
+func callInvocation(args xdr.InvokeContractArgs, subs ...xdr.SorobanAuthorizedInvocation) xdr.SorobanAuthorizedInvocation {
+	return xdr.SorobanAuthorizedInvocation{
+		Function: xdr.SorobanAuthorizedFunction{
+			Type:       xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeContractFn,
+			ContractFn: &args,
+		},
+		SubInvocations: subs,
+	}
+}
+
+func createArgs(salt byte) xdr.CreateContractArgs {
+	return xdr.CreateContractArgs{
+		ContractIdPreimage: xdr.ContractIdPreimage{
+			Type: xdr.ContractIdPreimageTypeContractIdPreimageFromAddress,
+			FromAddress: &xdr.ContractIdPreimageFromAddress{
+				Address: accountAddress(1),
+				Salt:    xdr.Uint256{salt},
+			},
+		},
+		Executable: xdr.ContractExecutable{Type: xdr.ContractExecutableTypeContractExecutableWasm, WasmHash: &xdr.Hash{2}},
+	}
+}
+
+func createInvocation(args xdr.CreateContractArgs) xdr.SorobanAuthorizedInvocation {
+	return xdr.SorobanAuthorizedInvocation{Function: xdr.SorobanAuthorizedFunction{
+		Type:                 xdr.SorobanAuthorizedFunctionTypeSorobanAuthorizedFunctionTypeCreateContractHostFn,
+		CreateContractHostFn: &args,
+	}}
+}
+
+func sourceEntry(root xdr.SorobanAuthorizedInvocation) xdr.SorobanAuthorizationEntry {
+	return xdr.SorobanAuthorizationEntry{
+		Credentials:    xdr.SorobanCredentials{Type: xdr.SorobanCredentialsTypeSorobanCredentialsSourceAccount},
+		RootInvocation: root,
+	}
+}
+
+func addressEntry(signer xdr.ScAddress, root xdr.SorobanAuthorizedInvocation) xdr.SorobanAuthorizationEntry {
+	return xdr.SorobanAuthorizationEntry{
+		Credentials: xdr.SorobanCredentials{
+			Type: xdr.SorobanCredentialsTypeSorobanCredentialsAddress,
+			Address: &xdr.SorobanAddressCredentials{
+				Address:                   signer,
+				Nonce:                     42,
+				SignatureExpirationLedger: 1000,
+				Signature:                 xdr.ScVal{Type: xdr.ScValTypeScvVoid},
+			},
+		},
+		RootInvocation: root,
+	}
+}
+
+func invokeContract(args xdr.InvokeContractArgs) xdr.HostFunction {
+	return xdr.HostFunction{Type: xdr.HostFunctionTypeHostFunctionTypeInvokeContract, InvokeContract: &args}
+}
+
+func testSource() xdr.MuxedAccount {
+	return xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &xdr.Uint256{7}}
+}
+
+func analyze(t *testing.T, fn xdr.HostFunction, entries ...xdr.SorobanAuthorizationEntry) []EntryAudit {
+	t.Helper()
+
+	audits, err := Analyze(xdr.InvokeHostFunctionOp{HostFunction: fn, Auth: entries}, testSource(), network.TestNetworkPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(audits) != len(entries) {
+		t.Fatalf("got %d audits of %d entries", len(audits), len(entries))
+	}
+
+	return audits
+}
+
+func expectMismatch(t *testing.T, audit EntryAudit, want string) {
+	t.Helper()
+
+	if want == "" {
+		if len(audit.Mismatches) != 0 {
+			t.Errorf("entry %d: got mismatches %q", audit.Index, audit.Mismatches)
+		}
+		return
+	}
+	if len(audit.Mismatches) != 1 || !strings.Contains(audit.Mismatches[0], want) {
+		t.Errorf("entry %d: got mismatches %q, want one containing %q", audit.Index, audit.Mismatches, want)
+	}
+}
+
+func TestAnalyzeInvokeContract(t *testing.T) {
+	swap := invokeArgs(contractA, "swap", u32(1))
+	transfer := invokeArgs(contractB, "transfer", u32(5))
+
+	audits := analyze(t, invokeContract(swap),
+		// The call itself, authorizing the transfer it makes.
+		addressEntry(accountAddress(1), callInvocation(swap, callInvocation(transfer))),
+		// A contract called further down the tree cannot be checked.
+		addressEntry(accountAddress(2), callInvocation(transfer)),
+		sourceEntry(callInvocation(invokeArgs(contractA, "deposit", u32(1)))),
+		sourceEntry(callInvocation(invokeArgs(contractA, "swap", u32(2)))),
+		sourceEntry(callInvocation(transfer, callInvocation(swap))),
+	)
+
+	expectMismatch(t, audits[0], "")
+	expectMismatch(t, audits[1], "")
+	expectMismatch(t, audits[2], "is not the called function")
+	expectMismatch(t, audits[3], "has other args than the call")
+	expectMismatch(t, audits[4], "re-enters the called contract")
+
+	first := audits[0]
+	signer, err := accountAddress(1).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.CredentialType != CredentialsAddress || first.Signer != signer {
+		t.Errorf("got credentials %s of %s", first.CredentialType, first.Signer)
+	}
+	if first.Nonce == nil || *first.Nonce != 42 || first.SignatureExpirationLedger == nil || *first.SignatureExpirationLedger != 1000 {
+		t.Errorf("got nonce %v, expiration %v", first.Nonce, first.SignatureExpirationLedger)
+	}
+	if len(first.Calls) != 2 || len(first.Calls[1].Path) != 2 || first.Calls[1].FunctionName != "transfer" {
+		t.Errorf("got calls %+v", first.Calls)
+	}
+
+	source := testSource()
+	if got := audits[2]; got.CredentialType != CredentialsSourceAccount || got.Signer != source.Address() || got.Nonce != nil {
+		t.Errorf("got source account credentials %+v", got)
+	}
+}
+
+func TestAnalyzeCreateContract(t *testing.T) {
+	args := createArgs(1)
+	fn := xdr.HostFunction{Type: xdr.HostFunctionTypeHostFunctionTypeCreateContract, CreateContract: &args}
+
+	audits := analyze(t, fn,
+		sourceEntry(createInvocation(args)),
+		sourceEntry(createInvocation(createArgs(2))),
+		sourceEntry(callInvocation(invokeArgs(contractA, "init"))),
+	)
+
+	expectMismatch(t, audits[0], "")
+	expectMismatch(t, audits[1], "creates a contract with other args")
+	expectMismatch(t, audits[2], "does not create the contract")
+
+	creations := audits[0].ContractCreations
+	if len(creations) != 1 || len(creations[0].Path) != 1 || !strings.HasPrefix(creations[0].Path[0], "create_contract:C") {
+		t.Errorf("got contract creations %+v", creations)
+	}
+}
+
+func TestAnalyzeUploadWasm(t *testing.T) {
+	wasm := []byte{0, 'a', 's', 'm'}
+	fn := xdr.HostFunction{Type: xdr.HostFunctionTypeHostFunctionTypeUploadContractWasm, Wasm: &wasm}
+
+	audits := analyze(t, fn, sourceEntry(callInvocation(invokeArgs(contractA, "swap"))))
+	expectMismatch(t, audits[0], "needs no authorization")
+}
+
+func TestAnalyzeTransaction(t *testing.T) {
+	swap := invokeArgs(contractA, "swap")
+	opSource := xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &xdr.Uint256{9}}
+	envelope := xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1: &xdr.TransactionV1Envelope{Tx: xdr.Transaction{
+			SourceAccount: testSource(),
+			Operations: []xdr.Operation{
+				{Body: xdr.OperationBody{Type: xdr.OperationTypeBumpSequence, BumpSequenceOp: &xdr.BumpSequenceOp{}}},
+				{
+					SourceAccount: &opSource,
+					Body: xdr.OperationBody{
+						Type: xdr.OperationTypeInvokeHostFunction,
+						InvokeHostFunctionOp: &xdr.InvokeHostFunctionOp{
+							HostFunction: invokeContract(swap),
+							Auth:         []xdr.SorobanAuthorizationEntry{sourceEntry(callInvocation(swap))},
+						},
+					},
+				},
+			},
+		}},
+	}
+
+	audits, err := AnalyzeTransaction(envelope, network.TestNetworkPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(audits) != 1 || audits[0].OpIndex != 1 || len(audits[0].Entries) != 1 {
+		t.Fatalf("got %+v", audits)
+	}
+	if signer := audits[0].Entries[0].Signer; signer != opSource.Address() {
+		t.Errorf("got signer %s, want the operation source %s", signer, opSource.Address())
+	}
+}
@@ -0,0 +1,294 @@
+package sorobanauth
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+
+	"github.com/decentrio/xdr-converter/converter"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+// maxAccountSignatures is the most signatures the host accepts for an
+// account's address credentials.
+const maxAccountSignatures = 20
+
+// Verification is the result of checking the signature of an auth entry's
+// address credentials.
+//
+// Verified is true when the signature is well formed, has at least one
+// signature and every signature is valid for the payload. The weight fields
+// are only set when the signer's account is known; MeetsThreshold then says
+// whether the host would accept the signature: every key must be a signer of
+// the account and their weights must add up to its medium threshold.
+type Verification struct {
+	Index          int              `json:"index"`
+	Signer         string           `json:"signer"`
+	PayloadHash    string           `json:"payload_hash"`
+	Signatures     []SignatureCheck `json:"signatures,omitempty"`
+	Verified       bool             `json:"verified"`
+	Weight         *uint32          `json:"weight,omitempty"`
+	Threshold      *uint32          `json:"threshold,omitempty"`
+	MeetsThreshold *bool            `json:"meets_threshold,omitempty"`
+	// Error is why the signature could not be checked at all.
+	Error string `json:"error,omitempty"`
+}
+
+// SignatureCheck is one signature of an address credential. Weight is the
+// key's weight as a signer of the account, when the account is known.
+type SignatureCheck struct {
+	PublicKey string  `json:"public_key"`
+	Verified  bool    `json:"verified"`
+	Weight    *uint32 `json:"weight,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// OperationVerification is the verification of the auth entries of an
+// InvokeHostFunction operation.
+type OperationVerification struct {
+	OpIndex int            `json:"op_index"`
+	Entries []Verification `json:"entries"`
+}
+
+// VerifyTransaction verifies the auth entries of every InvokeHostFunction
+// operation of a transaction, see Verify.
+func VerifyTransaction(e xdr.TransactionEnvelope, passphrase string, accounts map[string]xdr.AccountEntry) ([]OperationVerification, error) {
+	var result []OperationVerification
+	for i, op := range e.Operations() {
+		if op.Body.Type != xdr.OperationTypeInvokeHostFunction {
+			continue
+		}
+
+		entries, err := Verify(*op.Body.InvokeHostFunctionOp, passphrase, accounts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error verifying operation %d", i)
+		}
+		result = append(result, OperationVerification{OpIndex: i, Entries: entries})
+	}
+
+	return result, nil
+}
+
+// Verify checks the signatures of the auth entries with address credentials
+// on the network identified by passphrase. Entries with source account
+// credentials are left out, they are authorized by the transaction's own
+// signatures. accounts, keyed by G address, supplies the signers and
+// thresholds of the accounts signing; it may be nil.
+func Verify(op xdr.InvokeHostFunctionOp, passphrase string, accounts map[string]xdr.AccountEntry) ([]Verification, error) {
+	var result []Verification
+	for i, entry := range op.Auth {
+		if entry.Credentials.Type != xdr.SorobanCredentialsTypeSorobanCredentialsAddress {
+			continue
+		}
+
+		var account *xdr.AccountEntry
+		if address := entry.Credentials.Address.Address; address.Type == xdr.ScAddressTypeScAddressTypeAccount {
+			if a, ok := accounts[address.AccountId.Address()]; ok {
+				account = &a
+			}
+		}
+
+		verification, err := VerifyEntry(entry, passphrase, account)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error verifying auth entry %d", i)
+		}
+		verification.Index = i
+
+		result = append(result, verification)
+	}
+
+	return result, nil
+}
+
+// VerifyEntry checks the signature of an auth entry with address
+// credentials, and the signing weight against account when it is not nil.
+// Contract signers are authenticated by their own __check_auth and are
+// reported with an Error.
+func VerifyEntry(e xdr.SorobanAuthorizationEntry, passphrase string, account *xdr.AccountEntry) (Verification, error) {
+	var result Verification
+
+	if e.Credentials.Type != xdr.SorobanCredentialsTypeSorobanCredentialsAddress {
+		return result, errors.Errorf("error auth entry has %v credentials, expected address", e.Credentials.Type)
+	}
+	credentials := e.Credentials.Address
+
+	signer, err := credentials.Address.String()
+	if err != nil {
+		return result, err
+	}
+	result.Signer = signer
+
+	payload, err := AuthorizationPayload(e, passphrase)
+	if err != nil {
+		return result, err
+	}
+	result.PayloadHash = payload.HexString()
+
+	if credentials.Address.Type != xdr.ScAddressTypeScAddressTypeAccount {
+		result.Error = "contract signers are authenticated by their __check_auth"
+		return result, nil
+	}
+
+	signatures, err := accountSignatures(credentials.Signature)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.Verified = len(signatures) > 0
+	var weight uint32
+	var prev []byte
+	for _, sig := range signatures {
+		check := SignatureCheck{Verified: ed25519.Verify(sig.publicKey, payload[:], sig.signature)}
+
+		check.PublicKey, err = strkey.Encode(strkey.VersionByteAccountID, sig.publicKey)
+		if err != nil {
+			return result, err
+		}
+
+		switch {
+		case !check.Verified:
+			check.Error = "invalid signature"
+		case prev != nil && bytes.Compare(prev, sig.publicKey) >= 0:
+			// The host rejects keys out of order, which also rules out
+			// counting a key twice.
+			check.Verified = false
+			check.Error = "public keys are not in increasing order"
+		}
+		prev = sig.publicKey
+		result.Verified = result.Verified && check.Verified
+
+		if account != nil {
+			w := signerWeight(*account, sig.publicKey)
+			check.Weight = &w
+			if w == 0 && check.Error == "" {
+				check.Error = "not a signer of the account"
+			}
+			if check.Verified {
+				weight += w
+			}
+		}
+
+		result.Signatures = append(result.Signatures, check)
+	}
+
+	if account != nil {
+		threshold := uint32(account.Thresholds.ThresholdMedium())
+		meets := result.Verified && weight >= threshold
+		for _, check := range result.Signatures {
+			meets = meets && *check.Weight > 0
+		}
+
+		result.Weight = &weight
+		result.Threshold = &threshold
+		result.MeetsThreshold = &meets
+	}
+
+	return result, nil
+}
+
+// AuthorizationPayload returns the hash an auth entry's address credentials
+// sign: the HashIdPreimageSorobanAuthorization of the entry's nonce,
+// signature expiration ledger and root invocation on the network. An empty
+// passphrase means converter.DefaultNetworkPassphrase.
+func AuthorizationPayload(e xdr.SorobanAuthorizationEntry, passphrase string) (xdr.Hash, error) {
+	if e.Credentials.Type != xdr.SorobanCredentialsTypeSorobanCredentialsAddress {
+		return xdr.Hash{}, errors.Errorf("error auth entry has %v credentials, expected address", e.Credentials.Type)
+	}
+
+	preimage := xdr.HashIdPreimage{
+		Type: xdr.EnvelopeTypeEnvelopeTypeSorobanAuthorization,
+		SorobanAuthorization: &xdr.HashIdPreimageSorobanAuthorization{
+			NetworkId:                 sha256.Sum256([]byte(converter.PassphraseOrDefault(passphrase))),
+			Nonce:                     e.Credentials.Address.Nonce,
+			SignatureExpirationLedger: e.Credentials.Address.SignatureExpirationLedger,
+			Invocation:                e.RootInvocation,
+		},
+	}
+
+	bz, err := preimage.MarshalBinary()
+	if err != nil {
+		return xdr.Hash{}, err
+	}
+
+	return sha256.Sum256(bz), nil
+}
+
+type accountSignature struct {
+	publicKey ed25519.PublicKey
+	signature []byte
+}
+
+// accountSignatures parses the signature of account credentials, a vec of
+// {public_key: BytesN<32>, signature: BytesN<64>} maps.
+func accountSignatures(v xdr.ScVal) ([]accountSignature, error) {
+	vec, ok := v.GetVec()
+	if !ok || vec == nil {
+		return nil, errors.Errorf("error signature is %v, expected a vec of signatures", v.Type)
+	}
+	if len(*vec) > maxAccountSignatures {
+		return nil, errors.Errorf("error %d signatures, at most %d are allowed", len(*vec), maxAccountSignatures)
+	}
+
+	var result []accountSignature
+	for i, item := range *vec {
+		m, ok := item.GetMap()
+		if !ok || m == nil || len(*m) != 2 {
+			return nil, errors.Errorf("error signature %d is not a map of public_key and signature", i)
+		}
+
+		var sig accountSignature
+		for _, entry := range *m {
+			key, ok := entry.Key.GetSym()
+			if !ok {
+				return nil, errors.Errorf("error signature %d has a %v key, expected a symbol", i, entry.Key.Type)
+			}
+			value, ok := entry.Val.GetBytes()
+			if !ok {
+				return nil, errors.Errorf("error signature %d %s is %v, expected bytes", i, key, entry.Val.Type)
+			}
+
+			switch key {
+			case "public_key":
+				if len(value) != ed25519.PublicKeySize {
+					return nil, errors.Errorf("error signature %d public_key is %d bytes, expected %d", i, len(value), ed25519.PublicKeySize)
+				}
+				sig.publicKey = ed25519.PublicKey(value)
+			case "signature":
+				if len(value) != ed25519.SignatureSize {
+					return nil, errors.Errorf("error signature %d signature is %d bytes, expected %d", i, len(value), ed25519.SignatureSize)
+				}
+				sig.signature = []byte(value)
+			default:
+				return nil, errors.Errorf("error signature %d has unexpected field %s", i, key)
+			}
+		}
+		if sig.publicKey == nil || sig.signature == nil {
+			return nil, errors.Errorf("error signature %d is not a map of public_key and signature", i)
+		}
+
+		result = append(result, sig)
+	}
+
+	return result, nil
+}
+
+// signerWeight returns the weight of a key as a signer of an account: the
+// master weight for the account's own key, its signer weight for an ed25519
+// signer, and 0 otherwise.
+func signerWeight(account xdr.AccountEntry, publicKey ed25519.PublicKey) uint32 {
+	key := xdr.Uint256(publicKey)
+	if *account.AccountId.Ed25519 == key {
+		return uint32(account.MasterKeyWeight())
+	}
+
+	for _, s := range account.Signers {
+		if s.Key.Type == xdr.SignerKeyTypeSignerKeyTypeEd25519 && *s.Key.Ed25519 == key {
+			return uint32(s.Weight)
+		}
+	}
+
+	return 0
+}
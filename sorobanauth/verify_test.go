@@ -0,0 +1,238 @@
+package sorobanauth
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+)
+
+type testKey struct {
+	public  ed25519.PublicKey
+	private ed25519.PrivateKey
+}
+
+func newTestKey(seed byte) testKey {
+	private := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+	return testKey{public: private.Public().(ed25519.PublicKey), private: private}
+}
+
+func (k testKey) address() xdr.ScAddress {
+	id := xdr.AccountId{Type: xdr.PublicKeyTypePublicKeyTypeEd25519, Ed25519: (*xdr.Uint256)(k.public)}
+	return xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeAccount, AccountId: &id}
+}
+
+// signature is the host's account signature map of k over payload.
+func (k testKey) signature(payload xdr.Hash) xdr.ScVal {
+	return signatureVal(k.public, ed25519.Sign(k.private, payload[:]))
+}
+
+func signatureVal(publicKey, signature []byte) xdr.ScVal {
+	sym := func(s string) xdr.ScVal {
+		v := xdr.ScSymbol(s)
+		return xdr.ScVal{Type: xdr.ScValTypeScvSymbol, Sym: &v}
+	}
+	bz := func(b []byte) xdr.ScVal {
+		v := xdr.ScBytes(b)
+		return xdr.ScVal{Type: xdr.ScValTypeScvBytes, Bytes: &v}
+	}
+
+	m := &xdr.ScMap{
+		{Key: sym("public_key"), Val: bz(publicKey)},
+		{Key: sym("signature"), Val: bz(signature)},
+	}
+	return xdr.ScVal{Type: xdr.ScValTypeScvMap, Map: &m}
+}
+
+func signatures(sigs ...xdr.ScVal) xdr.ScVal {
+	v := xdr.ScVec(sigs)
+	p := &v
+	return xdr.ScVal{Type: xdr.ScValTypeScvVec, Vec: &p}
+}
+
+// signedEntry returns an auth entry of signer, signed by keys on passphrase.
+func signedEntry(t *testing.T, signer xdr.ScAddress, passphrase string, keys ...testKey) xdr.SorobanAuthorizationEntry {
+	t.Helper()
+
+	e := addressEntry(signer, callInvocation(invokeArgs(contractA, "swap", u32(1))))
+	payload, err := AuthorizationPayload(e, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sigs []xdr.ScVal
+	for _, k := range keys {
+		sigs = append(sigs, k.signature(payload))
+	}
+	e.Credentials.Address.Signature = signatures(sigs...)
+
+	return e
+}
+
+// testAccount is the account of master with a master weight of 1, other
+// as a signer of weight 2 and the given medium threshold.
+func testAccount(master, other testKey, medium byte) xdr.AccountEntry {
+	return xdr.AccountEntry{
+		AccountId:  *master.address().AccountId,
+		Thresholds: xdr.Thresholds{1, 0, medium, 0},
+		Signers: []xdr.Signer{{
+			Key:    xdr.SignerKey{Type: xdr.SignerKeyTypeSignerKeyTypeEd25519, Ed25519: (*xdr.Uint256)(other.public)},
+			Weight: 2,
+		}},
+	}
+}
+
+// orderedKeys returns two keys in increasing public key order.
+func orderedKeys() (testKey, testKey) {
+	a, b := newTestKey(1), newTestKey(2)
+	if bytes.Compare(a.public, b.public) > 0 {
+		a, b = b, a
+	}
+
+	return a, b
+}
+
+func TestVerifyEntrySignatures(t *testing.T) {
+	master, other := orderedKeys()
+	passphrase := network.TestNetworkPassphrase
+
+	v, err := VerifyEntry(signedEntry(t, master.address(), passphrase, master), passphrase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Verified || len(v.Signatures) != 1 || !v.Signatures[0].Verified || v.Weight != nil || v.MeetsThreshold != nil {
+		t.Errorf("got %+v for a valid signature without the account", v)
+	}
+	if signer, _ := master.address().String(); v.Signer != signer || v.PayloadHash == "" {
+		t.Errorf("got signer %s, payload %s", v.Signer, v.PayloadHash)
+	}
+
+	// Signed on another network.
+	v, err = VerifyEntry(signedEntry(t, master.address(), network.PublicNetworkPassphrase, master), passphrase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Verified || v.Signatures[0].Error != "invalid signature" {
+		t.Errorf("got %+v for a signature of another network", v)
+	}
+
+	v, err = VerifyEntry(signedEntry(t, master.address(), passphrase, other, master), passphrase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Verified || !strings.Contains(v.Signatures[1].Error, "increasing order") {
+		t.Errorf("got %+v for keys out of order", v)
+	}
+
+	v, err = VerifyEntry(signedEntry(t, master.address(), passphrase), passphrase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Verified {
+		t.Error("verified an entry without signatures")
+	}
+}
+
+func TestVerifyEntryThreshold(t *testing.T) {
+	master, other := orderedKeys()
+	stranger := newTestKey(3)
+	passphrase := network.TestNetworkPassphrase
+
+	for _, c := range []struct {
+		name   string
+		keys   []testKey
+		medium byte
+		weight uint32
+		meets  bool
+	}{
+		{"master meets", []testKey{master}, 1, 1, true},
+		{"master below", []testKey{master}, 3, 1, false},
+		{"signer meets", []testKey{other}, 2, 2, true},
+		{"both add up", []testKey{master, other}, 3, 3, true},
+		{"stranger", []testKey{stranger}, 0, 0, false},
+	} {
+		account := testAccount(master, other, c.medium)
+		v, err := VerifyEntry(signedEntry(t, master.address(), passphrase, c.keys...), passphrase, &account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !v.Verified || v.Weight == nil || *v.Weight != c.weight || *v.Threshold != uint32(c.medium) || *v.MeetsThreshold != c.meets {
+			t.Errorf("%s: got verified %v, weight %v of %v, meets %v", c.name, v.Verified, v.Weight, v.Threshold, v.MeetsThreshold)
+		}
+	}
+
+	account := testAccount(master, other, 0)
+	v, err := VerifyEntry(signedEntry(t, master.address(), passphrase, stranger), passphrase, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if check := v.Signatures[0]; check.Weight == nil || *check.Weight != 0 || check.Error != "not a signer of the account" {
+		t.Errorf("got %+v for a key that does not sign the account", check)
+	}
+}
+
+func TestVerifyEntryMalformed(t *testing.T) {
+	key := newTestKey(1)
+	passphrase := network.TestNetworkPassphrase
+
+	contract := signedEntry(t, contractAddress(contractA), passphrase)
+	v, err := VerifyEntry(contract, passphrase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Verified || !strings.Contains(v.Error, "__check_auth") {
+		t.Errorf("got %+v for a contract signer", v)
+	}
+
+	for name, sig := range map[string]xdr.ScVal{
+		"void":      {Type: xdr.ScValTypeScvVoid},
+		"short key": signatures(signatureVal(key.public[:31], make([]byte, 64))),
+		"short sig": signatures(signatureVal(key.public, make([]byte, 63))),
+		"not a map": signatures(u32(1)),
+		"too many":  signatures(make([]xdr.ScVal, maxAccountSignatures+1)...),
+	} {
+		e := signedEntry(t, key.address(), passphrase)
+		e.Credentials.Address.Signature = sig
+		v, err := VerifyEntry(e, passphrase, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Verified || v.Error == "" {
+			t.Errorf("%s: got %+v", name, v)
+		}
+	}
+
+	if _, err := VerifyEntry(sourceEntry(callInvocation(invokeArgs(contractA, "swap"))), passphrase, nil); err == nil {
+		t.Error("got no error for source account credentials")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	master, other := orderedKeys()
+	passphrase := network.TestNetworkPassphrase
+	account := testAccount(master, other, 1)
+
+	op := xdr.InvokeHostFunctionOp{Auth: []xdr.SorobanAuthorizationEntry{
+		sourceEntry(callInvocation(invokeArgs(contractA, "swap"))),
+		signedEntry(t, master.address(), passphrase, master),
+		signedEntry(t, other.address(), passphrase, other),
+	}}
+	accounts := map[string]xdr.AccountEntry{account.AccountId.Address(): account}
+
+	result, err := Verify(op, passphrase, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || result[0].Index != 1 || result[1].Index != 2 {
+		t.Fatalf("got %+v, want entries 1 and 2", result)
+	}
+	if result[0].MeetsThreshold == nil || !*result[0].MeetsThreshold {
+		t.Errorf("got %+v for the known account", result[0])
+	}
+	if !result[1].Verified || result[1].MeetsThreshold != nil {
+		t.Errorf("got %+v for an unknown account", result[1])
+	}
+}
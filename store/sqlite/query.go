@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/flatten"
+	"github.com/pkg/errors"
+	"github.com/stellar/go/xdr"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// The queries below return rows in ledger order. A limit of 0 returns all
+// of them.
+
+// TransactionByHash returns the transaction with the given hash, or the fee
+// bump transaction wrapping it.
+func (s *Store) TransactionByHash(ctx context.Context, hash string) (flatten.TransactionRow, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT %s FROM transactions WHERE hash = ? OR inner_hash = ? ORDER BY ledger_seq LIMIT 1",
+		columnList(flatten.TableTransactions),
+	), hash, hash)
+	if err != nil {
+		return flatten.TransactionRow{}, err
+	}
+
+	result, err := scanRows[flatten.TransactionRow](rows)
+	if err != nil {
+		return flatten.TransactionRow{}, err
+	}
+	if len(result) == 0 {
+		return flatten.TransactionRow{}, ErrNotFound
+	}
+
+	return result[0], nil
+}
+
+// OperationsByTransaction returns the operations of a transaction in order.
+func (s *Store) OperationsByTransaction(ctx context.Context, ledgerSeq int64, hash string) ([]flatten.OperationRow, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT %s FROM operations WHERE ledger_seq = ? AND transaction_hash = ? ORDER BY op_index",
+		columnList(flatten.TableOperations),
+	), ledgerSeq, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows[flatten.OperationRow](rows)
+}
+
+// OperationsByAccount returns the operations with account as their source,
+// destination or from account. A G address also matches the operations of
+// the muxed accounts on it; an M address only those of that muxed account.
+func (s *Store) OperationsByAccount(ctx context.Context, account string, limit int) ([]flatten.OperationRow, error) {
+	where := `source_account = ? OR destination = ? OR "from" = ?`
+	if strings.HasPrefix(account, "M") {
+		where = `source_account_muxed = ? OR destination_muxed = ? OR from_muxed = ?`
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(
+		`SELECT %s FROM operations WHERE %s ORDER BY id LIMIT ?`,
+		columnList(flatten.TableOperations), where,
+	), account, account, account, sqlLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows[flatten.OperationRow](rows)
+}
+
+// EventsByContract returns the events emitted by a contract.
+func (s *Store) EventsByContract(ctx context.Context, contractId string, limit int) ([]flatten.ContractEventRow, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT %s FROM contract_events WHERE contract_id = ? ORDER BY ledger_seq, rowid LIMIT ?",
+		columnList(flatten.TableContractEvents),
+	), contractId, sqlLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows[flatten.ContractEventRow](rows)
+}
+
+// ContractDataHistory returns the changes to a contract data entry in the
+// order they were applied.
+func (s *Store) ContractDataHistory(ctx context.Context, contractId string, key xdr.ScVal, limit int) ([]flatten.ContractDataChangeRow, error) {
+	keyXdr, err := xdr.MarshalBase64(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(
+		`SELECT %s FROM contract_data_changes c WHERE contract_id = ? AND key_xdr = ?
+ORDER BY ledger_seq,
+	(SELECT application_order FROM transactions t WHERE t.ledger_seq = c.ledger_seq AND t.hash = c.transaction_hash),
+	transaction_hash, %s, op_index, change_index
+LIMIT ?`,
+		columnList(flatten.TableContractDataChanges), stageOrder,
+	), contractId, keyXdr, sqlLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRows[flatten.ContractDataChangeRow](rows)
+}
+
+// stageOrder sorts the stage column in the order a transaction applies its
+// changes.
+const stageOrder = "CASE stage WHEN 'fee' THEN 0 WHEN 'before' THEN 1 WHEN 'operation' THEN 2 WHEN 'after' THEN 3 ELSE 4 END"
+
+// sqlLimit maps a limit of 0 to SQLite's no limit.
+func sqlLimit(limit int) int {
+	if limit <= 0 {
+		return -1
+	}
+
+	return limit
+}
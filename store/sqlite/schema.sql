@@ -0,0 +1,151 @@
+-- Tables mirror the row types of the flatten package, see
+-- sink/postgres/migrations for the Postgres equivalent.
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id                   INTEGER NOT NULL,
+    ledger_seq           INTEGER NOT NULL,
+    close_time           INTEGER NOT NULL,
+    application_order    INTEGER NOT NULL,
+    hash                 TEXT    NOT NULL,
+    envelope_type        TEXT    NOT NULL,
+    source_account       TEXT    NOT NULL,
+    source_account_muxed TEXT,
+    fee_account          TEXT,
+    inner_hash           TEXT,
+    max_fee              INTEGER NOT NULL,
+    fee_charged          INTEGER NOT NULL,
+    seq_num              INTEGER NOT NULL,
+    memo_type            TEXT    NOT NULL,
+    memo                 TEXT,
+    operation_count      INTEGER NOT NULL,
+    successful           INTEGER NOT NULL,
+    result_code          INTEGER NOT NULL,
+    result_code_name     TEXT    NOT NULL,
+    resource_fee         INTEGER,
+    instructions         INTEGER,
+    read_bytes           INTEGER,
+    write_bytes          INTEGER,
+    PRIMARY KEY (ledger_seq, hash)
+);
+
+CREATE INDEX IF NOT EXISTS transactions_hash ON transactions (hash);
+CREATE INDEX IF NOT EXISTS transactions_inner_hash ON transactions (inner_hash);
+
+CREATE TABLE IF NOT EXISTS operations (
+    id                   INTEGER NOT NULL,
+    transaction_id       INTEGER NOT NULL,
+    ledger_seq           INTEGER NOT NULL,
+    transaction_hash     TEXT    NOT NULL,
+    op_index             INTEGER NOT NULL,
+    type                 TEXT    NOT NULL,
+    source_account       TEXT    NOT NULL,
+    source_account_muxed TEXT,
+    destination          TEXT,
+    destination_muxed    TEXT,
+    "from"               TEXT,
+    from_muxed           TEXT,
+    asset                TEXT,
+    amount               INTEGER,
+    source_asset         TEXT,
+    source_amount        INTEGER,
+    selling_asset        TEXT,
+    buying_asset         TEXT,
+    price_n              INTEGER,
+    price_d              INTEGER,
+    offer_id             INTEGER,
+    liquidity_pool_id    TEXT,
+    balance_id           TEXT,
+    contract_id          TEXT,
+    function_name        TEXT,
+    body_json            TEXT    NOT NULL,
+    PRIMARY KEY (ledger_seq, transaction_hash, op_index)
+);
+
+CREATE INDEX IF NOT EXISTS operations_id ON operations (id);
+CREATE INDEX IF NOT EXISTS operations_source_account ON operations (source_account, id);
+CREATE INDEX IF NOT EXISTS operations_destination ON operations (destination, id);
+CREATE INDEX IF NOT EXISTS operations_from ON operations ("from", id);
+CREATE INDEX IF NOT EXISTS operations_source_account_muxed ON operations (source_account_muxed, id) WHERE source_account_muxed IS NOT NULL;
+CREATE INDEX IF NOT EXISTS operations_destination_muxed ON operations (destination_muxed, id) WHERE destination_muxed IS NOT NULL;
+CREATE INDEX IF NOT EXISTS operations_from_muxed ON operations (from_muxed, id) WHERE from_muxed IS NOT NULL;
+CREATE INDEX IF NOT EXISTS operations_contract_id ON operations (contract_id, id);
+
+CREATE TABLE IF NOT EXISTS operation_results (
+    operation_id     INTEGER NOT NULL,
+    ledger_seq       INTEGER NOT NULL,
+    transaction_hash TEXT    NOT NULL,
+    op_index         INTEGER NOT NULL,
+    type             TEXT    NOT NULL,
+    code             INTEGER NOT NULL,
+    code_name        TEXT    NOT NULL,
+    result_code      INTEGER,
+    result_code_name TEXT,
+    successful       INTEGER NOT NULL,
+    result_json      TEXT,
+    PRIMARY KEY (ledger_seq, transaction_hash, op_index)
+);
+
+CREATE TABLE IF NOT EXISTS effects (
+    operation_id     INTEGER NOT NULL,
+    ledger_seq       INTEGER NOT NULL,
+    transaction_hash TEXT    NOT NULL,
+    op_index         INTEGER NOT NULL,
+    effect_index     INTEGER NOT NULL,
+    type             TEXT    NOT NULL,
+    account          TEXT    NOT NULL,
+    asset            TEXT,
+    amount           INTEGER,
+    PRIMARY KEY (ledger_seq, transaction_hash, op_index, effect_index)
+);
+
+CREATE INDEX IF NOT EXISTS effects_account ON effects (account, operation_id);
+
+CREATE TABLE IF NOT EXISTS ledger_entry_changes (
+    ledger_seq               INTEGER NOT NULL,
+    transaction_hash         TEXT    NOT NULL,
+    stage                    TEXT    NOT NULL,
+    op_index                 INTEGER,
+    change_index             INTEGER NOT NULL,
+    change_type              TEXT    NOT NULL,
+    entry_type               TEXT    NOT NULL,
+    last_modified_ledger_seq INTEGER,
+    key_json                 TEXT,
+    entry_json               TEXT
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entry_changes_transaction ON ledger_entry_changes (ledger_seq, transaction_hash);
+
+CREATE TABLE IF NOT EXISTS contract_events (
+    ledger_seq       INTEGER NOT NULL,
+    transaction_hash TEXT    NOT NULL,
+    event_index      INTEGER NOT NULL,
+    contract_id      TEXT,
+    type             TEXT    NOT NULL,
+    topic            TEXT,
+    "from"           TEXT,
+    "to"             TEXT,
+    admin            TEXT,
+    amount           TEXT,
+    topics_json      TEXT    NOT NULL,
+    data_json        TEXT    NOT NULL,
+    PRIMARY KEY (ledger_seq, transaction_hash, event_index)
+);
+
+CREATE INDEX IF NOT EXISTS contract_events_contract_id ON contract_events (contract_id, ledger_seq);
+
+CREATE TABLE IF NOT EXISTS contract_data_changes (
+    ledger_seq       INTEGER NOT NULL,
+    transaction_hash TEXT    NOT NULL,
+    stage            TEXT    NOT NULL,
+    op_index         INTEGER,
+    change_index     INTEGER NOT NULL,
+    change_type      TEXT    NOT NULL,
+    contract_id      TEXT    NOT NULL,
+    durability       TEXT    NOT NULL,
+    key_json         TEXT    NOT NULL,
+    key_xdr          TEXT    NOT NULL,
+    value_json       TEXT
+);
+
+CREATE INDEX IF NOT EXISTS contract_data_changes_transaction ON contract_data_changes (ledger_seq, transaction_hash);
+CREATE INDEX IF NOT EXISTS contract_data_changes_key ON contract_data_changes (contract_id, key_xdr, ledger_seq);
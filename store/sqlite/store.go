@@ -0,0 +1,187 @@
+// Package sqlite stores the tables of the flatten package in a SQLite file,
+// for querying ledgers offline without a database server.
+//
+// Loading is idempotent: the rows of a transaction replace whatever an
+// earlier load of the same ledger and hash stored.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/decentrio/xdr-converter/flatten"
+	_ "modernc.org/sqlite"
+)
+
+//go:embed schema.sql
+var schema string
+
+// Store is a SQLite database of flattened transactions. It implements
+// flatten.Writer.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens or creates the database file at path and creates the tables
+// that do not exist yet.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	// A single connection keeps the pragmas and avoids SQLITE_BUSY between
+	// the store's own connections.
+	db.SetMaxOpenConns(1)
+
+	for _, query := range []string{
+		"PRAGMA journal_mode = WAL",
+		"PRAGMA synchronous = NORMAL",
+		schema,
+	} {
+		if _, err := db.Exec(query); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// columns are the columns of each table, in the order of the flatten row
+// fields.
+var columns = map[string][]string{
+	flatten.TableTransactions:        flatten.Columns(flatten.TransactionRow{}),
+	flatten.TableOperations:          flatten.Columns(flatten.OperationRow{}),
+	flatten.TableOperationResults:    flatten.Columns(flatten.OperationResultRow{}),
+	flatten.TableEffects:             flatten.Columns(flatten.EffectRow{}),
+	flatten.TableLedgerEntryChanges:  flatten.Columns(flatten.LedgerEntryChangeRow{}),
+	flatten.TableContractEvents:      flatten.Columns(flatten.ContractEventRow{}),
+	flatten.TableContractDataChanges: flatten.Columns(flatten.ContractDataChangeRow{}),
+}
+
+func (s *Store) Write(rows *flatten.Rows) error {
+	return s.Load(context.Background(), rows)
+}
+
+// Load stores rows in one transaction.
+func (s *Store) Load(ctx context.Context, rows *flatten.Rows) error {
+	if len(rows.Transactions) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range flatten.Tables {
+		if table == flatten.TableTransactions {
+			continue
+		}
+
+		query := fmt.Sprintf("DELETE FROM %s WHERE ledger_seq = ? AND transaction_hash = ?", table)
+		for _, row := range rows.Transactions {
+			if _, err := tx.ExecContext(ctx, query, row.LedgerSeq, row.Hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := insertRows(ctx, tx, flatten.TableTransactions, rows.Transactions); err != nil {
+		return err
+	}
+	if err := insertRows(ctx, tx, flatten.TableOperations, rows.Operations); err != nil {
+		return err
+	}
+	if err := insertRows(ctx, tx, flatten.TableOperationResults, rows.OperationResults); err != nil {
+		return err
+	}
+	if err := insertRows(ctx, tx, flatten.TableEffects, rows.Effects); err != nil {
+		return err
+	}
+	if err := insertRows(ctx, tx, flatten.TableLedgerEntryChanges, rows.LedgerEntryChanges); err != nil {
+		return err
+	}
+	if err := insertRows(ctx, tx, flatten.TableContractEvents, rows.ContractEvents); err != nil {
+		return err
+	}
+	if err := insertRows(ctx, tx, flatten.TableContractDataChanges, rows.ContractDataChanges); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertRows[T any](ctx context.Context, tx *sql.Tx, table string, rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns[table])), ", ")
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf(
+		"INSERT OR REPLACE INTO %s (%s) VALUES (%s)", table, columnList(table), placeholders,
+	))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, rowValues(row)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rowValues returns the fields of a row; nil pointers are stored as NULL.
+func rowValues(row interface{}) []interface{} {
+	v := reflect.ValueOf(row)
+	values := make([]interface{}, v.NumField())
+	for i := range values {
+		values[i] = v.Field(i).Interface()
+	}
+
+	return values
+}
+
+// scanRows reads rows selected with columnList into row structs.
+func scanRows[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+
+	var result []T
+	for rows.Next() {
+		var row T
+		v := reflect.ValueOf(&row).Elem()
+		dest := make([]interface{}, v.NumField())
+		for i := range dest {
+			dest[i] = v.Field(i).Addr().Interface()
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
+func columnList(table string) string {
+	var quoted []string
+	for _, column := range columns[table] {
+		quoted = append(quoted, `"`+column+`"`)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,260 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/decentrio/xdr-converter/flatten"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+)
+
+var testContract = xdr.ContractId{0xcc}
+
+func testAccount(b byte) xdr.MuxedAccount {
+	return xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &xdr.Uint256{b}}
+}
+
+func testMuxedAccount(b byte, id uint64) xdr.MuxedAccount {
+	return xdr.MuxedAccount{
+		Type:     xdr.CryptoKeyTypeKeyTypeMuxedEd25519,
+		Med25519: &xdr.MuxedAccountMed25519{Id: xdr.Uint64(id), Ed25519: xdr.Uint256{b}},
+	}
+}
+
+func symbol(s string) xdr.ScVal {
+	sym := xdr.ScSymbol(s)
+	return xdr.ScVal{Type: xdr.ScValTypeScvSymbol, Sym: &sym}
+}
+
+func u32(v uint32) xdr.ScVal {
+	u := xdr.Uint32(v)
+	return xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &u}
+}
+
+func contractData(key string, val uint32) xdr.LedgerEntry {
+	id := testContract
+	return xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 10,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeContractData,
+			ContractData: &xdr.ContractDataEntry{
+				Contract:   xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id},
+				Key:        symbol(key),
+				Durability: xdr.ContractDataDurabilityPersistent,
+				Val:        u32(val),
+			},
+		},
+	}
+}
+
+func created(e xdr.LedgerEntry) xdr.LedgerEntryChange {
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryCreated, Created: &e}
+}
+
+func updated(e xdr.LedgerEntry) xdr.LedgerEntryChange {
+	return xdr.LedgerEntryChange{Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated, Updated: &e}
+}
+
+// testTransaction returns a successful payment of ledger 10 to destination
+// with the given meta.
+func testTransaction(index uint32, hash byte, destination xdr.MuxedAccount, meta xdr.TransactionMetaV3) flatten.Transaction {
+	results := []xdr.OperationResult{{
+		Code: xdr.OperationResultCodeOpInner,
+		Tr: &xdr.OperationResultTr{
+			Type:          xdr.OperationTypePayment,
+			PaymentResult: &xdr.PaymentResult{Code: xdr.PaymentResultCodePaymentSuccess},
+		},
+	}}
+
+	return flatten.Transaction{
+		LedgerSeq: 10,
+		Index:     index,
+		Envelope: xdr.TransactionEnvelope{
+			Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+			V1: &xdr.TransactionV1Envelope{Tx: xdr.Transaction{
+				SourceAccount: testAccount(1),
+				Fee:           100,
+				SeqNum:        xdr.SequenceNumber(index),
+				Operations: []xdr.Operation{{Body: xdr.OperationBody{
+					Type:      xdr.OperationTypePayment,
+					PaymentOp: &xdr.PaymentOp{Destination: destination, Asset: xdr.MustNewNativeAsset(), Amount: 10},
+				}}},
+			}},
+		},
+		Result: xdr.TransactionResultPair{
+			TransactionHash: xdr.Hash{hash},
+			Result: xdr.TransactionResult{
+				FeeCharged: 100,
+				Result: xdr.TransactionResultResult{
+					Code:    xdr.TransactionResultCodeTxSuccess,
+					Results: &results,
+				},
+			},
+		},
+		Meta:              &xdr.TransactionMeta{V: 3, V3: &meta},
+		NetworkPassphrase: "Test SDF Network ; September 2015",
+	}
+}
+
+// testRows has two transactions of ledger 10 changing the contract data
+// entry "counter". The second is applied last but sorts first by hash.
+func testRows(t *testing.T) *flatten.Rows {
+	id := testContract
+	first := testTransaction(1, 0xaa, testMuxedAccount(2, 7), xdr.TransactionMetaV3{
+		Operations: []xdr.OperationMeta{{Changes: xdr.LedgerEntryChanges{created(contractData("counter", 1))}}},
+		TxChangesAfter: xdr.LedgerEntryChanges{
+			updated(contractData("counter", 2)),
+			created(contractData("other", 9)),
+		},
+		SorobanMeta: &xdr.SorobanTransactionMeta{
+			Events: []xdr.ContractEvent{{
+				ContractId: &id,
+				Type:       xdr.ContractEventTypeContract,
+				Body: xdr.ContractEventBody{V0: &xdr.ContractEventV0{
+					Topics: xdr.ScVec{symbol("incr")},
+					Data:   u32(2),
+				}},
+			}},
+		},
+	})
+	second := testTransaction(2, 0x01, testAccount(3), xdr.TransactionMetaV3{
+		Operations: []xdr.OperationMeta{{Changes: xdr.LedgerEntryChanges{updated(contractData("counter", 3))}}},
+	})
+
+	var rows flatten.Rows
+	for _, tx := range []flatten.Transaction{first, second} {
+		if err := rows.Add(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &rows
+}
+
+func openTestStore(t *testing.T) *Store {
+	store, err := Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	return store
+}
+
+func TestQueries(t *testing.T) {
+	ctx := context.Background()
+	store := openTestStore(t)
+	// Loading twice replaces the rows of the first load.
+	for i := 0; i < 2; i++ {
+		if err := store.Write(testRows(t)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hash := xdr.Hash{0xaa}.HexString()
+	tx, err := store.TransactionByHash(ctx, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ApplicationOrder != 1 || tx.LedgerSeq != 10 {
+		t.Errorf("got transaction %+v", tx)
+	}
+	if _, err := store.TransactionByHash(ctx, xdr.Hash{0xff}.HexString()); err != ErrNotFound {
+		t.Errorf("got %v for a missing transaction, want ErrNotFound", err)
+	}
+
+	ops, err := store.OperationsByTransaction(ctx, 10, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 1 || ops[0].Type != "PAYMENT" {
+		t.Fatalf("got operations %+v", ops)
+	}
+
+	events, err := store.EventsByContract(ctx, strkey.MustEncode(strkey.VersionByteContract, testContract[:]), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].TransactionHash != hash {
+		t.Errorf("got events %+v", events)
+	}
+}
+
+func TestOperationsByAccountMuxed(t *testing.T) {
+	ctx := context.Background()
+	store := openTestStore(t)
+	if err := store.Write(testRows(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	recipient := testMuxedAccount(2, 7)
+	other := testMuxedAccount(2, 8)
+	for _, c := range []struct {
+		account string
+		want    int
+	}{
+		{recipient.ToAccountId().Address(), 1},
+		{recipient.Address(), 1},
+		{other.Address(), 0},
+		{testAccount(1).ToAccountId().Address(), 2},
+		{testAccount(3).ToAccountId().Address(), 1},
+	} {
+		ops, err := store.OperationsByAccount(ctx, c.account, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ops) != c.want {
+			t.Errorf("%s: got %d operations, want %d", c.account, len(ops), c.want)
+		}
+	}
+
+	ops, err := store.OperationsByAccount(ctx, recipient.Address(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) == 1 && (ops[0].DestinationMuxed == nil || *ops[0].DestinationMuxed != recipient.Address()) {
+		t.Errorf("got destination_muxed %v, want %s", ops[0].DestinationMuxed, recipient.Address())
+	}
+}
+
+func TestContractDataHistory(t *testing.T) {
+	ctx := context.Background()
+	store := openTestStore(t)
+	if err := store.Write(testRows(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	contractId := strkey.MustEncode(strkey.VersionByteContract, testContract[:])
+	changes, err := store.ContractDataHistory(ctx, contractId, symbol("counter"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{`{"u32":1}`, `{"u32":2}`, `{"u32":3}`}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d", len(changes), len(want))
+	}
+	for i, change := range changes {
+		if change.ValueJson == nil || *change.ValueJson != want[i] {
+			t.Errorf("change %d: got value %v, want %s", i, change.ValueJson, want[i])
+		}
+	}
+
+	changes, err = store.ContractDataHistory(ctx, contractId, symbol("counter"), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Errorf("got %d changes with limit 2", len(changes))
+	}
+
+	changes, err = store.ContractDataHistory(ctx, contractId, symbol("missing"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("got %d changes of a missing key", len(changes))
+	}
+}
@@ -0,0 +1,199 @@
+// Package xdrstream reads XDR records framed with RFC 5531 record marking, the
+// format of history archive files, bucket files and the stellar-core metadata
+// output stream.
+//
+// Each record is made of fragments. A fragment starts with a 4-byte big-endian
+// header whose high bit marks the last fragment of the record and whose other
+// 31 bits are the fragment length.
+package xdrstream
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	xdr3 "github.com/stellar/go-xdr/xdr3"
+	"github.com/stellar/go/xdr"
+)
+
+// DefaultMaxRecordSize bounds the memory a single record may take. Ledger
+// close meta of busy ledgers runs to tens of megabytes.
+const DefaultMaxRecordSize = 256 << 20
+
+const lastFragment = 0x80000000
+
+// ErrTruncated is returned when the stream ends inside a record.
+var ErrTruncated = errors.New("xdr stream ends inside a record")
+
+type Reader struct {
+	r             *bufio.Reader
+	closer        io.Closer
+	buf           []byte
+	offset        int64
+	MaxRecordSize int
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:             bufio.NewReaderSize(r, 64*1024),
+		MaxRecordSize: DefaultMaxRecordSize,
+	}
+}
+
+// OpenFile opens a record-marked file, decompressing it when its name ends in
+// .gz. The returned reader must be closed.
+func OpenFile(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(path, ".gz") {
+		r := NewReader(f)
+		r.closer = f
+		return r, nil
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "error opening %s", path)
+	}
+
+	r := NewReader(gz)
+	r.closer = closers{gz, f}
+	return r, nil
+}
+
+type closers []io.Closer
+
+func (c closers) Close() error {
+	var first error
+	for _, closer := range c {
+		if err := closer.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// Close closes the file opened by OpenFile. It does nothing for readers made
+// by NewReader.
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
+
+// Offset returns the number of stream bytes consumed so far.
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
+
+// ReadRecord returns the next record. The returned slice is reused by the
+// next call. It returns io.EOF when the stream ends between records and
+// ErrTruncated when it ends inside one.
+func (r *Reader) ReadRecord() ([]byte, error) {
+	r.buf = r.buf[:0]
+	start := r.offset
+
+	for {
+		var header [4]byte
+		n, err := io.ReadFull(r.r, header[:])
+		r.offset += int64(n)
+		if err == io.EOF && len(r.buf) == 0 && r.offset == start {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, r.truncated(start, err)
+		}
+
+		marker := binary.BigEndian.Uint32(header[:])
+		size := int(marker &^ lastFragment)
+		if len(r.buf)+size > r.MaxRecordSize {
+			return nil, errors.Errorf("record at offset %d exceeds the limit of %d bytes", start, r.MaxRecordSize)
+		}
+
+		r.buf = grow(r.buf, size)
+		n, err = io.ReadFull(r.r, r.buf[len(r.buf)-size:])
+		r.offset += int64(n)
+		if err != nil {
+			return nil, r.truncated(start, err)
+		}
+
+		if marker&lastFragment != 0 {
+			return r.buf, nil
+		}
+	}
+}
+
+func (r *Reader) truncated(start int64, err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return fmt.Errorf("%w: record at offset %d", ErrTruncated, start)
+	}
+
+	return err
+}
+
+func grow(buf []byte, n int) []byte {
+	if cap(buf)-len(buf) >= n {
+		return buf[:len(buf)+n]
+	}
+
+	grown := make([]byte, len(buf)+n, 2*len(buf)+n)
+	copy(grown, buf)
+	return grown
+}
+
+// Read decodes the next record into v. Trailing bytes in the record are an
+// error.
+func (r *Reader) Read(v xdr.DecoderFrom) error {
+	record, err := r.ReadRecord()
+	if err != nil {
+		return err
+	}
+
+	return Decode(record, v)
+}
+
+// Decode decodes a whole record into v.
+func Decode(record []byte, v xdr.DecoderFrom) error {
+	opts := xdr3.DefaultDecodeOptions
+	opts.MaxInputLen = len(record)
+
+	n, err := v.DecodeFrom(xdr3.NewDecoderWithOptions(bytes.NewReader(record), opts), opts.MaxDepth)
+	if err != nil {
+		return errors.Wrapf(err, "error decoding %T", v)
+	}
+
+	if n != len(record) {
+		return errors.Errorf("error decoding %T: %d trailing bytes", v, len(record)-n)
+	}
+
+	return nil
+}
+
+// WriteRecord writes bz as a single-fragment record.
+func WriteRecord(w io.Writer, bz []byte) error {
+	if len(bz) >= lastFragment {
+		return errors.Errorf("record of %d bytes is too large", len(bz))
+	}
+
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(bz))|lastFragment)
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(bz)
+	return err
+}